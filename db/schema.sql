@@ -3,18 +3,33 @@ CREATE EXTENSION IF NOT EXISTS "pgcrypto";
 
 -- Enums
 CREATE TYPE position_enum AS ENUM (
-    'QB', 'RB', 'WR', 'TE', 'PK', -- Football
+    'QB', 'RB', 'WR', 'TE', 'PK', 'DST',  -- Football
+    'DL', 'LB', 'DB',                     -- Football defenders (IDP)
     'PG', 'SG', 'SF', 'PF', 'C',          -- Basketball
     'SP', 'RP', 'CATCHER', '1B', '2B', '3B', 'SS', 'LF', 'CF', 'RF', 'DH' -- Baseball
 );
 CREATE TYPE player_status_enum AS ENUM ('ACTIVE', 'INJURED', 'PUP', 'SUSPENDED', 'RETIRED');
 CREATE TYPE sport_type_enum AS ENUM ('FOOTBALL', 'BASKETBALL', 'BASEBALL');
 CREATE TYPE draft_room_status_enum AS ENUM ('WAITING', 'DRAFTING', 'PAUSED', 'COMPLETE');
+CREATE TYPE waiver_mode_enum AS ENUM ('ROLLING', 'FAAB');
+CREATE TYPE waiver_claim_status_enum AS ENUM ('PENDING', 'SUCCESSFUL', 'FAILED', 'CANCELLED');
+CREATE TYPE transaction_type_enum AS ENUM ('ADD', 'DROP', 'TRADE');
+CREATE TYPE trade_status_enum AS ENUM ('PROPOSED', 'COUNTERED', 'REJECTED', 'CANCELLED', 'IN_REVIEW', 'VETOED', 'COMPLETED', 'FAILED');
+CREATE TYPE injury_severity_enum AS ENUM ('MINOR', 'MODERATE', 'SEVERE');
+
+-- 0. Universes (independent synthetic leagues; seeding creates or replaces one at a time)
+CREATE TABLE universes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT UNIQUE NOT NULL,
+    league JSONB, -- the league definition its football league was generated from
+    created_at TIMESTAMP DEFAULT NOW()
+);
 
 -- 1. Conferences
 CREATE TABLE conferences (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     name TEXT NOT NULL,
+    universe_id UUID NOT NULL REFERENCES universes(id),
     created_at TIMESTAMP DEFAULT NOW()
 );
 
@@ -36,6 +51,9 @@ CREATE TABLE pro_teams (
     logo_url TEXT,
     year_established INT,
     division_id UUID NOT NULL REFERENCES divisions(id),
+    universe_id UUID NOT NULL REFERENCES universes(id), -- same as the division's conference, kept here so player pools filter without joins
+    bye_week INT, -- week the team doesn't play, NULL = none
+    sport_type sport_type_enum NOT NULL DEFAULT 'FOOTBALL',
     created_at TIMESTAMP DEFAULT NOW()
 );
 
@@ -58,6 +76,9 @@ CREATE TABLE players (
     -- Meta
     status player_status_enum NOT NULL DEFAULT 'ACTIVE',
     skill DECIMAL(5,4), -- renamed from position_skill_factor, now 0.0000 - 1.0000
+    scouted_skill DECIMAL(5,4), -- what scouts saw before the rookie season, NULL = not scouted
+    draft_round INT, -- NULL = not taken in a simulated rookie draft
+    draft_pick INT, -- pick within the round
     headshot_url TEXT,
     
     created_at TIMESTAMP DEFAULT NOW(),
@@ -79,6 +100,10 @@ CREATE TABLE yearly_stats (
     projected_fantasy_points DECIMAL(10,2) NOT NULL DEFAULT 0,
     is_projected BOOLEAN NOT NULL DEFAULT FALSE,
     games_played INT CHECK (games_played >= 0),
+
+    -- Snapshot of the player that season
+    age INT,
+    skill DECIMAL(5,4), -- follows the position's aging curve
     
     -- Computed Column
     fantasy_points_per_game DECIMAL(10,2) GENERATED ALWAYS AS (
@@ -112,7 +137,10 @@ CREATE TABLE rankings (
 CREATE TABLE draft_rooms (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     status draft_room_status_enum NOT NULL DEFAULT 'WAITING',
+    sport_type sport_type_enum NOT NULL DEFAULT 'FOOTBALL', -- the pro league the room drafts from
+    universe_id UUID NOT NULL REFERENCES universes(id), -- the synthetic league that pro league belongs to
     timer_duration INT NOT NULL DEFAULT 60,
+    roster_rules JSONB, -- starting slots and bench/IR sizes, NULL = league defaults
     created_at TIMESTAMP DEFAULT NOW(),
     updated_at TIMESTAMP DEFAULT NOW()
 );
@@ -145,6 +173,8 @@ CREATE TABLE fantasy_teams (
     name TEXT NOT NULL,
     draft_order_number INT,
     is_bot BOOLEAN NOT NULL DEFAULT FALSE,
+    waiver_priority INT, -- 1 claims first, set when the season is scheduled
+    faab_remaining INT CHECK (faab_remaining >= 0),
     created_at TIMESTAMP DEFAULT NOW()
 );
 
@@ -158,3 +188,158 @@ CREATE TABLE fantasy_rosters (
     
     UNIQUE (fantasy_team_id, player_id)
 );
+
+-- 13. Weekly Stats (one game line per player per week; drives fantasy matchups)
+CREATE TABLE weekly_stats (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    player_id UUID NOT NULL REFERENCES players(id),
+    year INT NOT NULL,
+    week INT NOT NULL CHECK (week > 0),
+    sport_type sport_type_enum NOT NULL,
+    stats JSONB NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+
+    UNIQUE (player_id, year, week)
+);
+
+-- 14. Fantasy Seasons (one simulated season per completed draft room)
+CREATE TABLE fantasy_seasons (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    draft_room_id UUID NOT NULL UNIQUE REFERENCES draft_rooms(id),
+    year INT NOT NULL, -- pro season whose weekly_stats are used for scoring
+    regular_season_weeks INT NOT NULL CHECK (regular_season_weeks > 0),
+    current_week INT NOT NULL DEFAULT 0, -- last simulated week, 0 = not started
+
+    -- Playoffs
+    playoff_teams INT NOT NULL DEFAULT 4 CHECK (playoff_teams >= 2),
+    playoff_byes INT NOT NULL DEFAULT 0 CHECK (playoff_byes >= 0),
+    playoff_weeks_per_round INT NOT NULL DEFAULT 1 CHECK (playoff_weeks_per_round > 0),
+    playoff_tiebreakers TEXT[] NOT NULL DEFAULT '{RECORD,HEAD_TO_HEAD,POINTS_FOR}',
+    champion_team_id UUID REFERENCES fantasy_teams(id),
+
+    -- Waivers
+    waiver_mode waiver_mode_enum NOT NULL DEFAULT 'ROLLING',
+    faab_budget INT NOT NULL DEFAULT 100 CHECK (faab_budget >= 0),
+    waiver_process_day INT NOT NULL DEFAULT 3 CHECK (waiver_process_day BETWEEN 0 AND 6), -- 0 = Sunday
+    waiver_process_hour INT NOT NULL DEFAULT 10 CHECK (waiver_process_hour BETWEEN 0 AND 23), -- UTC
+    waivers_processed_at TIMESTAMP, -- last batch, NULL = never processed
+
+    -- Trades
+    trade_review_hours INT NOT NULL DEFAULT 48 CHECK (trade_review_hours >= 0), -- 0 = execute on acceptance
+    trade_veto_votes INT CHECK (trade_veto_votes > 0), -- NULL = majority of uninvolved teams
+
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+-- 15. Fantasy Playoff Games (one node of the bracket tree per game)
+CREATE TABLE fantasy_playoff_games (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    draft_room_id UUID NOT NULL REFERENCES draft_rooms(id),
+    round INT NOT NULL CHECK (round > 0),
+    bracket_position INT NOT NULL, -- top-to-bottom order within the round
+    home_team_id UUID REFERENCES fantasy_teams(id), -- NULL until the feeding game is decided
+    away_team_id UUID REFERENCES fantasy_teams(id),
+    home_seed INT,
+    away_seed INT,
+    home_score DECIMAL(10,2), -- summed across every week of the round
+    away_score DECIMAL(10,2),
+    winner_team_id UUID REFERENCES fantasy_teams(id),
+    next_game_id UUID REFERENCES fantasy_playoff_games(id), -- NULL for the final
+    next_slot TEXT CHECK (next_slot IN ('HOME', 'AWAY')),
+    created_at TIMESTAMP DEFAULT NOW(),
+
+    UNIQUE (draft_room_id, round, bracket_position)
+);
+
+-- 16. Fantasy Matchups (head-to-head games between fantasy teams)
+CREATE TABLE fantasy_matchups (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    draft_room_id UUID NOT NULL REFERENCES draft_rooms(id),
+    week INT NOT NULL CHECK (week > 0),
+    home_team_id UUID NOT NULL REFERENCES fantasy_teams(id),
+    away_team_id UUID NOT NULL REFERENCES fantasy_teams(id),
+    home_score DECIMAL(10,2), -- NULL until the week is simulated
+    away_score DECIMAL(10,2),
+    is_complete BOOLEAN NOT NULL DEFAULT FALSE,
+    playoff_game_id UUID REFERENCES fantasy_playoff_games(id), -- set for playoff weeks
+    created_at TIMESTAMP DEFAULT NOW(),
+
+    CHECK (home_team_id <> away_team_id)
+);
+
+-- 17. Fantasy Waiver Claims (processed in weekly batches)
+CREATE TABLE fantasy_waiver_claims (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    draft_room_id UUID NOT NULL REFERENCES draft_rooms(id),
+    fantasy_team_id UUID NOT NULL REFERENCES fantasy_teams(id),
+    add_player_id UUID NOT NULL REFERENCES players(id),
+    drop_player_id UUID REFERENCES players(id),
+    bid_amount INT NOT NULL DEFAULT 0 CHECK (bid_amount >= 0), -- FAAB only
+    status waiver_claim_status_enum NOT NULL DEFAULT 'PENDING',
+    failure_reason TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    processed_at TIMESTAMP
+);
+
+-- 18. Fantasy Trades (proposals, counter-offers and their review)
+CREATE TABLE fantasy_trades (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    draft_room_id UUID NOT NULL REFERENCES draft_rooms(id),
+    proposing_team_id UUID NOT NULL REFERENCES fantasy_teams(id),
+    receiving_team_id UUID NOT NULL REFERENCES fantasy_teams(id),
+    status trade_status_enum NOT NULL DEFAULT 'PROPOSED',
+    counter_of_trade_id UUID REFERENCES fantasy_trades(id), -- the proposal this one counters
+    review_ends_at TIMESTAMP, -- set on acceptance
+    failure_reason TEXT,
+    created_at TIMESTAMP DEFAULT NOW(),
+    resolved_at TIMESTAMP,
+
+    CHECK (proposing_team_id <> receiving_team_id)
+);
+
+-- 19. Fantasy Trade Players (every player changing hands in a trade)
+CREATE TABLE fantasy_trade_players (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    trade_id UUID NOT NULL REFERENCES fantasy_trades(id),
+    player_id UUID NOT NULL REFERENCES players(id),
+    from_team_id UUID NOT NULL REFERENCES fantasy_teams(id),
+    to_team_id UUID NOT NULL REFERENCES fantasy_teams(id),
+
+    UNIQUE (trade_id, player_id)
+);
+
+-- 20. Fantasy Trade Votes (veto votes from managers not in the trade)
+CREATE TABLE fantasy_trade_votes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    trade_id UUID NOT NULL REFERENCES fantasy_trades(id),
+    fantasy_team_id UUID NOT NULL REFERENCES fantasy_teams(id),
+    created_at TIMESTAMP DEFAULT NOW(),
+
+    UNIQUE (trade_id, fantasy_team_id)
+);
+
+-- 21. Fantasy Transactions (history of every roster move)
+CREATE TABLE fantasy_transactions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    draft_room_id UUID NOT NULL REFERENCES draft_rooms(id),
+    fantasy_team_id UUID NOT NULL REFERENCES fantasy_teams(id),
+    player_id UUID NOT NULL REFERENCES players(id),
+    type transaction_type_enum NOT NULL,
+    waiver_claim_id UUID REFERENCES fantasy_waiver_claims(id), -- set for moves made by a waiver claim
+    trade_id UUID REFERENCES fantasy_trades(id), -- set for players received in a trade
+    faab_amount INT, -- winning bid for FAAB claims
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+-- 22. Injuries (simulated injury history of pro players)
+CREATE TABLE injuries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    player_id UUID NOT NULL REFERENCES players(id),
+    year INT NOT NULL,
+    week INT NOT NULL CHECK (week > 0), -- week of the game the injury happened in
+    games_missed INT NOT NULL CHECK (games_missed >= 0),
+    injury_type TEXT NOT NULL, -- e.g. "Hamstring strain"
+    severity injury_severity_enum NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"time"
 
 	"fantasy-draft/graph"
 
@@ -33,10 +34,13 @@ func main() {
 	// 3. Create the GraphQL resolver with connection pool
 	resolver := graph.NewResolver(pool)
 
-	// 4. Create the GraphQL server
+	// 4. Process waiver claims and reviewed trades in the background as they come due
+	go runLeagueScheduler(context.Background(), pool, time.Minute)
+
+	// 5. Create the GraphQL server
 	srv := handler.NewDefaultServer(graph.NewExecutableSchema(graph.Config{Resolvers: resolver}))
 
-	// 5. Register Routes
+	// 6. Register Routes
 	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintf(w, "Welcome to the Fantasy Draft API! Visit /playground for the GraphQL Playground.")
 	})
@@ -47,7 +51,7 @@ func main() {
 	// GraphQL endpoint
 	http.Handle("/graphql", srv)
 
-	// 6. Start the server
+	// 7. Start the server
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080"
@@ -61,3 +65,23 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+// runLeagueScheduler checks for due waiver batches and trades every interval until the context is cancelled
+func runLeagueScheduler(ctx context.Context, pool *pgxpool.Pool, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if _, err := graph.ProcessDueWaivers(ctx, pool, now); err != nil {
+				log.Printf("Waiver processing failed: %v", err)
+			}
+			if _, err := graph.ProcessDueTrades(ctx, pool); err != nil {
+				log.Printf("Trade processing failed: %v", err)
+			}
+		}
+	}
+}
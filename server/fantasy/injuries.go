@@ -0,0 +1,123 @@
+package fantasy
+
+import "math"
+
+// RecentInjurySeasons is how many of a player's latest seasons count toward their injury risk
+const RecentInjurySeasons = 3
+
+// injurySeverityFactors is how much each recent injury raises a player's per-game injury rate
+var injurySeverityFactors = map[string]float64{
+	"MINOR":    1.1,
+	"MODERATE": 1.25,
+	"SEVERE":   1.5,
+}
+
+// maxGameInjuryRate caps the per-game rate so a long injury history can't make an injury certain
+const maxGameInjuryRate = 0.5
+
+// Pro season lengths, in games, for each sport the data simulator plays
+const (
+	FootballGamesPerSeason   = 18
+	BasketballGamesPerSeason = 82
+	BaseballGamesPerSeason   = 162
+)
+
+// GamesPerSeasonFor returns the length of a sport's pro season
+func GamesPerSeasonFor(sportType string) int {
+	switch sportType {
+	case "BASKETBALL":
+		return BasketballGamesPerSeason
+	case "BASEBALL":
+		return BaseballGamesPerSeason
+	default:
+		return FootballGamesPerSeason
+	}
+}
+
+// GameInjuryRateFor returns the per-game injury rates of a sport's players
+func GameInjuryRateFor(sportType string) func(age int, position string) float64 {
+	switch sportType {
+	case "BASKETBALL":
+		return BasketballGameInjuryRate
+	case "BASEBALL":
+		return BaseballGameInjuryRate
+	default:
+		return GameInjuryRate
+	}
+}
+
+// GameInjuryRate is the chance of an injury in a single football game for a player with no
+// recent injuries. The data simulator rolls each game against it.
+func GameInjuryRate(age int, position string) float64 {
+	rate := 0.12
+	switch {
+	case age < 25:
+		rate = 0.04
+	case age < 30:
+		rate = 0.06
+	case age < 35:
+		rate = 0.10
+	}
+
+	switch position {
+	case "QB":
+		rate *= 0.5
+	case "WR", "TE":
+		rate *= 0.8
+	case "PK":
+		rate *= 0.25
+	case "DST":
+		// A team's defense can't be injured
+		return 0
+	}
+	return rate
+}
+
+// BasketballGameInjuryRate is the chance of an injury in a single basketball game. Basketball
+// seasons are long, so the per-game chance is far lower than football's.
+func BasketballGameInjuryRate(age int, position string) float64 {
+	rate := 0.008
+	if age >= 30 {
+		rate = 0.012
+	}
+	if position == "C" {
+		rate *= 1.25
+	}
+	return rate
+}
+
+// BaseballGameInjuryRate is the chance of an injury in a baseball game a player appears in. A
+// starting pitcher throws every fifth day and breaks down far more often per appearance than an
+// everyday player.
+func BaseballGameInjuryRate(age int, position string) float64 {
+	rate := 0.003
+	switch position {
+	case "SP":
+		rate = 0.02
+	case "RP":
+		rate = 0.006
+	case "CATCHER":
+		rate = 0.005
+	}
+	if age >= 32 {
+		rate *= 1.3
+	}
+	return rate
+}
+
+// InjuryRisk estimates the chance, from 0 to 1, that a player is injured at least once in a season
+// of the given number of games, each rolled against gameRate (see GameInjuryRateFor).
+// recentSeverities holds the severity of each injury in the player's last RecentInjurySeasons
+// seasons; each one makes a further injury more likely.
+func InjuryRisk(gameRate func(age int, position string) float64, age int, position string, recentSeverities []string, games int) float64 {
+	rate := gameRate(age, position)
+	for _, severity := range recentSeverities {
+		if factor, ok := injurySeverityFactors[severity]; ok {
+			rate *= factor
+		}
+	}
+	rate = min(rate, maxGameInjuryRate)
+
+	risk := 1 - math.Pow(1-rate, float64(games))
+	return math.Round(risk*1000) / 1000
+}
@@ -0,0 +1,60 @@
+package fantasy
+
+import "testing"
+
+func TestInjuryRisk(t *testing.T) {
+	tests := []struct {
+		name     string
+		age      int
+		position string
+		recent   []string
+		games    int
+		expected float64
+	}{
+		{"young quarterback, one game", 23, "QB", nil, 1, 0.02},
+		{"veteran running back, one game", 31, "RB", nil, 1, 0.1},
+		{"no games", 27, "WR", nil, 0, 0},
+		{"severe injury history", 27, "RB", []string{"SEVERE"}, 1, 0.09},
+		{"unknown severity is ignored", 27, "RB", []string{"UNKNOWN"}, 1, 0.06},
+		{"rate is capped", 36, "RB", []string{"SEVERE", "SEVERE", "SEVERE", "SEVERE", "SEVERE"}, 1, 0.5},
+		{"full season", 27, "RB", nil, 18, 0.672},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := InjuryRisk(GameInjuryRate, tt.age, tt.position, tt.recent, tt.games); result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestInjuryRiskGrowsWithHistory(t *testing.T) {
+	healthy := InjuryRisk(GameInjuryRate, 27, "WR", nil, 17)
+	minor := InjuryRisk(GameInjuryRate, 27, "WR", []string{"MINOR"}, 17)
+	severe := InjuryRisk(GameInjuryRate, 27, "WR", []string{"SEVERE"}, 17)
+
+	if !(healthy < minor && minor < severe) {
+		t.Errorf("Expected risk to grow with injury severity, got %v, %v, %v", healthy, minor, severe)
+	}
+}
+
+func TestInjuryRiskBySport(t *testing.T) {
+	football := InjuryRisk(GameInjuryRateFor("FOOTBALL"), 27, "RB", nil, GamesPerSeasonFor("FOOTBALL"))
+	basketball := InjuryRisk(GameInjuryRateFor("BASKETBALL"), 27, "PG", nil, GamesPerSeasonFor("BASKETBALL"))
+
+	if football != 0.672 {
+		t.Errorf("Expected an 18 game football season's risk to be 0.672, got %v", football)
+	}
+	// 82 games at 0.8% a game
+	if basketball != 0.482 {
+		t.Errorf("Expected an 82 game basketball season's risk to be 0.482, got %v", basketball)
+	}
+	// 162 games at 0.3% a game
+	if baseball := InjuryRisk(GameInjuryRateFor("BASEBALL"), 27, "SS", nil, GamesPerSeasonFor("BASEBALL")); baseball != 0.385 {
+		t.Errorf("Expected a 162 game baseball season's risk to be 0.385, got %v", baseball)
+	}
+	if GamesPerSeasonFor("BASKETBALL") != 82 {
+		t.Errorf("Expected an 82 game basketball season, got %d", GamesPerSeasonFor("BASKETBALL"))
+	}
+}
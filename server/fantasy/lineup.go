@@ -0,0 +1,164 @@
+package fantasy
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// FlexSlot is the lineup slot any running back, wide receiver or tight end can fill
+const FlexSlot = "FLEX"
+
+// DefensiveFlexSlot is the lineup slot any individual defensive player can fill
+const DefensiveFlexSlot = "IDP"
+
+// Basketball lineup slots that accept more than one position
+const (
+	GuardSlot   = "G"
+	ForwardSlot = "F"
+	// UtilitySlot takes any basketball player, or any baseball hitter
+	UtilitySlot = "UTIL"
+)
+
+// Baseball lineup slots that accept more than one position
+const (
+	OutfieldSlot = "OF"
+	PitcherSlot  = "P"
+)
+
+// slotPositions lists the positions allowed in slots that accept more than their own position
+var slotPositions = map[string][]string{
+	FlexSlot:          {"RB", "WR", "TE"},
+	DefensiveFlexSlot: {"DL", "LB", "DB"},
+	GuardSlot:         {"PG", "SG"},
+	ForwardSlot:       {"SF", "PF"},
+	UtilitySlot:       {"PG", "SG", "SF", "PF", "C", "CATCHER", "1B", "2B", "3B", "SS", "LF", "CF", "RF", "DH"},
+	OutfieldSlot:      {"LF", "CF", "RF"},
+	PitcherSlot:       {"SP", "RP"},
+}
+
+// EligiblePositions returns the pro positions that may start in a lineup slot
+func EligiblePositions(slot string) []string {
+	if positions, ok := slotPositions[slot]; ok {
+		return positions
+	}
+	return []string{slot}
+}
+
+// CanStart reports whether a player at position may start in slot
+func CanStart(slot, position string) bool {
+	return slices.Contains(EligiblePositions(slot), position)
+}
+
+// CanUseInjuredReserve reports whether a player with the given status may be stashed on injured reserve
+func CanUseInjuredReserve(status string) bool {
+	return status == "INJURED" || status == "PUP"
+}
+
+// LineupPlayer is a rostered player as seen when setting a weekly lineup
+type LineupPlayer struct {
+	ID       string
+	Position string
+	Status   string
+	// OnBye is set when the player's pro team doesn't play this week
+	OnBye bool
+	// Projection is the player's expected fantasy points for the week
+	Projection float64
+}
+
+// Available reports whether the player is expected to play this week
+func (p LineupPlayer) Available() bool {
+	return (p.Status == "" || p.Status == "ACTIVE") && !p.OnBye
+}
+
+// CheckLineup returns an error if a lineup (player ID to roster spot) puts a player in a slot
+// the rules don't have, overfills a slot, starts an ineligible position or misuses injured reserve.
+// players maps each player ID on the roster to their details.
+func (r RosterRules) CheckLineup(lineup map[string]string, players map[string]LineupPlayer) error {
+	counts := make(map[string]int)
+	for playerID, spot := range lineup {
+		player, ok := players[playerID]
+		if !ok {
+			return fmt.Errorf("player %s is not on this roster", playerID)
+		}
+
+		switch {
+		case spot == BenchSpot:
+		case spot == InjuredReserveSpot:
+			if !CanUseInjuredReserve(player.Status) {
+				return fmt.Errorf("player %s is %s and can't be placed on injured reserve", playerID, player.Status)
+			}
+		case r.Slots[spot] == 0:
+			return fmt.Errorf("lineup has no %s slot", spot)
+		case !CanStart(spot, player.Position):
+			return fmt.Errorf("a %s can't start at %s", player.Position, spot)
+		}
+		counts[spot]++
+	}
+
+	for spot, count := range counts {
+		if IsStartingSpot(spot) && count > r.Slots[spot] {
+			return fmt.Errorf("lineup starts %d players at %s, the limit is %d", count, spot, r.Slots[spot])
+		}
+	}
+	if counts[InjuredReserveSpot] > r.InjuredReserveSpots {
+		return fmt.Errorf("lineup has %d players on injured reserve, the limit is %d", counts[InjuredReserveSpot], r.InjuredReserveSpots)
+	}
+	return nil
+}
+
+// OptimizeLineup returns the lineup (player ID to roster spot) that starts the available players
+// with the highest projections. Players on injured reserve in current stay there, everyone who
+// isn't started goes to the bench, and slots with no available player are left empty.
+func OptimizeLineup(rules RosterRules, players []LineupPlayer, current map[string]string) map[string]string {
+	lineup := make(map[string]string, len(players))
+	var candidates []LineupPlayer
+	for _, player := range players {
+		if current[player.ID] == InjuredReserveSpot {
+			lineup[player.ID] = InjuredReserveSpot
+			continue
+		}
+		lineup[player.ID] = BenchSpot
+		if player.Available() {
+			candidates = append(candidates, player)
+		}
+	}
+
+	// Best projections first, ties broken by ID so the result is stable
+	slices.SortFunc(candidates, func(a, b LineupPlayer) int {
+		if c := cmp.Compare(b.Projection, a.Projection); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	// Fill the most restrictive slots first. Each flexible slot accepts a superset of the
+	// positions the single-position slots do, so filling greedily in this order is optimal.
+	slots := make([]string, 0, len(rules.Slots))
+	for slot := range rules.Slots {
+		slots = append(slots, slot)
+	}
+	slices.SortFunc(slots, func(a, b string) int {
+		if c := cmp.Compare(len(EligiblePositions(a)), len(EligiblePositions(b))); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
+
+	started := make(map[string]bool)
+	for _, slot := range slots {
+		filled := 0
+		for _, player := range candidates {
+			if filled == rules.Slots[slot] {
+				break
+			}
+			if started[player.ID] || !CanStart(slot, player.Position) {
+				continue
+			}
+			lineup[player.ID] = slot
+			started[player.ID] = true
+			filled++
+		}
+	}
+	return lineup
+}
@@ -0,0 +1,140 @@
+package fantasy
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestCanStart(t *testing.T) {
+	tests := []struct {
+		slot     string
+		position string
+		expected bool
+	}{
+		{"QB", "QB", true},
+		{"QB", "RB", false},
+		{"FLEX", "RB", true},
+		{"FLEX", "WR", true},
+		{"FLEX", "TE", true},
+		{"FLEX", "QB", false},
+		{"FLEX", "PK", false},
+		{"FLEX", "LB", false},
+		{"IDP", "DL", true},
+		{"IDP", "DB", true},
+		{"IDP", "DST", false},
+		{"G", "PG", true},
+		{"G", "SF", false},
+		{"F", "PF", true},
+		{"UTIL", "C", true},
+		{"UTIL", "QB", false},
+		{"UTIL", "DH", true},
+		{"UTIL", "SP", false},
+		{"OF", "CF", true},
+		{"OF", "1B", false},
+		{"P", "RP", true},
+		{"P", "CATCHER", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.slot+" "+tt.position, func(t *testing.T) {
+			if result := CanStart(tt.slot, tt.position); result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func lineupPlayers() map[string]LineupPlayer {
+	return map[string]LineupPlayer{
+		"qb":      {ID: "qb", Position: "QB", Status: "ACTIVE"},
+		"rb":      {ID: "rb", Position: "RB", Status: "ACTIVE"},
+		"wr":      {ID: "wr", Position: "WR", Status: "ACTIVE"},
+		"injured": {ID: "injured", Position: "WR", Status: "INJURED"},
+		"suspend": {ID: "suspend", Position: "RB", Status: "SUSPENDED"},
+	}
+}
+
+func TestCheckLineup(t *testing.T) {
+	rules := RosterRules{
+		Slots:               map[string]int{"QB": 1, "RB": 1, "FLEX": 1},
+		BenchSpots:          2,
+		InjuredReserveSpots: 1,
+	}
+
+	tests := []struct {
+		name        string
+		lineup      map[string]string
+		expectError bool
+	}{
+		{"valid", map[string]string{"qb": "QB", "rb": "RB", "wr": "FLEX", "injured": "IR"}, false},
+		{"empty slots are allowed", map[string]string{"qb": BenchSpot, "rb": BenchSpot}, false},
+		{"wrong position", map[string]string{"rb": "QB"}, true},
+		{"quarterback at flex", map[string]string{"qb": "FLEX"}, true},
+		{"slot not in rules", map[string]string{"wr": "WR"}, true},
+		{"slot overfilled", map[string]string{"rb": "FLEX", "wr": "FLEX"}, true},
+		{"healthy player on IR", map[string]string{"qb": "IR"}, true},
+		{"suspended player on IR", map[string]string{"suspend": "IR"}, true},
+		{"player not on roster", map[string]string{"someone": BenchSpot}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rules.CheckLineup(tt.lineup, lineupPlayers())
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestOptimizeLineup(t *testing.T) {
+	rules := RosterRules{
+		Slots:               map[string]int{"QB": 1, "RB": 1, "WR": 1, "FLEX": 1},
+		BenchSpots:          4,
+		InjuredReserveSpots: 1,
+	}
+	players := []LineupPlayer{
+		{ID: "qb1", Position: "QB", Status: "ACTIVE", Projection: 20},
+		{ID: "qb2", Position: "QB", Status: "ACTIVE", Projection: 25, OnBye: true},
+		{ID: "rb1", Position: "RB", Status: "ACTIVE", Projection: 15},
+		{ID: "rb2", Position: "RB", Status: "ACTIVE", Projection: 12},
+		{ID: "rb3", Position: "RB", Status: "SUSPENDED", Projection: 30},
+		{ID: "wr1", Position: "WR", Status: "ACTIVE", Projection: 14},
+		{ID: "wr2", Position: "WR", Status: "ACTIVE", Projection: 9},
+		{ID: "wr3", Position: "WR", Status: "INJURED", Projection: 18},
+	}
+	current := map[string]string{
+		"qb2": "QB", "rb3": "RB", "wr2": "WR", "rb1": "FLEX",
+		"qb1": BenchSpot, "rb2": BenchSpot, "wr1": BenchSpot, "wr3": InjuredReserveSpot,
+	}
+
+	lineup := OptimizeLineup(rules, players, current)
+
+	expected := map[string]string{
+		"qb1": "QB", "rb1": "RB", "wr1": "WR", "rb2": "FLEX",
+		"qb2": BenchSpot, "rb3": BenchSpot, "wr2": BenchSpot, "wr3": InjuredReserveSpot,
+	}
+	if !maps.Equal(lineup, expected) {
+		t.Errorf("Expected %v, got %v", expected, lineup)
+	}
+}
+
+func TestOptimizeLineupLeavesUnfillableSlotsEmpty(t *testing.T) {
+	rules := RosterRules{Slots: map[string]int{"QB": 1, "TE": 1}, BenchSpots: 2}
+	players := []LineupPlayer{
+		{ID: "qb", Position: "QB", Status: "ACTIVE", Projection: 18},
+		{ID: "te", Position: "TE", Status: "PUP", Projection: 10},
+	}
+
+	lineup := OptimizeLineup(rules, players, map[string]string{"qb": BenchSpot, "te": "TE"})
+
+	if lineup["qb"] != "QB" {
+		t.Errorf("Expected qb to start, got %q", lineup["qb"])
+	}
+	if lineup["te"] != BenchSpot {
+		t.Errorf("Expected unavailable te on the bench, got %q", lineup["te"])
+	}
+}
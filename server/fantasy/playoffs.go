@@ -0,0 +1,299 @@
+package fantasy
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// Tiebreaker decides the order of teams with identical records when seeding the playoffs
+type Tiebreaker string
+
+const (
+	TiebreakerRecord     Tiebreaker = "RECORD"
+	TiebreakerPointsFor  Tiebreaker = "POINTS_FOR"
+	TiebreakerHeadToHead Tiebreaker = "HEAD_TO_HEAD"
+)
+
+// Bracket slots a winner can advance into
+const (
+	SlotHome = "HOME"
+	SlotAway = "AWAY"
+)
+
+// PlayoffSettings configures the post-season bracket for a fantasy season
+type PlayoffSettings struct {
+	// Teams is how many teams make the playoffs
+	Teams int
+	// Byes is how many top seeds skip the first round
+	Byes int
+	// WeeksPerRound is how many weeks of scoring decide each round
+	WeeksPerRound int
+	// Tiebreakers are applied in order when seeding
+	Tiebreakers []Tiebreaker
+}
+
+// DefaultTiebreakers seeds by record, then head-to-head, then points scored
+var DefaultTiebreakers = []Tiebreaker{TiebreakerRecord, TiebreakerHeadToHead, TiebreakerPointsFor}
+
+// DefaultPlayoffSettings is a one-week-per-round, four team bracket (two for tiny leagues)
+func DefaultPlayoffSettings(teamCount int) PlayoffSettings {
+	teams := 4
+	if teamCount < 4 {
+		teams = 2
+	}
+	return PlayoffSettings{
+		Teams:         teams,
+		Byes:          0,
+		WeeksPerRound: 1,
+		Tiebreakers:   DefaultTiebreakers,
+	}
+}
+
+// Validate checks the settings produce a complete single-elimination bracket
+func (s PlayoffSettings) Validate(teamCount int) error {
+	if s.Teams < 2 {
+		return fmt.Errorf("playoffs need at least 2 teams, got %d", s.Teams)
+	}
+	if s.Teams > teamCount {
+		return fmt.Errorf("playoffs cannot include %d teams when the league only has %d", s.Teams, teamCount)
+	}
+	if s.Byes < 0 || s.Byes >= s.Teams {
+		return fmt.Errorf("byes must be between 0 and %d, got %d", s.Teams-1, s.Byes)
+	}
+	if (s.Teams-s.Byes)%2 != 0 {
+		return fmt.Errorf("%d teams with %d byes leaves an odd number of teams in the first round", s.Teams, s.Byes)
+	}
+	if !isPowerOfTwo(s.secondRoundEntrants()) {
+		return fmt.Errorf("%d teams with %d byes does not leave a power of two after the first round", s.Teams, s.Byes)
+	}
+	if s.WeeksPerRound < 1 {
+		return fmt.Errorf("each round needs at least 1 week, got %d", s.WeeksPerRound)
+	}
+	for _, tb := range s.Tiebreakers {
+		switch tb {
+		case TiebreakerRecord, TiebreakerPointsFor, TiebreakerHeadToHead:
+		default:
+			return fmt.Errorf("unknown tiebreaker %q", tb)
+		}
+	}
+	return nil
+}
+
+// secondRoundEntrants is the bye teams plus the first round winners
+func (s PlayoffSettings) secondRoundEntrants() int {
+	return s.Byes + (s.Teams-s.Byes)/2
+}
+
+// Rounds is the number of rounds needed to crown a champion
+func (s PlayoffSettings) Rounds() int {
+	rounds := 1
+	for entrants := s.secondRoundEntrants(); entrants > 1; entrants /= 2 {
+		rounds++
+	}
+	return rounds
+}
+
+// TotalWeeks is how many weeks the playoffs take after the regular season
+func (s PlayoffSettings) TotalWeeks() int {
+	return s.Rounds() * s.WeeksPerRound
+}
+
+// RoundForWeek maps a season week onto a playoff round.
+// It returns 0 for regular season weeks and reports whether the week closes out its round.
+func (s PlayoffSettings) RoundForWeek(regularSeasonWeeks, week int) (round int, lastWeekOfRound bool) {
+	playoffWeek := week - regularSeasonWeeks
+	if playoffWeek < 1 {
+		return 0, false
+	}
+	round = (playoffWeek-1)/s.WeeksPerRound + 1
+	return round, playoffWeek%s.WeeksPerRound == 0
+}
+
+// BracketGame is a node in the playoff tree.
+// A zero seed means the slot is filled by the winner of an earlier game.
+type BracketGame struct {
+	Round    int
+	Position int
+	HomeSeed int
+	AwaySeed int
+	// NextGame is the index of the game the winner advances to, -1 for the final
+	NextGame int
+	NextSlot string
+}
+
+// BuildBracket lays out a fixed single-elimination bracket for the settings.
+// Games are ordered by round so every game appears before the game its winner feeds.
+// Seeds are paired so the top two seeds can only meet in the final.
+func BuildBracket(s PlayoffSettings) []BracketGame {
+	entrants := s.secondRoundEntrants()
+
+	// Each entrant slot is either a bye team or the winner of a first round game
+	type slot struct {
+		seed int // set for bye teams
+		game int // index of the feeding game, -1 for bye teams
+	}
+
+	var games []BracketGame
+	slots := make([]slot, 0, entrants)
+	for _, seed := range seededOrder(entrants) {
+		if seed <= s.Byes {
+			slots = append(slots, slot{seed: seed, game: -1})
+			continue
+		}
+		games = append(games, BracketGame{
+			Round:    1,
+			Position: len(games),
+			HomeSeed: seed,
+			AwaySeed: s.Teams + s.Byes + 1 - seed,
+			NextGame: -1,
+		})
+		slots = append(slots, slot{game: len(games) - 1})
+	}
+
+	for round := 2; len(slots) > 1; round++ {
+		next := make([]slot, 0, len(slots)/2)
+		for i := 0; i < len(slots); i += 2 {
+			home, away := slots[i], slots[i+1]
+			game := BracketGame{Round: round, Position: i / 2, HomeSeed: home.seed, AwaySeed: away.seed, NextGame: -1}
+			games = append(games, game)
+			index := len(games) - 1
+
+			if home.game >= 0 {
+				games[home.game].NextGame = index
+				games[home.game].NextSlot = SlotHome
+			}
+			if away.game >= 0 {
+				games[away.game].NextGame = index
+				games[away.game].NextSlot = SlotAway
+			}
+			next = append(next, slot{game: index})
+		}
+		slots = next
+	}
+
+	return games
+}
+
+// seededOrder returns seeds 1..n in bracket order, e.g. [1 8 4 5 2 7 3 6] for 8
+func seededOrder(n int) []int {
+	order := []int{1}
+	for size := 2; size <= n; size *= 2 {
+		expanded := make([]int, 0, size)
+		for _, seed := range order {
+			expanded = append(expanded, seed, size+1-seed)
+		}
+		order = expanded
+	}
+	return order
+}
+
+func isPowerOfTwo(n int) bool {
+	return n > 0 && n&(n-1) == 0
+}
+
+// HomeAdvances decides a playoff game. Ties go to the better (lower) seed.
+func HomeAdvances(homeScore, awayScore float64, homeSeed, awaySeed int) bool {
+	if homeScore != awayScore {
+		return homeScore > awayScore
+	}
+	return homeSeed <= awaySeed
+}
+
+// SeedTeams orders standings for the playoffs by applying each tiebreaker in turn
+// to groups of teams that are still tied. Teams tied on every tiebreaker keep
+// their standings order.
+func SeedTeams(standings []Standing, results []MatchupResult, tiebreakers []Tiebreaker) []Standing {
+	groups := [][]Standing{slices.Clone(standings)}
+
+	for _, tb := range tiebreakers {
+		var split [][]Standing
+		for _, group := range groups {
+			if len(group) < 2 {
+				split = append(split, group)
+				continue
+			}
+			split = append(split, splitByTiebreaker(group, results, tb)...)
+		}
+		groups = split
+	}
+
+	seeded := make([]Standing, 0, len(standings))
+	for _, group := range groups {
+		seeded = append(seeded, group...)
+	}
+	return seeded
+}
+
+// splitByTiebreaker sorts a tied group by the tiebreaker and breaks it into smaller tied groups
+func splitByTiebreaker(group []Standing, results []MatchupResult, tb Tiebreaker) [][]Standing {
+	keys := make(map[string]float64, len(group))
+	switch tb {
+	case TiebreakerRecord:
+		for _, s := range group {
+			keys[s.TeamID] = s.WinPercentage()
+		}
+	case TiebreakerPointsFor:
+		for _, s := range group {
+			keys[s.TeamID] = s.PointsFor
+		}
+	case TiebreakerHeadToHead:
+		keys = headToHeadPercentages(group, results)
+	}
+
+	sorted := slices.Clone(group)
+	slices.SortStableFunc(sorted, func(a, b Standing) int {
+		return cmp.Compare(keys[b.TeamID], keys[a.TeamID])
+	})
+
+	var split [][]Standing
+	start := 0
+	for i := 1; i <= len(sorted); i++ {
+		if i == len(sorted) || keys[sorted[i].TeamID] != keys[sorted[start].TeamID] {
+			split = append(split, sorted[start:i])
+			start = i
+		}
+	}
+	return split
+}
+
+// headToHeadPercentages is each team's win percentage in games against the rest of the group.
+// Teams that never played the others are treated as .500.
+func headToHeadPercentages(group []Standing, results []MatchupResult) map[string]float64 {
+	inGroup := make(map[string]bool, len(group))
+	for _, s := range group {
+		inGroup[s.TeamID] = true
+	}
+
+	records := make(map[string]*Standing, len(group))
+	for _, s := range group {
+		records[s.TeamID] = &Standing{TeamID: s.TeamID}
+	}
+	for _, r := range results {
+		if !inGroup[r.HomeTeamID] || !inGroup[r.AwayTeamID] {
+			continue
+		}
+		home, away := records[r.HomeTeamID], records[r.AwayTeamID]
+		switch {
+		case r.HomeScore > r.AwayScore:
+			home.Wins++
+			away.Losses++
+		case r.HomeScore < r.AwayScore:
+			away.Wins++
+			home.Losses++
+		default:
+			home.Ties++
+			away.Ties++
+		}
+	}
+
+	percentages := make(map[string]float64, len(group))
+	for id, record := range records {
+		if record.Wins+record.Losses+record.Ties == 0 {
+			percentages[id] = 0.5
+			continue
+		}
+		percentages[id] = record.WinPercentage()
+	}
+	return percentages
+}
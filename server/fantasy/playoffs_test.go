@@ -0,0 +1,228 @@
+package fantasy
+
+import (
+	"testing"
+)
+
+func TestPlayoffSettingsValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		settings  PlayoffSettings
+		teamCount int
+		wantErr   bool
+	}{
+		{"four team bracket", PlayoffSettings{Teams: 4, WeeksPerRound: 1}, 10, false},
+		{"six teams with two byes", PlayoffSettings{Teams: 6, Byes: 2, WeeksPerRound: 2}, 12, false},
+		{"two team final", PlayoffSettings{Teams: 2, WeeksPerRound: 1}, 2, false},
+		{"more playoff teams than league", PlayoffSettings{Teams: 8, WeeksPerRound: 1}, 6, true},
+		{"single team", PlayoffSettings{Teams: 1, WeeksPerRound: 1}, 6, true},
+		{"odd first round", PlayoffSettings{Teams: 5, Byes: 0, WeeksPerRound: 1}, 6, true},
+		{"six teams without byes", PlayoffSettings{Teams: 6, WeeksPerRound: 1}, 8, true},
+		{"everyone has a bye", PlayoffSettings{Teams: 4, Byes: 4, WeeksPerRound: 1}, 8, true},
+		{"zero week rounds", PlayoffSettings{Teams: 4, WeeksPerRound: 0}, 8, true},
+		{"unknown tiebreaker", PlayoffSettings{Teams: 4, WeeksPerRound: 1, Tiebreakers: []Tiebreaker{"COIN_FLIP"}}, 8, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate(tt.teamCount)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDefaultPlayoffSettings(t *testing.T) {
+	if s := DefaultPlayoffSettings(10); s.Teams != 4 || s.Validate(10) != nil {
+		t.Errorf("Expected valid 4 team default for a 10 team league, got %+v", s)
+	}
+	if s := DefaultPlayoffSettings(3); s.Teams != 2 || s.Validate(3) != nil {
+		t.Errorf("Expected valid 2 team default for a 3 team league, got %+v", s)
+	}
+}
+
+func TestPlayoffSettingsRoundsAndWeeks(t *testing.T) {
+	tests := []struct {
+		settings       PlayoffSettings
+		expectedRounds int
+		expectedWeeks  int
+	}{
+		{PlayoffSettings{Teams: 2, WeeksPerRound: 1}, 1, 1},
+		{PlayoffSettings{Teams: 4, WeeksPerRound: 1}, 2, 2},
+		{PlayoffSettings{Teams: 6, Byes: 2, WeeksPerRound: 1}, 3, 3},
+		{PlayoffSettings{Teams: 8, WeeksPerRound: 2}, 3, 6},
+	}
+
+	for _, tt := range tests {
+		if rounds := tt.settings.Rounds(); rounds != tt.expectedRounds {
+			t.Errorf("%+v: expected %d rounds, got %d", tt.settings, tt.expectedRounds, rounds)
+		}
+		if weeks := tt.settings.TotalWeeks(); weeks != tt.expectedWeeks {
+			t.Errorf("%+v: expected %d weeks, got %d", tt.settings, tt.expectedWeeks, weeks)
+		}
+	}
+}
+
+func TestRoundForWeek(t *testing.T) {
+	settings := PlayoffSettings{Teams: 4, WeeksPerRound: 2}
+
+	tests := []struct {
+		week     int
+		round    int
+		lastWeek bool
+	}{
+		{14, 0, false},
+		{15, 1, false},
+		{16, 1, true},
+		{17, 2, false},
+		{18, 2, true},
+	}
+
+	for _, tt := range tests {
+		round, lastWeek := settings.RoundForWeek(14, tt.week)
+		if round != tt.round || lastWeek != tt.lastWeek {
+			t.Errorf("Week %d: expected round %d (last=%v), got round %d (last=%v)", tt.week, tt.round, tt.lastWeek, round, lastWeek)
+		}
+	}
+}
+
+func TestBuildBracket(t *testing.T) {
+	t.Run("eight teams", func(t *testing.T) {
+		games := BuildBracket(PlayoffSettings{Teams: 8, WeeksPerRound: 1})
+
+		if len(games) != 7 {
+			t.Fatalf("Expected 7 games, got %d", len(games))
+		}
+
+		expectedFirstRound := [][2]int{{1, 8}, {4, 5}, {2, 7}, {3, 6}}
+		for i, pair := range expectedFirstRound {
+			if games[i].Round != 1 || games[i].HomeSeed != pair[0] || games[i].AwaySeed != pair[1] {
+				t.Errorf("Game %d: expected round 1 %d v %d, got round %d %d v %d",
+					i, pair[0], pair[1], games[i].Round, games[i].HomeSeed, games[i].AwaySeed)
+			}
+		}
+
+		final := games[len(games)-1]
+		if final.NextGame != -1 || final.Round != 3 {
+			t.Errorf("Expected last game to be the round 3 final, got %+v", final)
+		}
+
+		// 1 and 2 seeds must be on opposite halves of the bracket
+		if games[0].NextGame == games[2].NextGame {
+			t.Error("1 and 2 seeds should not meet before the final")
+		}
+	})
+
+	t.Run("byes go straight to the second round", func(t *testing.T) {
+		games := BuildBracket(PlayoffSettings{Teams: 6, Byes: 2, WeeksPerRound: 1})
+
+		if len(games) != 5 {
+			t.Fatalf("Expected 5 games, got %d", len(games))
+		}
+
+		// First round: 4 v 5 and 3 v 6
+		if games[0].HomeSeed != 4 || games[0].AwaySeed != 5 {
+			t.Errorf("Expected 4 v 5, got %d v %d", games[0].HomeSeed, games[0].AwaySeed)
+		}
+		if games[1].HomeSeed != 3 || games[1].AwaySeed != 6 {
+			t.Errorf("Expected 3 v 6, got %d v %d", games[1].HomeSeed, games[1].AwaySeed)
+		}
+
+		// 1 seed waits at home for the 4/5 winner
+		next := games[games[0].NextGame]
+		if next.Round != 2 || next.HomeSeed != 1 || games[0].NextSlot != SlotAway {
+			t.Errorf("Expected 4/5 winner to visit the 1 seed in round 2, got %+v via %s", next, games[0].NextSlot)
+		}
+	})
+
+	t.Run("every game feeds a later game", func(t *testing.T) {
+		games := BuildBracket(PlayoffSettings{Teams: 12, Byes: 4, WeeksPerRound: 1})
+		finals := 0
+		for i, g := range games {
+			if g.NextGame == -1 {
+				finals++
+				continue
+			}
+			if g.NextGame <= i {
+				t.Errorf("Game %d feeds earlier game %d", i, g.NextGame)
+			}
+			if g.NextSlot != SlotHome && g.NextSlot != SlotAway {
+				t.Errorf("Game %d has invalid next slot %q", i, g.NextSlot)
+			}
+		}
+		if finals != 1 {
+			t.Errorf("Expected exactly 1 final, got %d", finals)
+		}
+	})
+
+	t.Run("two team final", func(t *testing.T) {
+		games := BuildBracket(PlayoffSettings{Teams: 2, WeeksPerRound: 1})
+		if len(games) != 1 || games[0].HomeSeed != 1 || games[0].AwaySeed != 2 || games[0].NextGame != -1 {
+			t.Errorf("Expected a single 1 v 2 final, got %+v", games)
+		}
+	})
+}
+
+func TestHomeAdvances(t *testing.T) {
+	if !HomeAdvances(100, 90, 4, 1) {
+		t.Error("Higher score should advance regardless of seed")
+	}
+	if HomeAdvances(90, 100, 1, 4) {
+		t.Error("Lower score should not advance")
+	}
+	if HomeAdvances(100, 100, 3, 2) {
+		t.Error("Tie should go to the better seed")
+	}
+}
+
+func TestSeedTeams(t *testing.T) {
+	results := []MatchupResult{
+		{HomeTeamID: "a", AwayTeamID: "b", HomeScore: 90, AwayScore: 100},
+		{HomeTeamID: "a", AwayTeamID: "c", HomeScore: 120, AwayScore: 80},
+		{HomeTeamID: "b", AwayTeamID: "d", HomeScore: 70, AwayScore: 95},
+		{HomeTeamID: "c", AwayTeamID: "d", HomeScore: 110, AwayScore: 85},
+	}
+	standings := ComputeStandings([]string{"a", "b", "c", "d"}, results)
+
+	t.Run("record then head-to-head", func(t *testing.T) {
+		seeded := SeedTeams(standings, results, []Tiebreaker{TiebreakerRecord, TiebreakerHeadToHead, TiebreakerPointsFor})
+
+		if len(seeded) != 4 {
+			t.Fatalf("Expected 4 seeded teams, got %d", len(seeded))
+		}
+		// Every team is 1-1 overall and 1-1 within the group, so points for decides it
+		expected := []string{"a", "c", "d", "b"}
+		for i, id := range expected {
+			if seeded[i].TeamID != id {
+				t.Errorf("Expected %s at seed %d, got %s", id, i+1, seeded[i].TeamID)
+			}
+		}
+	})
+
+	t.Run("head-to-head breaks a two-way tie", func(t *testing.T) {
+		tied := []Standing{
+			{TeamID: "x", Wins: 5, Losses: 3, PointsFor: 900},
+			{TeamID: "y", Wins: 5, Losses: 3, PointsFor: 850},
+		}
+		h2h := []MatchupResult{{HomeTeamID: "x", AwayTeamID: "y", HomeScore: 80, AwayScore: 95}}
+
+		seeded := SeedTeams(tied, h2h, DefaultTiebreakers)
+		if seeded[0].TeamID != "y" {
+			t.Errorf("Expected head-to-head winner y to be the top seed, got %s", seeded[0].TeamID)
+		}
+
+		seeded = SeedTeams(tied, h2h, []Tiebreaker{TiebreakerRecord, TiebreakerPointsFor})
+		if seeded[0].TeamID != "x" {
+			t.Errorf("Expected points leader x to be the top seed without head-to-head, got %s", seeded[0].TeamID)
+		}
+	})
+
+	t.Run("does not reorder input", func(t *testing.T) {
+		input := []Standing{{TeamID: "p", PointsFor: 1}, {TeamID: "q", PointsFor: 2}}
+		SeedTeams(input, nil, []Tiebreaker{TiebreakerPointsFor})
+		if input[0].TeamID != "p" {
+			t.Error("SeedTeams should not modify its input")
+		}
+	})
+}
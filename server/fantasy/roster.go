@@ -0,0 +1,170 @@
+package fantasy
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Roster spots that never count toward a team's score
+const (
+	BenchSpot          = "BN"
+	InjuredReserveSpot = "IR"
+)
+
+// IsStartingSpot reports whether a fantasy_rosters.roster_spot value is a starting slot
+func IsStartingSpot(spot string) bool {
+	return spot != "" && spot != BenchSpot && spot != InjuredReserveSpot
+}
+
+// RosterRules describes the roster a draft room's teams must fit into.
+// It is stored as JSON on draft_rooms.roster_rules.
+type RosterRules struct {
+	// Slots is the number of starters per lineup slot, e.g. "RB": 2 or "FLEX": 1
+	Slots map[string]int `json:"slots"`
+	// BenchSpots is how many reserves a team may carry
+	BenchSpots int `json:"benchSpots"`
+	// InjuredReserveSpots hold injured players without using an active roster spot
+	InjuredReserveSpots int `json:"injuredReserveSpots"`
+	// MaxPerPosition optionally caps how many players of a position a team may hold
+	MaxPerPosition map[string]int `json:"maxPerPosition,omitempty"`
+}
+
+// DefaultRosterRules is a standard single-QB football lineup with a six man bench
+var DefaultRosterRules = RosterRules{
+	Slots: map[string]int{
+		"QB":   1,
+		"RB":   2,
+		"WR":   2,
+		"TE":   1,
+		"FLEX": 1,
+		"PK":   1,
+		"DST":  1,
+	},
+	BenchSpots:          6,
+	InjuredReserveSpots: 1,
+}
+
+// IDPRosterRules extends the standard football lineup with a starter at each level of the
+// defense and a defensive flex, for leagues that draft individual defenders. A draft room
+// opts in by storing these as its roster rules.
+var IDPRosterRules = RosterRules{
+	Slots: map[string]int{
+		"QB":              1,
+		"RB":              2,
+		"WR":              2,
+		"TE":              1,
+		"FLEX":            1,
+		"PK":              1,
+		"DST":             1,
+		"DL":              2,
+		"LB":              2,
+		"DB":              2,
+		DefensiveFlexSlot: 1,
+	},
+	BenchSpots:          7,
+	InjuredReserveSpots: 1,
+}
+
+// DefaultBasketballRosterRules is a standard ten man basketball lineup with a three man bench
+var DefaultBasketballRosterRules = RosterRules{
+	Slots: map[string]int{
+		"PG":        1,
+		"SG":        1,
+		"SF":        1,
+		"PF":        1,
+		"C":         1,
+		GuardSlot:   1,
+		ForwardSlot: 1,
+		UtilitySlot: 3,
+	},
+	BenchSpots:          3,
+	InjuredReserveSpots: 1,
+}
+
+// DefaultBaseballRosterRules is a standard rotisserie lineup: every infield spot, three outfielders,
+// a utility hitter and six pitchers, with a five man bench
+var DefaultBaseballRosterRules = RosterRules{
+	Slots: map[string]int{
+		"CATCHER":    1,
+		"1B":         1,
+		"2B":         1,
+		"3B":         1,
+		"SS":         1,
+		OutfieldSlot: 3,
+		UtilitySlot:  1,
+		"SP":         2,
+		"RP":         2,
+		PitcherSlot:  2,
+	},
+	BenchSpots:          5,
+	InjuredReserveSpots: 1,
+}
+
+// DefaultRosterRulesFor returns the default roster rules of a draft room's sport
+func DefaultRosterRulesFor(sportType string) RosterRules {
+	switch sportType {
+	case "BASKETBALL":
+		return DefaultBasketballRosterRules
+	case "BASEBALL":
+		return DefaultBaseballRosterRules
+	default:
+		return DefaultRosterRules
+	}
+}
+
+// ParseRosterRules decodes stored roster rules, falling back to the defaults when none are set
+func ParseRosterRules(raw []byte) (RosterRules, error) {
+	if len(raw) == 0 {
+		return DefaultRosterRules, nil
+	}
+
+	var rules RosterRules
+	if err := json.Unmarshal(raw, &rules); err != nil {
+		return RosterRules{}, fmt.Errorf("invalid roster rules: %w", err)
+	}
+	return rules, nil
+}
+
+// MaxActivePlayers is the most players a team may hold outside of injured reserve
+func (r RosterRules) MaxActivePlayers() int {
+	total := r.BenchSpots
+	for _, count := range r.Slots {
+		total += count
+	}
+	return total
+}
+
+// ActivePlayers counts the players on a roster (player ID to roster spot) outside of injured reserve
+func ActivePlayers(roster map[string]string) int {
+	active := 0
+	for _, spot := range roster {
+		if spot != InjuredReserveSpot {
+			active++
+		}
+	}
+	return active
+}
+
+// CheckRoster returns an error if a roster (player ID to roster spot) breaks the rules.
+// positions maps each player ID to their pro position.
+func (r RosterRules) CheckRoster(roster map[string]string, positions map[string]string) error {
+	if active := ActivePlayers(roster); active > r.MaxActivePlayers() {
+		return fmt.Errorf("roster would have %d active players, the limit is %d", active, r.MaxActivePlayers())
+	}
+
+	injured := len(roster) - ActivePlayers(roster)
+	if injured > r.InjuredReserveSpots {
+		return fmt.Errorf("roster would have %d players on injured reserve, the limit is %d", injured, r.InjuredReserveSpots)
+	}
+
+	counts := make(map[string]int)
+	for playerID := range roster {
+		counts[positions[playerID]]++
+	}
+	for position, limit := range r.MaxPerPosition {
+		if counts[position] > limit {
+			return fmt.Errorf("roster would have %d %s, the limit is %d", counts[position], position, limit)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package fantasy
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIsStartingSpot(t *testing.T) {
+	tests := []struct {
+		spot     string
+		expected bool
+	}{
+		{"QB", true},
+		{"WR1", true},
+		{"FLEX", true},
+		{"BN", false},
+		{"IR", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if result := IsStartingSpot(tt.spot); result != tt.expected {
+			t.Errorf("IsStartingSpot(%q) = %v, expected %v", tt.spot, result, tt.expected)
+		}
+	}
+}
+
+func TestParseRosterRules(t *testing.T) {
+	tests := []struct {
+		name        string
+		raw         string
+		expectedMax int
+		expectError bool
+	}{
+		{"empty uses defaults", "", 15, false},
+		{"custom rules", `{"slots":{"QB":2,"RB":2},"benchSpots":3,"injuredReserveSpots":0}`, 7, false},
+		{"invalid json", `{"slots":`, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := ParseRosterRules([]byte(tt.raw))
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if max := rules.MaxActivePlayers(); max != tt.expectedMax {
+				t.Errorf("Expected %d active players, got %d", tt.expectedMax, max)
+			}
+		})
+	}
+}
+
+func TestDefaultRosterRulesFor(t *testing.T) {
+	if max := DefaultRosterRulesFor("FOOTBALL").MaxActivePlayers(); max != 15 {
+		t.Errorf("Expected 15 active football players, got %d", max)
+	}
+	if max := DefaultRosterRulesFor("BASKETBALL").MaxActivePlayers(); max != 13 {
+		t.Errorf("Expected 13 active basketball players, got %d", max)
+	}
+	if max := DefaultRosterRulesFor("BASEBALL").MaxActivePlayers(); max != 20 {
+		t.Errorf("Expected 20 active baseball players, got %d", max)
+	}
+}
+
+func TestIDPRosterRules(t *testing.T) {
+	if max := IDPRosterRules.MaxActivePlayers(); max != 23 {
+		t.Errorf("Expected 23 active players in an IDP league, got %d", max)
+	}
+
+	raw, err := json.Marshal(IDPRosterRules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules, err := ParseRosterRules(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rules.Slots[DefensiveFlexSlot] != 1 || rules.Slots["LB"] != 2 {
+		t.Errorf("Expected stored IDP rules to keep their defensive slots, got %v", rules.Slots)
+	}
+}
+
+func TestActivePlayers(t *testing.T) {
+	roster := map[string]string{
+		"p1": "QB",
+		"p2": BenchSpot,
+		"p3": InjuredReserveSpot,
+	}
+	if active := ActivePlayers(roster); active != 2 {
+		t.Errorf("Expected 2 active players, got %d", active)
+	}
+}
+
+func TestCheckRoster(t *testing.T) {
+	rules := RosterRules{
+		Slots:               map[string]int{"QB": 1, "RB": 1},
+		BenchSpots:          1,
+		InjuredReserveSpots: 1,
+		MaxPerPosition:      map[string]int{"QB": 1},
+	}
+	positions := map[string]string{"qb1": "QB", "qb2": "QB", "rb1": "RB", "rb2": "RB", "rb3": "RB", "rb4": "RB"}
+
+	tests := []struct {
+		name        string
+		roster      map[string]string
+		expectError bool
+	}{
+		{"legal", map[string]string{"qb1": "QB", "rb1": "RB", "rb2": BenchSpot, "rb3": InjuredReserveSpot}, false},
+		{"too many active", map[string]string{"qb1": "QB", "rb1": "RB", "rb2": BenchSpot, "rb3": BenchSpot}, true},
+		{"too many on IR", map[string]string{"qb1": "QB", "rb1": InjuredReserveSpot, "rb2": InjuredReserveSpot}, true},
+		{"over position limit", map[string]string{"qb1": "QB", "qb2": BenchSpot}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := rules.CheckRoster(tt.roster, positions)
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
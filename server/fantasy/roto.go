@@ -0,0 +1,184 @@
+package fantasy
+
+import (
+	"cmp"
+	"slices"
+
+	"fantasy-draft/graph/model"
+)
+
+// BattingAverage is hits per at bat, or zero without any at bats
+func BattingAverage(stats model.BaseballStats) float64 {
+	if stats.AtBats == 0 {
+		return 0
+	}
+	return float64(stats.Hits) / float64(stats.AtBats)
+}
+
+// InningsPitched converts outs to innings as a decimal, so 200 outs is 66.67 innings
+// (written 66.2 on a box score)
+func InningsPitched(stats model.BaseballStats) float64 {
+	return float64(stats.OutsPitched) / 3
+}
+
+// EarnedRunAverage is earned runs per nine innings, or zero without any innings pitched
+func EarnedRunAverage(stats model.BaseballStats) float64 {
+	if stats.OutsPitched == 0 {
+		return 0
+	}
+	return float64(stats.EarnedRuns) * 27 / float64(stats.OutsPitched)
+}
+
+// WHIP is walks plus hits allowed per inning, or zero without any innings pitched
+func WHIP(stats model.BaseballStats) float64 {
+	if stats.OutsPitched == 0 {
+		return 0
+	}
+	return float64(stats.WalksAllowed+stats.HitsAllowed) * 3 / float64(stats.OutsPitched)
+}
+
+// AddBaseballStats sums the counting stats of two stat lines. Rate stats are left unset;
+// derive them from the totals.
+func AddBaseballStats(a, b model.BaseballStats) model.BaseballStats {
+	return model.BaseballStats{
+		AtBats:       a.AtBats + b.AtBats,
+		Hits:         a.Hits + b.Hits,
+		HomeRuns:     a.HomeRuns + b.HomeRuns,
+		RunsBattedIn: a.RunsBattedIn + b.RunsBattedIn,
+		StolenBases:  a.StolenBases + b.StolenBases,
+		OutsPitched:  a.OutsPitched + b.OutsPitched,
+		Strikeouts:   a.Strikeouts + b.Strikeouts,
+		EarnedRuns:   a.EarnedRuns + b.EarnedRuns,
+		HitsAllowed:  a.HitsAllowed + b.HitsAllowed,
+		WalksAllowed: a.WalksAllowed + b.WalksAllowed,
+		Saves:        a.Saves + b.Saves,
+	}
+}
+
+// RotoCategory is one stat a rotisserie league ranks teams by
+type RotoCategory struct {
+	Name string
+	// LowerIsBetter ranks the smallest total first, as for ERA and WHIP
+	LowerIsBetter bool
+	// Value returns a team's total, or false when the team has none (a rate without a denominator)
+	Value func(stats model.BaseballStats) (float64, bool)
+}
+
+func countingCategory(name string, stat func(model.BaseballStats) int) RotoCategory {
+	return RotoCategory{
+		Name:  name,
+		Value: func(stats model.BaseballStats) (float64, bool) { return float64(stat(stats)), true },
+	}
+}
+
+func pitchingRateCategory(name string, rate func(model.BaseballStats) float64) RotoCategory {
+	return RotoCategory{
+		Name:          name,
+		LowerIsBetter: true,
+		Value: func(stats model.BaseballStats) (float64, bool) {
+			return rate(stats), stats.OutsPitched > 0
+		},
+	}
+}
+
+// StandardBaseballCategories is a 4x4 rotisserie format that scores strikeouts in place of wins:
+// HR, RBI, SB and AVG for hitters; K, SV, ERA and WHIP for pitchers
+var StandardBaseballCategories = []RotoCategory{
+	countingCategory("HR", func(s model.BaseballStats) int { return s.HomeRuns }),
+	countingCategory("RBI", func(s model.BaseballStats) int { return s.RunsBattedIn }),
+	countingCategory("SB", func(s model.BaseballStats) int { return s.StolenBases }),
+	{
+		Name: "AVG",
+		Value: func(s model.BaseballStats) (float64, bool) {
+			return BattingAverage(s), s.AtBats > 0
+		},
+	},
+	countingCategory("K", func(s model.BaseballStats) int { return s.Strikeouts }),
+	countingCategory("SV", func(s model.BaseballStats) int { return s.Saves }),
+	pitchingRateCategory("ERA", EarnedRunAverage),
+	pitchingRateCategory("WHIP", WHIP),
+}
+
+// RotoCategoryResult is a team's total in one category and the points it earned
+type RotoCategoryResult struct {
+	Category string
+	// Value is the team's total; HasValue is false when it has none
+	Value    float64
+	HasValue bool
+	Points   float64
+}
+
+// RotoStanding is a team's place in a rotisserie league
+type RotoStanding struct {
+	TeamID     string
+	Points     float64
+	Categories []RotoCategoryResult
+}
+
+// ComputeRotoStandings ranks teams in each category on their stat totals. With n teams the
+// best total earns n points and the worst earns 1; tied teams split the points of the places
+// they share, and teams without a total take the bottom places. Teams are ordered by their
+// points across all categories, keeping teamIDs order between equal totals.
+func ComputeRotoStandings(teamIDs []string, totals map[string]model.BaseballStats, categories []RotoCategory) []RotoStanding {
+	standings := make([]*RotoStanding, len(teamIDs))
+	for i, id := range teamIDs {
+		standings[i] = &RotoStanding{TeamID: id}
+	}
+
+	for _, category := range categories {
+		results := make([]RotoCategoryResult, len(teamIDs))
+		for i, id := range teamIDs {
+			value, ok := category.Value(totals[id])
+			results[i] = RotoCategoryResult{Category: category.Name, Value: value, HasValue: ok}
+		}
+
+		// compare orders results best first, teams without a total last
+		compare := func(a, b RotoCategoryResult) int {
+			if a.HasValue != b.HasValue {
+				if a.HasValue {
+					return -1
+				}
+				return 1
+			}
+			if category.LowerIsBetter {
+				return cmp.Compare(a.Value, b.Value)
+			}
+			return cmp.Compare(b.Value, a.Value)
+		}
+
+		order := make([]int, len(results))
+		for i := range order {
+			order[i] = i
+		}
+		slices.SortStableFunc(order, func(a, b int) int { return compare(results[a], results[b]) })
+
+		// Give each run of tied teams the average of the points for the places they hold
+		for start := 0; start < len(order); {
+			end := start + 1
+			for end < len(order) && compare(results[order[start]], results[order[end]]) == 0 {
+				end++
+			}
+			best := float64(len(order) - start)
+			worst := float64(len(order) - end + 1)
+			for _, i := range order[start:end] {
+				results[i].Points = (best + worst) / 2
+			}
+			start = end
+		}
+
+		for i, result := range results {
+			standings[i].Categories = append(standings[i].Categories, result)
+			standings[i].Points += result.Points
+		}
+	}
+
+	slices.SortStableFunc(standings, func(a, b *RotoStanding) int {
+		return cmp.Compare(b.Points, a.Points)
+	})
+
+	sorted := make([]RotoStanding, len(standings))
+	for i, s := range standings {
+		sorted[i] = *s
+	}
+	return sorted
+}
@@ -0,0 +1,88 @@
+package fantasy
+
+import (
+	"fmt"
+	"testing"
+
+	"fantasy-draft/graph/model"
+)
+
+func TestBaseballRates(t *testing.T) {
+	stats := model.BaseballStats{
+		AtBats:       500,
+		Hits:         150,
+		OutsPitched:  600,
+		EarnedRuns:   60,
+		HitsAllowed:  170,
+		WalksAllowed: 50,
+	}
+
+	if avg := BattingAverage(stats); avg != 0.3 {
+		t.Errorf("Expected a .300 average, got %.3f", avg)
+	}
+	if ip := InningsPitched(stats); ip != 200 {
+		t.Errorf("Expected 200 innings, got %.2f", ip)
+	}
+	if era := EarnedRunAverage(stats); era != 2.7 {
+		t.Errorf("Expected a 2.70 ERA, got %.2f", era)
+	}
+	if whip := WHIP(stats); whip != 1.1 {
+		t.Errorf("Expected a 1.10 WHIP, got %.2f", whip)
+	}
+
+	empty := model.BaseballStats{}
+	if BattingAverage(empty) != 0 || EarnedRunAverage(empty) != 0 || WHIP(empty) != 0 {
+		t.Error("Expected zero rates without at bats or innings")
+	}
+}
+
+func TestComputeRotoStandings(t *testing.T) {
+	categories := []RotoCategory{
+		countingCategory("HR", func(s model.BaseballStats) int { return s.HomeRuns }),
+		pitchingRateCategory("ERA", EarnedRunAverage),
+	}
+	totals := map[string]model.BaseballStats{
+		"a": {HomeRuns: 40, OutsPitched: 27, EarnedRuns: 3},
+		"b": {HomeRuns: 40, OutsPitched: 27, EarnedRuns: 1},
+		"c": {HomeRuns: 10, OutsPitched: 27, EarnedRuns: 5},
+		"d": {HomeRuns: 25},
+	}
+
+	standings := ComputeRotoStandings([]string{"a", "b", "c", "d"}, totals, categories)
+
+	// HR: a and b split 4 and 3, d 2, c 1. ERA: b 4, a 3, c 2, d has no innings and takes 1.
+	expected := []struct {
+		team   string
+		points float64
+	}{
+		{"b", 7.5},
+		{"a", 6.5},
+		{"c", 3},
+		{"d", 3},
+	}
+	if len(standings) != len(expected) {
+		t.Fatalf("Expected %d standings, got %d", len(expected), len(standings))
+	}
+	for i, e := range expected {
+		s := standings[i]
+		if s.TeamID != e.team || s.Points != e.points {
+			t.Errorf("Expected %s with %.1f points at position %d, got %s with %.1f", e.team, e.points, i+1, s.TeamID, s.Points)
+		}
+	}
+
+	d := standings[3]
+	if era := d.Categories[1]; era.HasValue || era.Points != 1 {
+		t.Errorf("Expected d to have no ERA and 1 point, got %v with %.1f", era.HasValue, era.Points)
+	}
+}
+
+func TestComputeRotoStandingsWithoutStats(t *testing.T) {
+	standings := ComputeRotoStandings([]string{"a", "b", "c"}, nil, StandardBaseballCategories)
+
+	for _, s := range standings {
+		// Every team ties in every category and splits the 6 points on offer
+		if fmt.Sprintf("%.1f", s.Points) != fmt.Sprintf("%.1f", 2.0*float64(len(StandardBaseballCategories))) {
+			t.Errorf("Expected %s to have %d points, got %.1f", s.TeamID, 2*len(StandardBaseballCategories), s.Points)
+		}
+	}
+}
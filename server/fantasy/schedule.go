@@ -0,0 +1,64 @@
+package fantasy
+
+import "fmt"
+
+// DefaultRegularSeasonWeeks is used when the commissioner doesn't pick a season length
+const DefaultRegularSeasonWeeks = 14
+
+// Pairing is a single head-to-head game between two fantasy teams
+type Pairing struct {
+	HomeTeamID string
+	AwayTeamID string
+}
+
+// GenerateSchedule builds a round-robin schedule for the given teams.
+// It uses the circle method: the first team stays fixed while the rest rotate,
+// so every team plays every other team once per cycle. When there are more
+// weeks than a single cycle the rotation keeps going and home/away flips.
+// With an odd number of teams one team has a bye each week.
+func GenerateSchedule(teamIDs []string, weeks int) ([][]Pairing, error) {
+	if len(teamIDs) < 2 {
+		return nil, fmt.Errorf("need at least 2 teams to build a schedule, got %d", len(teamIDs))
+	}
+	if weeks < 1 {
+		return nil, fmt.Errorf("season must have at least 1 week, got %d", weeks)
+	}
+
+	// Pad with an empty slot for byes so the circle always has an even size
+	rotation := make([]string, len(teamIDs))
+	copy(rotation, teamIDs)
+	if len(rotation)%2 == 1 {
+		rotation = append(rotation, "")
+	}
+
+	size := len(rotation)
+	roundsPerCycle := size - 1
+	schedule := make([][]Pairing, weeks)
+
+	for week := range weeks {
+		round := week % roundsPerCycle
+		cycle := week / roundsPerCycle
+		pairings := make([]Pairing, 0, size/2)
+
+		for i := range size / 2 {
+			home := rotation[i]
+			away := rotation[size-1-i]
+			if home == "" || away == "" {
+				continue
+			}
+			// Alternate home field so the fixed team isn't always at home
+			if (i == 0 && round%2 == 1) != (cycle%2 == 1) {
+				home, away = away, home
+			}
+			pairings = append(pairings, Pairing{HomeTeamID: home, AwayTeamID: away})
+		}
+		schedule[week] = pairings
+
+		// Rotate everyone except the first slot one place clockwise
+		last := rotation[size-1]
+		copy(rotation[2:], rotation[1:size-1])
+		rotation[1] = last
+	}
+
+	return schedule, nil
+}
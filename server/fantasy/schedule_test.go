@@ -0,0 +1,125 @@
+package fantasy
+
+import (
+	"testing"
+)
+
+func TestGenerateSchedule(t *testing.T) {
+	t.Run("even team count plays everyone once per cycle", func(t *testing.T) {
+		teams := []string{"a", "b", "c", "d", "e", "f"}
+		schedule, err := GenerateSchedule(teams, 5)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+
+		if len(schedule) != 5 {
+			t.Fatalf("Expected 5 weeks, got %d", len(schedule))
+		}
+
+		opponents := make(map[string]map[string]bool)
+		for week, pairings := range schedule {
+			if len(pairings) != 3 {
+				t.Errorf("Week %d: expected 3 games, got %d", week+1, len(pairings))
+			}
+			playing := make(map[string]bool)
+			for _, p := range pairings {
+				if playing[p.HomeTeamID] || playing[p.AwayTeamID] {
+					t.Errorf("Week %d: a team is scheduled twice", week+1)
+				}
+				playing[p.HomeTeamID] = true
+				playing[p.AwayTeamID] = true
+
+				if opponents[p.HomeTeamID] == nil {
+					opponents[p.HomeTeamID] = make(map[string]bool)
+				}
+				if opponents[p.AwayTeamID] == nil {
+					opponents[p.AwayTeamID] = make(map[string]bool)
+				}
+				opponents[p.HomeTeamID][p.AwayTeamID] = true
+				opponents[p.AwayTeamID][p.HomeTeamID] = true
+			}
+		}
+
+		for _, team := range teams {
+			if len(opponents[team]) != len(teams)-1 {
+				t.Errorf("Team %s played %d distinct opponents, expected %d", team, len(opponents[team]), len(teams)-1)
+			}
+		}
+	})
+
+	t.Run("odd team count gives one bye per week", func(t *testing.T) {
+		schedule, err := GenerateSchedule([]string{"a", "b", "c", "d", "e"}, 10)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+
+		byes := make(map[string]int)
+		for week, pairings := range schedule {
+			if len(pairings) != 2 {
+				t.Errorf("Week %d: expected 2 games, got %d", week+1, len(pairings))
+			}
+			playing := make(map[string]bool)
+			for _, p := range pairings {
+				if p.HomeTeamID == "" || p.AwayTeamID == "" {
+					t.Errorf("Week %d: bye slot leaked into a pairing", week+1)
+				}
+				playing[p.HomeTeamID] = true
+				playing[p.AwayTeamID] = true
+			}
+			for _, team := range []string{"a", "b", "c", "d", "e"} {
+				if !playing[team] {
+					byes[team]++
+				}
+			}
+		}
+
+		for team, count := range byes {
+			if count != 2 {
+				t.Errorf("Team %s had %d byes over 10 weeks, expected 2", team, count)
+			}
+		}
+	})
+
+	t.Run("home and away are balanced over two cycles", func(t *testing.T) {
+		teams := []string{"a", "b", "c", "d"}
+		schedule, err := GenerateSchedule(teams, 6)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+
+		homeGames := make(map[string]int)
+		for _, pairings := range schedule {
+			for _, p := range pairings {
+				homeGames[p.HomeTeamID]++
+			}
+		}
+		for _, team := range teams {
+			if homeGames[team] != 3 {
+				t.Errorf("Team %s had %d home games, expected 3", team, homeGames[team])
+			}
+		}
+	})
+
+	t.Run("does not mutate input", func(t *testing.T) {
+		teams := []string{"a", "b", "c", "d"}
+		if _, err := GenerateSchedule(teams, 3); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		expected := []string{"a", "b", "c", "d"}
+		for i := range teams {
+			if teams[i] != expected[i] {
+				t.Errorf("Input slice was modified: %v", teams)
+				break
+			}
+		}
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		if _, err := GenerateSchedule([]string{"a"}, 14); err == nil {
+			t.Error("Expected error for a single team")
+		}
+		if _, err := GenerateSchedule([]string{"a", "b"}, 0); err == nil {
+			t.Error("Expected error for zero weeks")
+		}
+	})
+}
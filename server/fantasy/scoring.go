@@ -0,0 +1,198 @@
+package fantasy
+
+import (
+	"math"
+
+	"fantasy-draft/graph/model"
+)
+
+// FootballScoring holds the points awarded per unit of each football stat.
+// Yardage values are points per yard (e.g. 0.04 = 1 point every 25 yards). Long field goals earn
+// their distance bonus on top of FieldGoalsMade.
+type FootballScoring struct {
+	PassingYards         float64
+	PassingTDs           float64
+	PassingInterceptions float64
+	RushingYards         float64
+	RushingTDs           float64
+	Receptions           float64
+	ReceivingYards       float64
+	ReceivingTDs         float64
+	FumblesLost          float64
+	FieldGoalsMade       float64
+	FieldGoalsMade40To49 float64
+	FieldGoalsMade50Plus float64
+	FieldGoalsMissed     float64
+	ExtraPointsMade      float64
+	ExtraPointsMissed    float64
+}
+
+// StandardFootballScoring is a full-PPR scoring profile used by default for every league.
+var StandardFootballScoring = FootballScoring{
+	PassingYards:         0.04,
+	PassingTDs:           4,
+	PassingInterceptions: -2,
+	RushingYards:         0.1,
+	RushingTDs:           6,
+	Receptions:           1,
+	ReceivingYards:       0.1,
+	ReceivingTDs:         6,
+	FumblesLost:          -2,
+	FieldGoalsMade:       3,
+	FieldGoalsMade40To49: 1,
+	FieldGoalsMade50Plus: 2,
+	FieldGoalsMissed:     -1,
+	ExtraPointsMade:      1,
+	ExtraPointsMissed:    -1,
+}
+
+// Score returns the fantasy points for a stat line, rounded to two decimals
+func (s FootballScoring) Score(stats model.FootballStats) float64 {
+	points := float64(stats.PassingYards)*s.PassingYards +
+		float64(stats.PassingTDs)*s.PassingTDs +
+		float64(stats.PassingInterceptions)*s.PassingInterceptions +
+		float64(stats.RushingYards)*s.RushingYards +
+		float64(stats.RushingTDs)*s.RushingTDs +
+		float64(stats.ReceivingReceptions)*s.Receptions +
+		float64(stats.ReceivingYards)*s.ReceivingYards +
+		float64(stats.ReceivingTDs)*s.ReceivingTDs +
+		float64(stats.FumblesLost)*s.FumblesLost +
+		float64(stats.FieldGoalsMade)*s.FieldGoalsMade +
+		float64(stats.FieldGoalsMade40To49)*s.FieldGoalsMade40To49 +
+		float64(stats.FieldGoalsMade50Plus)*s.FieldGoalsMade50Plus +
+		float64(stats.FieldGoalsMissed)*s.FieldGoalsMissed +
+		float64(stats.ExtraPointsMade)*s.ExtraPointsMade +
+		float64(stats.ExtraPointsMissed)*s.ExtraPointsMissed
+	return roundPoints(points)
+}
+
+// PointsAllowedTier awards Points to a defense that allowed at most MaxPointsAllowed
+type PointsAllowedTier struct {
+	MaxPointsAllowed int
+	Points           float64
+}
+
+// DefenseScoring holds the points awarded to a team defense/special teams unit. Points allowed
+// are scored by the first tier the total falls within, and nothing past the last tier.
+type DefenseScoring struct {
+	Sacks              float64
+	Interceptions      float64
+	FumbleRecoveries   float64
+	DefensiveTDs       float64
+	PointsAllowedTiers []PointsAllowedTier
+}
+
+// StandardDefenseScoring is the common DST profile, rewarding shutouts and penalizing blowouts
+var StandardDefenseScoring = DefenseScoring{
+	Sacks:            1,
+	Interceptions:    2,
+	FumbleRecoveries: 2,
+	DefensiveTDs:     6,
+	PointsAllowedTiers: []PointsAllowedTier{
+		{0, 10},
+		{6, 7},
+		{13, 4},
+		{20, 1},
+		{27, 0},
+		{34, -1},
+		{math.MaxInt, -4},
+	},
+}
+
+// Score returns the fantasy points for a DST stat line, rounded to two decimals
+func (s DefenseScoring) Score(stats model.FootballStats) float64 {
+	points := float64(stats.Sacks)*s.Sacks +
+		float64(stats.Interceptions)*s.Interceptions +
+		float64(stats.FumbleRecoveries)*s.FumbleRecoveries +
+		float64(stats.DefensiveTDs)*s.DefensiveTDs
+	for _, tier := range s.PointsAllowedTiers {
+		if stats.PointsAllowed <= tier.MaxPointsAllowed {
+			points += tier.Points
+			break
+		}
+	}
+	return roundPoints(points)
+}
+
+// IDPScoring holds the points awarded per unit of each individual defensive player stat
+type IDPScoring struct {
+	Tackles         float64
+	AssistedTackles float64
+	Sacks           float64
+	Interceptions   float64
+	PassesDefended  float64
+	ForcedFumbles   float64
+}
+
+// StandardIDPScoring is a tackle-heavy profile where big plays are worth a few tackles
+var StandardIDPScoring = IDPScoring{
+	Tackles:         1,
+	AssistedTackles: 0.5,
+	Sacks:           2,
+	Interceptions:   3,
+	PassesDefended:  1,
+	ForcedFumbles:   3,
+}
+
+// Score returns the fantasy points for a defender's stat line, rounded to two decimals
+func (s IDPScoring) Score(stats model.FootballStats) float64 {
+	points := float64(stats.Tackles)*s.Tackles +
+		float64(stats.AssistedTackles)*s.AssistedTackles +
+		float64(stats.Sacks)*s.Sacks +
+		float64(stats.Interceptions)*s.Interceptions +
+		float64(stats.PassesDefended)*s.PassesDefended +
+		float64(stats.ForcedFumbles)*s.ForcedFumbles
+	return roundPoints(points)
+}
+
+// ScoreFootball scores a football stat line by the standard profile for the player's position:
+// DST units are scored on their team defense, defensive players on their IDP stats and everyone
+// else on their offense and kicking
+func ScoreFootball(position string, stats model.FootballStats) float64 {
+	switch position {
+	case "DST":
+		return StandardDefenseScoring.Score(stats)
+	case "DL", "LB", "DB":
+		return StandardIDPScoring.Score(stats)
+	default:
+		return StandardFootballScoring.Score(stats)
+	}
+}
+
+// BasketballScoring holds the points awarded per unit of each basketball stat
+type BasketballScoring struct {
+	Points            float64
+	ThreePointersMade float64
+	Rebounds          float64
+	Assists           float64
+	Steals            float64
+	Blocks            float64
+	Turnovers         float64
+}
+
+// StandardBasketballScoring is the points-league profile for basketball
+var StandardBasketballScoring = BasketballScoring{
+	Points:            1,
+	ThreePointersMade: 0.5,
+	Rebounds:          1.25,
+	Assists:           1.5,
+	Steals:            2,
+	Blocks:            2,
+	Turnovers:         -0.5,
+}
+
+// Score returns the fantasy points for a stat line, rounded to two decimals
+func (s BasketballScoring) Score(stats model.BasketballStats) float64 {
+	points := float64(stats.Points)*s.Points +
+		float64(stats.ThreePointersMade)*s.ThreePointersMade +
+		float64(stats.Rebounds)*s.Rebounds +
+		float64(stats.Assists)*s.Assists +
+		float64(stats.Steals)*s.Steals +
+		float64(stats.Blocks)*s.Blocks +
+		float64(stats.Turnovers)*s.Turnovers
+	return roundPoints(points)
+}
+
+func roundPoints(points float64) float64 {
+	return math.Round(points*100) / 100
+}
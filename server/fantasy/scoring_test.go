@@ -0,0 +1,151 @@
+package fantasy
+
+import (
+	"testing"
+
+	"fantasy-draft/graph/model"
+)
+
+func TestFootballScoringScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		stats    model.FootballStats
+		expected float64
+	}{
+		{"empty stat line", model.FootballStats{}, 0},
+		{
+			"quarterback",
+			model.FootballStats{PassingYards: 300, PassingTDs: 2, PassingInterceptions: 1, RushingYards: 20},
+			12 + 8 - 2 + 2,
+		},
+		{
+			"receiver with a lost fumble",
+			model.FootballStats{ReceivingReceptions: 7, ReceivingYards: 95, ReceivingTDs: 1, FumblesLost: 1},
+			7 + 9.5 + 6 - 2,
+		},
+		{
+			"kicker",
+			model.FootballStats{FieldGoalsMade: 3, FieldGoalsMissed: 1, ExtraPointsMade: 2, ExtraPointsMissed: 1},
+			9 - 1 + 2 - 1,
+		},
+		{
+			"kicker with long field goals",
+			model.FootballStats{FieldGoalsMade: 3, FieldGoalsMadeUnder40: 1, FieldGoalsMade40To49: 1, FieldGoalsMade50Plus: 1},
+			9 + 1 + 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := StandardFootballScoring.Score(tt.stats)
+			if result != tt.expected {
+				t.Errorf("Expected %.2f points, got %.2f", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestFootballScoringRoundsToHundredths(t *testing.T) {
+	result := StandardFootballScoring.Score(model.FootballStats{PassingYards: 333})
+	if result != 13.32 {
+		t.Errorf("Expected 13.32 points, got %v", result)
+	}
+}
+
+func TestDefenseScoringScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		stats    model.FootballStats
+		expected float64
+	}{
+		{"shutout", model.FootballStats{}, 10},
+		{
+			"takeaways returned for a score",
+			model.FootballStats{Sacks: 3, Interceptions: 2, FumbleRecoveries: 1, DefensiveTDs: 1, PointsAllowed: 10},
+			3 + 4 + 2 + 6 + 4,
+		},
+		{"tier boundary", model.FootballStats{PointsAllowed: 20}, 1},
+		{"blowout", model.FootballStats{Sacks: 1, PointsAllowed: 45}, 1 - 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := StandardDefenseScoring.Score(tt.stats)
+			if result != tt.expected {
+				t.Errorf("Expected %.2f points, got %.2f", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestScoreFootballByPosition(t *testing.T) {
+	// An offensive player's line never records points allowed, so it must not earn a shutout
+	stats := model.FootballStats{RushingYards: 50}
+	if result := ScoreFootball("RB", stats); result != 5 {
+		t.Errorf("Expected a running back to score 5 points, got %.2f", result)
+	}
+	if result := ScoreFootball("DST", model.FootballStats{Sacks: 2, PointsAllowed: 17}); result != 3 {
+		t.Errorf("Expected a defense to score 3 points, got %.2f", result)
+	}
+	if result := ScoreFootball("LB", model.FootballStats{Tackles: 6, Sacks: 1, PointsAllowed: 0}); result != 8 {
+		t.Errorf("Expected a linebacker to score 8 points, got %.2f", result)
+	}
+}
+
+func TestIDPScoringScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		stats    model.FootballStats
+		expected float64
+	}{
+		{"empty stat line", model.FootballStats{}, 0},
+		{
+			"linebacker",
+			model.FootballStats{Tackles: 7, AssistedTackles: 3, Sacks: 1, ForcedFumbles: 1},
+			7 + 1.5 + 2 + 3,
+		},
+		{
+			"cornerback",
+			model.FootballStats{Tackles: 4, PassesDefended: 2, Interceptions: 1},
+			4 + 2 + 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := StandardIDPScoring.Score(tt.stats)
+			if result != tt.expected {
+				t.Errorf("Expected %.2f points, got %.2f", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestBasketballScoringScore(t *testing.T) {
+	tests := []struct {
+		name     string
+		stats    model.BasketballStats
+		expected float64
+	}{
+		{"empty stat line", model.BasketballStats{}, 0},
+		{
+			"shooting guard",
+			model.BasketballStats{Points: 28, ThreePointersMade: 4, Rebounds: 5, Assists: 3, Turnovers: 2},
+			28 + 2 + 6.25 + 4.5 - 1,
+		},
+		{
+			"center",
+			model.BasketballStats{Points: 16, Rebounds: 13, Assists: 2, Steals: 1, Blocks: 3, Turnovers: 3},
+			16 + 16.25 + 3 + 2 + 6 - 1.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := StandardBasketballScoring.Score(tt.stats)
+			if result != tt.expected {
+				t.Errorf("Expected %.2f points, got %.2f", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package fantasy
+
+import (
+	"cmp"
+	"slices"
+)
+
+// MatchupResult is the final score of a completed head-to-head game
+type MatchupResult struct {
+	HomeTeamID string
+	AwayTeamID string
+	HomeScore  float64
+	AwayScore  float64
+}
+
+// Standing is a team's regular season record
+type Standing struct {
+	TeamID        string
+	Wins          int
+	Losses        int
+	Ties          int
+	PointsFor     float64
+	PointsAgainst float64
+}
+
+// WinPercentage counts ties as half a win
+func (s Standing) WinPercentage() float64 {
+	games := s.Wins + s.Losses + s.Ties
+	if games == 0 {
+		return 0
+	}
+	return (float64(s.Wins) + float64(s.Ties)/2) / float64(games)
+}
+
+// ComputeStandings tallies records for every team from the completed results.
+// Teams are ordered by win percentage, then points scored.
+// Teams without any results still appear with an empty record.
+func ComputeStandings(teamIDs []string, results []MatchupResult) []Standing {
+	byTeam := make(map[string]*Standing, len(teamIDs))
+	standings := make([]*Standing, 0, len(teamIDs))
+	for _, id := range teamIDs {
+		s := &Standing{TeamID: id}
+		byTeam[id] = s
+		standings = append(standings, s)
+	}
+
+	for _, result := range results {
+		home, okHome := byTeam[result.HomeTeamID]
+		away, okAway := byTeam[result.AwayTeamID]
+		if !okHome || !okAway {
+			continue
+		}
+
+		home.PointsFor += result.HomeScore
+		home.PointsAgainst += result.AwayScore
+		away.PointsFor += result.AwayScore
+		away.PointsAgainst += result.HomeScore
+
+		switch {
+		case result.HomeScore > result.AwayScore:
+			home.Wins++
+			away.Losses++
+		case result.HomeScore < result.AwayScore:
+			away.Wins++
+			home.Losses++
+		default:
+			home.Ties++
+			away.Ties++
+		}
+	}
+
+	slices.SortStableFunc(standings, func(a, b *Standing) int {
+		if c := cmp.Compare(b.WinPercentage(), a.WinPercentage()); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.PointsFor, a.PointsFor)
+	})
+
+	sorted := make([]Standing, len(standings))
+	for i, s := range standings {
+		s.PointsFor = roundPoints(s.PointsFor)
+		s.PointsAgainst = roundPoints(s.PointsAgainst)
+		sorted[i] = *s
+	}
+	return sorted
+}
@@ -0,0 +1,67 @@
+package fantasy
+
+import (
+	"testing"
+)
+
+func TestComputeStandings(t *testing.T) {
+	teams := []string{"a", "b", "c", "d"}
+	results := []MatchupResult{
+		{HomeTeamID: "a", AwayTeamID: "b", HomeScore: 110.5, AwayScore: 90},
+		{HomeTeamID: "c", AwayTeamID: "d", HomeScore: 80, AwayScore: 100},
+		{HomeTeamID: "a", AwayTeamID: "c", HomeScore: 95, AwayScore: 120},
+		{HomeTeamID: "b", AwayTeamID: "d", HomeScore: 100, AwayScore: 100},
+	}
+
+	standings := ComputeStandings(teams, results)
+
+	if len(standings) != 4 {
+		t.Fatalf("Expected 4 standings, got %d", len(standings))
+	}
+
+	// d: 1-0-1 (.750), a: 1-1 (.500, 205.5 PF), c: 1-1 (.500, 200 PF), b: 0-1-1 (.250)
+	expectedOrder := []string{"d", "a", "c", "b"}
+	for i, id := range expectedOrder {
+		if standings[i].TeamID != id {
+			t.Errorf("Expected %s at position %d, got %s", id, i+1, standings[i].TeamID)
+		}
+	}
+
+	d := standings[0]
+	if d.Wins != 1 || d.Losses != 0 || d.Ties != 1 {
+		t.Errorf("Expected d to be 1-0-1, got %d-%d-%d", d.Wins, d.Losses, d.Ties)
+	}
+	if d.PointsFor != 200 || d.PointsAgainst != 180 {
+		t.Errorf("Expected d to have 200 PF / 180 PA, got %.2f / %.2f", d.PointsFor, d.PointsAgainst)
+	}
+}
+
+func TestComputeStandingsWithoutResults(t *testing.T) {
+	standings := ComputeStandings([]string{"a", "b"}, nil)
+
+	if len(standings) != 2 {
+		t.Fatalf("Expected 2 standings, got %d", len(standings))
+	}
+	for _, s := range standings {
+		if s.Wins != 0 || s.Losses != 0 || s.Ties != 0 {
+			t.Errorf("Expected empty record for %s, got %d-%d-%d", s.TeamID, s.Wins, s.Losses, s.Ties)
+		}
+		if s.WinPercentage() != 0 {
+			t.Errorf("Expected 0 win percentage, got %f", s.WinPercentage())
+		}
+	}
+}
+
+func TestComputeStandingsIgnoresUnknownTeams(t *testing.T) {
+	results := []MatchupResult{
+		{HomeTeamID: "a", AwayTeamID: "ghost", HomeScore: 100, AwayScore: 50},
+	}
+
+	standings := ComputeStandings([]string{"a", "b"}, results)
+
+	for _, s := range standings {
+		if s.Wins != 0 || s.PointsFor != 0 {
+			t.Errorf("Expected result against unknown team to be ignored, got %+v", s)
+		}
+	}
+}
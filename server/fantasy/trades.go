@@ -0,0 +1,92 @@
+package fantasy
+
+import (
+	"fmt"
+	"maps"
+	"time"
+)
+
+// TradeSettings configures how accepted trades are reviewed before they execute
+type TradeSettings struct {
+	// ReviewPeriod is how long other managers have to veto an accepted trade.
+	// Zero executes trades as soon as they are accepted.
+	ReviewPeriod time.Duration
+	// VetoVotes is how many veto votes overturn a trade, 0 means a majority of the uninvolved teams
+	VetoVotes int
+}
+
+// DefaultTradeSettings gives the league two days to veto with a majority vote
+var DefaultTradeSettings = TradeSettings{
+	ReviewPeriod: 48 * time.Hour,
+	VetoVotes:    0,
+}
+
+// Validate checks the settings describe a usable review process
+func (s TradeSettings) Validate() error {
+	if s.ReviewPeriod < 0 {
+		return fmt.Errorf("trade review period cannot be negative, got %s", s.ReviewPeriod)
+	}
+	if s.VetoVotes < 0 {
+		return fmt.Errorf("veto votes cannot be negative, got %d", s.VetoVotes)
+	}
+	return nil
+}
+
+// VetoVotesRequired is how many votes veto a trade in a league with the given number of teams
+func (s TradeSettings) VetoVotesRequired(teamCount int) int {
+	if s.VetoVotes > 0 {
+		return s.VetoVotes
+	}
+	uninvolved := max(teamCount-2, 0)
+	return uninvolved/2 + 1
+}
+
+// TradeAsset is a single player changing teams in a trade
+type TradeAsset struct {
+	PlayerID   string
+	FromTeamID string
+	ToTeamID   string
+}
+
+// ApplyTrade moves each traded player to the bench of their new team.
+// rosters maps each team ID to its roster (player ID to roster spot).
+// It returns the updated rosters of every team and leaves the input untouched.
+func ApplyTrade(rosters map[string]map[string]string, assets []TradeAsset) (map[string]map[string]string, error) {
+	updated := make(map[string]map[string]string, len(rosters))
+	for teamID, roster := range rosters {
+		updated[teamID] = maps.Clone(roster)
+	}
+
+	for _, asset := range assets {
+		from, ok := updated[asset.FromTeamID]
+		if !ok {
+			return nil, fmt.Errorf("team %s is not part of this trade", asset.FromTeamID)
+		}
+		to, ok := updated[asset.ToTeamID]
+		if !ok {
+			return nil, fmt.Errorf("team %s is not part of this trade", asset.ToTeamID)
+		}
+		if _, ok := from[asset.PlayerID]; !ok {
+			return nil, fmt.Errorf("player %s is no longer on team %s's roster", asset.PlayerID, asset.FromTeamID)
+		}
+
+		delete(from, asset.PlayerID)
+		to[asset.PlayerID] = BenchSpot
+	}
+	return updated, nil
+}
+
+// CheckTrade returns an error if the trade can't be executed or would leave either team
+// with an illegal roster. positions maps each player ID to their pro position.
+func CheckTrade(rules RosterRules, rosters map[string]map[string]string, positions map[string]string, assets []TradeAsset) error {
+	updated, err := ApplyTrade(rosters, assets)
+	if err != nil {
+		return err
+	}
+	for teamID, roster := range updated {
+		if err := rules.CheckRoster(roster, positions); err != nil {
+			return fmt.Errorf("team %s: %w", teamID, err)
+		}
+	}
+	return nil
+}
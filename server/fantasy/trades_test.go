@@ -0,0 +1,156 @@
+package fantasy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTradeSettingsValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		settings    TradeSettings
+		expectError bool
+	}{
+		{"defaults", DefaultTradeSettings, false},
+		{"no review", TradeSettings{ReviewPeriod: 0, VetoVotes: 3}, false},
+		{"negative review", TradeSettings{ReviewPeriod: -time.Hour}, true},
+		{"negative votes", TradeSettings{VetoVotes: -1}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestVetoVotesRequired(t *testing.T) {
+	tests := []struct {
+		name      string
+		votes     int
+		teamCount int
+		expected  int
+	}{
+		{"majority of 10 uninvolved", 0, 12, 6},
+		{"majority of 9 uninvolved", 0, 11, 5},
+		{"two team league", 0, 2, 1},
+		{"fixed count", 4, 12, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := TradeSettings{VetoVotes: tt.votes}
+			if result := settings.VetoVotesRequired(tt.teamCount); result != tt.expected {
+				t.Errorf("Expected %d, got %d", tt.expected, result)
+			}
+		})
+	}
+}
+
+func tradeRosters() map[string]map[string]string {
+	return map[string]map[string]string{
+		"a": {"a-qb": "QB", "a-rb": "RB", "a-wr": BenchSpot},
+		"b": {"b-qb": "QB", "b-rb": "RB"},
+	}
+}
+
+func TestApplyTrade(t *testing.T) {
+	rosters := tradeRosters()
+	assets := []TradeAsset{
+		{PlayerID: "a-rb", FromTeamID: "a", ToTeamID: "b"},
+		{PlayerID: "b-rb", FromTeamID: "b", ToTeamID: "a"},
+	}
+
+	updated, err := ApplyTrade(rosters, assets)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, ok := updated["a"]["a-rb"]; ok {
+		t.Error("Expected a-rb to leave team a")
+	}
+	if updated["b"]["a-rb"] != BenchSpot {
+		t.Errorf("Expected a-rb on team b's bench, got %q", updated["b"]["a-rb"])
+	}
+	if updated["a"]["b-rb"] != BenchSpot {
+		t.Errorf("Expected b-rb on team a's bench, got %q", updated["a"]["b-rb"])
+	}
+	if _, ok := rosters["b"]["a-rb"]; ok {
+		t.Error("Expected input rosters to be unchanged")
+	}
+}
+
+func TestApplyTradeErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		asset TradeAsset
+	}{
+		{"player not on roster", TradeAsset{PlayerID: "b-qb", FromTeamID: "a", ToTeamID: "b"}},
+		{"unknown from team", TradeAsset{PlayerID: "a-qb", FromTeamID: "c", ToTeamID: "b"}},
+		{"unknown to team", TradeAsset{PlayerID: "a-qb", FromTeamID: "a", ToTeamID: "c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ApplyTrade(tradeRosters(), []TradeAsset{tt.asset}); err == nil {
+				t.Error("Expected error but got none")
+			}
+		})
+	}
+}
+
+func TestCheckTrade(t *testing.T) {
+	rules := RosterRules{
+		Slots:          map[string]int{"QB": 1, "RB": 1},
+		BenchSpots:     1,
+		MaxPerPosition: map[string]int{"QB": 1},
+	}
+	positions := map[string]string{"a-qb": "QB", "a-rb": "RB", "a-wr": "WR", "b-qb": "QB", "b-rb": "RB"}
+
+	tests := []struct {
+		name        string
+		assets      []TradeAsset
+		expectError bool
+	}{
+		{
+			name: "one for one",
+			assets: []TradeAsset{
+				{PlayerID: "a-rb", FromTeamID: "a", ToTeamID: "b"},
+				{PlayerID: "b-rb", FromTeamID: "b", ToTeamID: "a"},
+			},
+		},
+		{
+			name: "two for one fills the receiving roster",
+			assets: []TradeAsset{
+				{PlayerID: "b-rb", FromTeamID: "b", ToTeamID: "a"},
+			},
+			expectError: true,
+		},
+		{
+			name: "over the position limit",
+			assets: []TradeAsset{
+				{PlayerID: "a-qb", FromTeamID: "a", ToTeamID: "b"},
+				{PlayerID: "b-rb", FromTeamID: "b", ToTeamID: "a"},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckTrade(rules, tradeRosters(), positions, tt.assets)
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package fantasy
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+)
+
+// WaiverMode decides how competing waiver claims are awarded
+type WaiverMode string
+
+const (
+	// WaiverModeRolling awards each player to the claiming team highest in the waiver order
+	WaiverModeRolling WaiverMode = "ROLLING"
+	// WaiverModeFAAB awards each player to the highest blind bid from a season-long budget
+	WaiverModeFAAB WaiverMode = "FAAB"
+)
+
+// WaiverSettings configures a season's waiver wire
+type WaiverSettings struct {
+	Mode WaiverMode
+	// FAABBudget is each team's bidding budget for the season
+	FAABBudget int
+	// ProcessDay and ProcessHour (UTC) are when the weekly batch of claims runs
+	ProcessDay  time.Weekday
+	ProcessHour int
+}
+
+// DefaultWaiverSettings runs rolling waivers every Wednesday morning
+var DefaultWaiverSettings = WaiverSettings{
+	Mode:        WaiverModeRolling,
+	FAABBudget:  100,
+	ProcessDay:  time.Wednesday,
+	ProcessHour: 10,
+}
+
+// Validate checks the settings describe a usable waiver wire
+func (s WaiverSettings) Validate() error {
+	if s.Mode != WaiverModeRolling && s.Mode != WaiverModeFAAB {
+		return fmt.Errorf("unknown waiver mode %q", s.Mode)
+	}
+	if s.FAABBudget < 0 {
+		return fmt.Errorf("FAAB budget cannot be negative, got %d", s.FAABBudget)
+	}
+	if s.ProcessDay < time.Sunday || s.ProcessDay > time.Saturday {
+		return fmt.Errorf("process day must be between 0 (Sunday) and 6 (Saturday), got %d", s.ProcessDay)
+	}
+	if s.ProcessHour < 0 || s.ProcessHour > 23 {
+		return fmt.Errorf("process hour must be between 0 and 23, got %d", s.ProcessHour)
+	}
+	return nil
+}
+
+// NextRun is the first scheduled processing time strictly after the given time
+func (s WaiverSettings) NextRun(after time.Time) time.Time {
+	after = after.UTC()
+	run := time.Date(after.Year(), after.Month(), after.Day(), s.ProcessHour, 0, 0, 0, time.UTC)
+	run = run.AddDate(0, 0, (int(s.ProcessDay)-int(run.Weekday())+7)%7)
+	if !run.After(after) {
+		run = run.AddDate(0, 0, 7)
+	}
+	return run
+}
+
+// WaiverTeam is a team's standing on the waiver wire going into a batch
+type WaiverTeam struct {
+	ID string
+	// Priority is the team's place in the waiver order, 1 claims first
+	Priority int
+	// Budget is the team's remaining FAAB
+	Budget int
+	// Roster maps each rostered player ID to its roster spot
+	Roster map[string]string
+}
+
+// WaiverClaim is a request to add a player, optionally dropping another to make room
+type WaiverClaim struct {
+	ID           string
+	TeamID       string
+	AddPlayerID  string
+	DropPlayerID string
+	Bid          int
+}
+
+// WaiverResult is the outcome of a single claim
+type WaiverResult struct {
+	Claim      WaiverClaim
+	Successful bool
+	// Reason explains why an unsuccessful claim failed
+	Reason string
+}
+
+// ProcessWaivers awards a batch of claims. Claims must be in submission order,
+// which is also each team's order of preference.
+//
+// In rolling mode the team highest in the waiver order has its next claim considered first.
+// In FAAB mode the highest bid across all claims is considered first, with ties going to
+// the team higher in the waiver order. Either way a successful claim sends the team to
+// the back of the waiver order.
+//
+// Results are returned in processing order along with the teams' updated rosters,
+// budgets and priorities. The input teams are not modified.
+func ProcessWaivers(mode WaiverMode, maxActivePlayers int, teams []WaiverTeam, claims []WaiverClaim) ([]WaiverResult, []WaiverTeam) {
+	byID := make(map[string]*WaiverTeam, len(teams))
+	updated := make([]WaiverTeam, len(teams))
+	rostered := make(map[string]bool)
+	lastPriority := 0
+	for i, t := range teams {
+		t.Roster = maps.Clone(t.Roster)
+		if t.Roster == nil {
+			t.Roster = make(map[string]string)
+		}
+		updated[i] = t
+		byID[t.ID] = &updated[i]
+		for playerID := range t.Roster {
+			rostered[playerID] = true
+		}
+		lastPriority = max(lastPriority, t.Priority)
+	}
+
+	pending := slices.Clone(claims)
+	results := make([]WaiverResult, 0, len(claims))
+	for len(pending) > 0 {
+		i := nextWaiverClaim(mode, pending, byID)
+		claim := pending[i]
+		pending = slices.Delete(pending, i, i+1)
+
+		team, ok := byID[claim.TeamID]
+		if !ok {
+			results = append(results, WaiverResult{Claim: claim, Reason: "team is not in this league"})
+			continue
+		}
+		if reason := waiverFailure(mode, maxActivePlayers, team, claim, rostered); reason != "" {
+			results = append(results, WaiverResult{Claim: claim, Reason: reason})
+			continue
+		}
+
+		// Dropped players clear waivers in a later batch, so they stay unavailable here
+		if claim.DropPlayerID != "" {
+			delete(team.Roster, claim.DropPlayerID)
+		}
+		team.Roster[claim.AddPlayerID] = BenchSpot
+		rostered[claim.AddPlayerID] = true
+		if mode == WaiverModeFAAB {
+			team.Budget -= claim.Bid
+		}
+		lastPriority++
+		team.Priority = lastPriority
+
+		results = append(results, WaiverResult{Claim: claim, Successful: true})
+	}
+
+	// Close the gaps left by teams moving to the back of the order
+	order := make([]*WaiverTeam, 0, len(updated))
+	for i := range updated {
+		order = append(order, &updated[i])
+	}
+	slices.SortStableFunc(order, func(a, b *WaiverTeam) int { return cmp.Compare(a.Priority, b.Priority) })
+	for i, t := range order {
+		t.Priority = i + 1
+	}
+
+	return results, updated
+}
+
+// nextWaiverClaim picks the index of the pending claim to consider next
+func nextWaiverClaim(mode WaiverMode, pending []WaiverClaim, teams map[string]*WaiverTeam) int {
+	priority := func(teamID string) int {
+		if t, ok := teams[teamID]; ok {
+			return t.Priority
+		}
+		// Claims from unknown teams are considered last and then rejected
+		return int(^uint(0) >> 1)
+	}
+
+	best := 0
+	for i := 1; i < len(pending); i++ {
+		c, b := pending[i], pending[best]
+		if mode == WaiverModeFAAB && c.Bid != b.Bid {
+			if c.Bid > b.Bid {
+				best = i
+			}
+			continue
+		}
+		// Earlier claims win ties, so only a strictly better priority moves ahead
+		if priority(c.TeamID) < priority(b.TeamID) {
+			best = i
+		}
+	}
+	return best
+}
+
+// waiverFailure returns why a claim cannot be awarded, or "" if it can
+func waiverFailure(mode WaiverMode, maxActivePlayers int, team *WaiverTeam, claim WaiverClaim, rostered map[string]bool) string {
+	if rostered[claim.AddPlayerID] {
+		return "player is no longer available"
+	}
+	active := ActivePlayers(team.Roster)
+	if claim.DropPlayerID != "" {
+		spot, ok := team.Roster[claim.DropPlayerID]
+		if !ok {
+			return "drop player is no longer on the roster"
+		}
+		if spot != InjuredReserveSpot {
+			active--
+		}
+	}
+	if mode == WaiverModeFAAB && claim.Bid > team.Budget {
+		return "bid exceeds remaining budget"
+	}
+	if active >= maxActivePlayers {
+		return "roster is full"
+	}
+	return ""
+}
@@ -0,0 +1,221 @@
+package fantasy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWaiverSettingsValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		settings    WaiverSettings
+		expectError bool
+	}{
+		{"defaults", DefaultWaiverSettings, false},
+		{"faab", WaiverSettings{Mode: WaiverModeFAAB, FAABBudget: 1000, ProcessDay: time.Tuesday, ProcessHour: 23}, false},
+		{"unknown mode", WaiverSettings{Mode: "FIRST_COME", ProcessDay: time.Tuesday}, true},
+		{"negative budget", WaiverSettings{Mode: WaiverModeFAAB, FAABBudget: -1}, true},
+		{"bad day", WaiverSettings{Mode: WaiverModeRolling, ProcessDay: 7}, true},
+		{"bad hour", WaiverSettings{Mode: WaiverModeRolling, ProcessHour: 24}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.settings.Validate()
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestWaiverSettingsNextRun(t *testing.T) {
+	// Wednesday at 10:00 UTC
+	settings := WaiverSettings{Mode: WaiverModeRolling, ProcessDay: time.Wednesday, ProcessHour: 10}
+
+	tests := []struct {
+		name     string
+		after    time.Time
+		expected time.Time
+	}{
+		{
+			name:     "earlier in the week",
+			after:    time.Date(2024, 9, 9, 15, 0, 0, 0, time.UTC), // Monday
+			expected: time.Date(2024, 9, 11, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "same day before the hour",
+			after:    time.Date(2024, 9, 11, 9, 59, 0, 0, time.UTC),
+			expected: time.Date(2024, 9, 11, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "exactly at the run rolls to next week",
+			after:    time.Date(2024, 9, 11, 10, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 9, 18, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "later in the week",
+			after:    time.Date(2024, 9, 14, 8, 0, 0, 0, time.UTC), // Saturday
+			expected: time.Date(2024, 9, 18, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "non-UTC input",
+			after:    time.Date(2024, 9, 11, 5, 0, 0, 0, time.FixedZone("EDT", -4*60*60)), // 09:00 UTC
+			expected: time.Date(2024, 9, 11, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := settings.NextRun(tt.after); !result.Equal(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func waiverTeams() []WaiverTeam {
+	return []WaiverTeam{
+		{ID: "a", Priority: 1, Budget: 100, Roster: map[string]string{"a1": "QB", "a2": BenchSpot}},
+		{ID: "b", Priority: 2, Budget: 50, Roster: map[string]string{"b1": "QB"}},
+		{ID: "c", Priority: 3, Budget: 100, Roster: map[string]string{"c1": "QB", "c2": InjuredReserveSpot}},
+	}
+}
+
+func outcomes(results []WaiverResult) map[string]string {
+	byClaim := make(map[string]string, len(results))
+	for _, r := range results {
+		if r.Successful {
+			byClaim[r.Claim.ID] = "ok"
+		} else {
+			byClaim[r.Claim.ID] = r.Reason
+		}
+	}
+	return byClaim
+}
+
+func priorities(teams []WaiverTeam) map[string]int {
+	byTeam := make(map[string]int, len(teams))
+	for _, t := range teams {
+		byTeam[t.ID] = t.Priority
+	}
+	return byTeam
+}
+
+func TestProcessWaiversRolling(t *testing.T) {
+	claims := []WaiverClaim{
+		{ID: "c-x", TeamID: "c", AddPlayerID: "x"},
+		{ID: "b-x", TeamID: "b", AddPlayerID: "x"},
+		{ID: "b-y", TeamID: "b", AddPlayerID: "y"},
+		{ID: "a-y", TeamID: "a", AddPlayerID: "y", DropPlayerID: "a2"},
+	}
+
+	results, teams := ProcessWaivers(WaiverModeRolling, 10, waiverTeams(), claims)
+
+	expected := map[string]string{
+		"a-y": "ok",                            // a has top priority and wants y
+		"b-x": "ok",                            // b is next and still wants x
+		"b-y": "player is no longer available", // already taken by a
+		"c-x": "player is no longer available", // already taken by b
+	}
+	got := outcomes(results)
+	for id, want := range expected {
+		if got[id] != want {
+			t.Errorf("Claim %s: expected %q, got %q", id, want, got[id])
+		}
+	}
+
+	if results[0].Claim.ID != "a-y" || results[1].Claim.ID != "b-x" {
+		t.Errorf("Expected a-y then b-x to be processed first, got %s then %s", results[0].Claim.ID, results[1].Claim.ID)
+	}
+
+	// c made no successful claim so it moves to the top; a then b go to the back in award order
+	expectedPriorities := map[string]int{"c": 1, "a": 2, "b": 3}
+	for id, want := range expectedPriorities {
+		if got := priorities(teams)[id]; got != want {
+			t.Errorf("Team %s: expected priority %d, got %d", id, want, got)
+		}
+	}
+
+	if _, ok := teams[0].Roster["a2"]; ok {
+		t.Error("Expected a2 to be dropped from team a")
+	}
+	if teams[0].Roster["y"] != BenchSpot {
+		t.Errorf("Expected y on team a's bench, got %q", teams[0].Roster["y"])
+	}
+}
+
+func TestProcessWaiversFAAB(t *testing.T) {
+	claims := []WaiverClaim{
+		{ID: "a-x", TeamID: "a", AddPlayerID: "x", Bid: 10},
+		{ID: "c-x", TeamID: "c", AddPlayerID: "x", Bid: 30},
+		{ID: "b-y", TeamID: "b", AddPlayerID: "y", Bid: 60}, // over budget
+		{ID: "a-y", TeamID: "a", AddPlayerID: "y", Bid: 5},
+		{ID: "c-z", TeamID: "c", AddPlayerID: "z", Bid: 20},
+		{ID: "a-z", TeamID: "a", AddPlayerID: "z", Bid: 20}, // ties c, a has better priority
+	}
+
+	results, teams := ProcessWaivers(WaiverModeFAAB, 10, waiverTeams(), claims)
+
+	expected := map[string]string{
+		"b-y": "bid exceeds remaining budget",
+		"c-x": "ok",
+		"a-x": "player is no longer available",
+		"a-z": "ok",
+		"c-z": "player is no longer available",
+		"a-y": "ok",
+	}
+	got := outcomes(results)
+	for id, want := range expected {
+		if got[id] != want {
+			t.Errorf("Claim %s: expected %q, got %q", id, want, got[id])
+		}
+	}
+
+	budgets := map[string]int{"a": 75, "b": 50, "c": 70}
+	for _, team := range teams {
+		if team.Budget != budgets[team.ID] {
+			t.Errorf("Team %s: expected budget %d, got %d", team.ID, budgets[team.ID], team.Budget)
+		}
+	}
+}
+
+func TestProcessWaiversRosterLimits(t *testing.T) {
+	tests := []struct {
+		name     string
+		claim    WaiverClaim
+		expected string
+	}{
+		{"full roster without drop", WaiverClaim{ID: "1", TeamID: "a", AddPlayerID: "x"}, "roster is full"},
+		{"drop makes room", WaiverClaim{ID: "1", TeamID: "a", AddPlayerID: "x", DropPlayerID: "a2"}, "ok"},
+		{"drop player not on roster", WaiverClaim{ID: "1", TeamID: "a", AddPlayerID: "x", DropPlayerID: "b1"}, "drop player is no longer on the roster"},
+		{"injured reserve does not count", WaiverClaim{ID: "1", TeamID: "c", AddPlayerID: "x"}, "ok"},
+		{"dropping from IR does not make room", WaiverClaim{ID: "1", TeamID: "c", AddPlayerID: "x", DropPlayerID: "c2"}, "ok"},
+		{"unknown team", WaiverClaim{ID: "1", TeamID: "z", AddPlayerID: "x"}, "team is not in this league"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Two active players allowed: a is full, c has one active and one on IR
+			results, _ := ProcessWaivers(WaiverModeRolling, 2, waiverTeams(), []WaiverClaim{tt.claim})
+			if got := outcomes(results)["1"]; got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestProcessWaiversDoesNotModifyInput(t *testing.T) {
+	teams := waiverTeams()
+	ProcessWaivers(WaiverModeFAAB, 10, teams, []WaiverClaim{{ID: "1", TeamID: "a", AddPlayerID: "x", DropPlayerID: "a2", Bid: 10}})
+
+	if teams[0].Budget != 100 || teams[0].Priority != 1 {
+		t.Errorf("Expected input team unchanged, got budget %d priority %d", teams[0].Budget, teams[0].Priority)
+	}
+	if _, ok := teams[0].Roster["x"]; ok {
+		t.Error("Expected input roster unchanged")
+	}
+}
@@ -0,0 +1,62 @@
+package graph
+
+import (
+	"context"
+	"errors"
+
+	"fantasy-draft/graph/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// loadDepthChart returns a pro team's depth chart ordered by position and rank,
+// optionally limited to a single position
+func loadDepthChart(ctx context.Context, q querier, teamID string, position *model.Position) ([]*model.DepthChartEntry, error) {
+	var positionFilter *string
+	if position != nil {
+		value := positionToDB(*position)
+		positionFilter = &value
+	}
+
+	rows, err := q.Query(ctx, `
+		SELECT position, rank, player_id
+		FROM team_depth_charts
+		WHERE team_id = $1
+		  AND ($2::position_enum IS NULL OR position = $2)
+		ORDER BY position, rank
+	`, teamID, positionFilter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []*model.DepthChartEntry{}
+	for rows.Next() {
+		var e model.DepthChartEntry
+		var pos string
+		if err := rows.Scan(&pos, &e.Rank, &e.PlayerID); err != nil {
+			return nil, err
+		}
+		e.Position = positionFromDB(pos)
+		entries = append(entries, &e)
+	}
+	return entries, rows.Err()
+}
+
+// loadDepthChartRank returns a player's rank on their team's depth chart, or nil if they aren't on it
+func loadDepthChartRank(ctx context.Context, q querier, playerID string) (*int, error) {
+	var rank int
+	err := q.QueryRow(ctx, `
+		SELECT dc.rank
+		FROM team_depth_charts dc
+		JOIN players p ON p.id = dc.player_id AND p.team_id = dc.team_id AND p.position = dc.position
+		WHERE dc.player_id = $1
+	`, playerID).Scan(&rank)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rank, nil
+}
@@ -40,22 +40,74 @@ type Config struct {
 
 type ResolverRoot interface {
 	Conference() ConferenceResolver
+	DepthChartEntry() DepthChartEntryResolver
 	Division() DivisionResolver
+	FantasySeason() FantasySeasonResolver
+	FantasyTeam() FantasyTeamResolver
+	FantasyTransaction() FantasyTransactionResolver
+	Matchup() MatchupResolver
+	Mutation() MutationResolver
 	Player() PlayerResolver
+	PlayoffBracket() PlayoffBracketResolver
+	PlayoffGame() PlayoffGameResolver
 	Query() QueryResolver
+	RosterEntry() RosterEntryResolver
+	RotoStanding() RotoStandingResolver
+	Standing() StandingResolver
 	Team() TeamResolver
+	Trade() TradeResolver
+	TradePlayer() TradePlayerResolver
+	WaiverClaim() WaiverClaimResolver
 }
 
 type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	BaseballStats struct {
+		AtBats         func(childComplexity int) int
+		BattingAverage func(childComplexity int) int
+		EarnedRuns     func(childComplexity int) int
+		Era            func(childComplexity int) int
+		Hits           func(childComplexity int) int
+		HitsAllowed    func(childComplexity int) int
+		HomeRuns       func(childComplexity int) int
+		InningsPitched func(childComplexity int) int
+		OutsPitched    func(childComplexity int) int
+		RunsBattedIn   func(childComplexity int) int
+		Saves          func(childComplexity int) int
+		StolenBases    func(childComplexity int) int
+		Strikeouts     func(childComplexity int) int
+		WalksAllowed   func(childComplexity int) int
+		Whip           func(childComplexity int) int
+	}
+
+	BasketballStats struct {
+		Assists             func(childComplexity int) int
+		Blocks              func(childComplexity int) int
+		FieldGoalsAttempted func(childComplexity int) int
+		FieldGoalsMade      func(childComplexity int) int
+		FreeThrowsAttempted func(childComplexity int) int
+		FreeThrowsMade      func(childComplexity int) int
+		Points              func(childComplexity int) int
+		Rebounds            func(childComplexity int) int
+		Steals              func(childComplexity int) int
+		ThreePointersMade   func(childComplexity int) int
+		Turnovers           func(childComplexity int) int
+	}
+
 	Conference struct {
 		Divisions func(childComplexity int) int
 		ID        func(childComplexity int) int
 		Name      func(childComplexity int) int
 	}
 
+	DepthChartEntry struct {
+		Player   func(childComplexity int) int
+		Position func(childComplexity int) int
+		Rank     func(childComplexity int) int
+	}
+
 	Division struct {
 		Conference func(childComplexity int) int
 		ID         func(childComplexity int) int
@@ -63,39 +115,133 @@ type ComplexityRoot struct {
 		Teams      func(childComplexity int) int
 	}
 
+	FantasySeason struct {
+		Champion           func(childComplexity int) int
+		CurrentWeek        func(childComplexity int) int
+		DraftRoomID        func(childComplexity int) int
+		ID                 func(childComplexity int) int
+		PlayoffSettings    func(childComplexity int) int
+		RegularSeasonWeeks func(childComplexity int) int
+		TradeSettings      func(childComplexity int) int
+		WaiverSettings     func(childComplexity int) int
+		Year               func(childComplexity int) int
+	}
+
+	FantasyTeam struct {
+		DraftOrderNumber func(childComplexity int) int
+		FaabRemaining    func(childComplexity int) int
+		ID               func(childComplexity int) int
+		IsBot            func(childComplexity int) int
+		Name             func(childComplexity int) int
+		Roster           func(childComplexity int) int
+		WaiverPriority   func(childComplexity int) int
+	}
+
+	FantasyTransaction struct {
+		CreatedAt  func(childComplexity int) int
+		FaabAmount func(childComplexity int) int
+		ID         func(childComplexity int) int
+		Player     func(childComplexity int) int
+		Team       func(childComplexity int) int
+		Trade      func(childComplexity int) int
+		Type       func(childComplexity int) int
+	}
+
 	FootballStats struct {
-		ExtraPoints          func(childComplexity int) int
-		ExtraPointsMade      func(childComplexity int) int
-		ExtraPointsMissed    func(childComplexity int) int
-		FieldGoals           func(childComplexity int) int
-		FieldGoalsMade       func(childComplexity int) int
-		FieldGoalsMissed     func(childComplexity int) int
-		Fumbles              func(childComplexity int) int
-		FumblesLost          func(childComplexity int) int
-		PassingAttempts      func(childComplexity int) int
-		PassingCompletions   func(childComplexity int) int
-		PassingInterceptions func(childComplexity int) int
-		PassingTDs           func(childComplexity int) int
-		PassingYards         func(childComplexity int) int
-		ReceivingReceptions  func(childComplexity int) int
-		ReceivingTDs         func(childComplexity int) int
-		ReceivingTargets     func(childComplexity int) int
-		ReceivingYards       func(childComplexity int) int
-		RushingAttempts      func(childComplexity int) int
-		RushingTDs           func(childComplexity int) int
-		RushingYards         func(childComplexity int) int
+		AssistedTackles       func(childComplexity int) int
+		DefensiveTDs          func(childComplexity int) int
+		ExtraPoints           func(childComplexity int) int
+		ExtraPointsMade       func(childComplexity int) int
+		ExtraPointsMissed     func(childComplexity int) int
+		FieldGoals            func(childComplexity int) int
+		FieldGoals40To49      func(childComplexity int) int
+		FieldGoals50Plus      func(childComplexity int) int
+		FieldGoalsMade        func(childComplexity int) int
+		FieldGoalsMade40To49  func(childComplexity int) int
+		FieldGoalsMade50Plus  func(childComplexity int) int
+		FieldGoalsMadeUnder40 func(childComplexity int) int
+		FieldGoalsMissed      func(childComplexity int) int
+		FieldGoalsUnder40     func(childComplexity int) int
+		ForcedFumbles         func(childComplexity int) int
+		FumbleRecoveries      func(childComplexity int) int
+		Fumbles               func(childComplexity int) int
+		FumblesLost           func(childComplexity int) int
+		Interceptions         func(childComplexity int) int
+		PassesDefended        func(childComplexity int) int
+		PassingAttempts       func(childComplexity int) int
+		PassingCompletions    func(childComplexity int) int
+		PassingInterceptions  func(childComplexity int) int
+		PassingTDs            func(childComplexity int) int
+		PassingYards          func(childComplexity int) int
+		PointsAllowed         func(childComplexity int) int
+		ReceivingReceptions   func(childComplexity int) int
+		ReceivingTDs          func(childComplexity int) int
+		ReceivingTargets      func(childComplexity int) int
+		ReceivingYards        func(childComplexity int) int
+		RushingAttempts       func(childComplexity int) int
+		RushingTDs            func(childComplexity int) int
+		RushingYards          func(childComplexity int) int
+		Sacks                 func(childComplexity int) int
+		Tackles               func(childComplexity int) int
+		YardsAllowed          func(childComplexity int) int
+	}
+
+	Injury struct {
+		GamesMissed func(childComplexity int) int
+		ID          func(childComplexity int) int
+		InjuryType  func(childComplexity int) int
+		Severity    func(childComplexity int) int
+		Week        func(childComplexity int) int
+		Year        func(childComplexity int) int
+	}
+
+	Matchup struct {
+		AwayScore  func(childComplexity int) int
+		AwayTeam   func(childComplexity int) int
+		HomeScore  func(childComplexity int) int
+		HomeTeam   func(childComplexity int) int
+		ID         func(childComplexity int) int
+		IsComplete func(childComplexity int) int
+		Week       func(childComplexity int) int
+		Winner     func(childComplexity int) int
+	}
+
+	Mutation struct {
+		AcceptTrade       func(childComplexity int, tradeID string) int
+		AddFreeAgent      func(childComplexity int, teamID string, playerID string, dropPlayerID *string) int
+		ApproveTrade      func(childComplexity int, tradeID string) int
+		CancelTrade       func(childComplexity int, tradeID string) int
+		CancelWaiverClaim func(childComplexity int, claimID string) int
+		CounterTrade      func(childComplexity int, tradeID string, offeredPlayerIds []string, requestedPlayerIds []string) int
+		DropPlayer        func(childComplexity int, teamID string, playerID string) int
+		GenerateSchedule  func(childComplexity int, draftRoomID string, regularSeasonWeeks *int, playoffs *model.PlayoffSettingsInput, waivers *model.WaiverSettingsInput, trades *model.TradeSettingsInput) int
+		OptimizeLineup    func(childComplexity int, teamID string, week int) int
+		ProcessWaivers    func(childComplexity int, draftRoomID string) int
+		ProposeTrade      func(childComplexity int, proposingTeamID string, receivingTeamID string, offeredPlayerIds []string, requestedPlayerIds []string) int
+		RejectTrade       func(childComplexity int, tradeID string) int
+		SetLineup         func(childComplexity int, teamID string, moves []*model.LineupMoveInput) int
+		SimulateWeek      func(childComplexity int, draftRoomID string) int
+		SubmitWaiverClaim func(childComplexity int, teamID string, addPlayerID string, dropPlayerID *string, bid *int) int
+		VetoTrade         func(childComplexity int, tradeID string) int
+		VoteToVetoTrade   func(childComplexity int, tradeID string, teamID string) int
 	}
 
 	Player struct {
 		Age               func(childComplexity int) int
+		DepthChartRank    func(childComplexity int) int
+		DraftPick         func(childComplexity int) int
+		DraftRound        func(childComplexity int) int
 		DraftYear         func(childComplexity int) int
 		FirstName         func(childComplexity int) int
 		FullName          func(childComplexity int) int
 		Height            func(childComplexity int) int
 		ID                func(childComplexity int) int
+		Injuries          func(childComplexity int) int
+		InjuryRisk        func(childComplexity int) int
 		JerseyNumber      func(childComplexity int) int
 		LastName          func(childComplexity int) int
 		Position          func(childComplexity int) int
+		ScoutedSkill      func(childComplexity int) int
 		Skill             func(childComplexity int) int
 		Status            func(childComplexity int) int
 		Team              func(childComplexity int) int
@@ -104,33 +250,158 @@ type ComplexityRoot struct {
 		YearsOfExperience func(childComplexity int) int
 	}
 
-	Query struct {
-		Conference    func(childComplexity int, id string) int
-		Conferences   func(childComplexity int) int
-		Division      func(childComplexity int, id string) int
-		Divisions     func(childComplexity int) int
-		Player        func(childComplexity int, id string) int
-		Players       func(childComplexity int, position *model.Position, teamID *string, limit *int, offset *int) int
-		SearchPlayers func(childComplexity int, query string, limit *int) int
-		Team          func(childComplexity int, id string) int
+	PlayoffBracket struct {
+		Champion func(childComplexity int) int
+		Final    func(childComplexity int) int
+		Games    func(childComplexity int) int
+		Rounds   func(childComplexity int) int
+	}
+
+	PlayoffGame struct {
+		AwayScore       func(childComplexity int) int
+		AwaySeed        func(childComplexity int) int
+		AwaySource      func(childComplexity int) int
+		AwayTeam        func(childComplexity int) int
+		BracketPosition func(childComplexity int) int
+		HomeScore       func(childComplexity int) int
+		HomeSeed        func(childComplexity int) int
+		HomeSource      func(childComplexity int) int
+		HomeTeam        func(childComplexity int) int
+		ID              func(childComplexity int) int
+		IsComplete      func(childComplexity int) int
+		Round           func(childComplexity int) int
+		Winner          func(childComplexity int) int
+	}
+
+	PlayoffSettings struct {
+		Byes          func(childComplexity int) int
 		Teams         func(childComplexity int) int
+		Tiebreakers   func(childComplexity int) int
+		WeeksPerRound func(childComplexity int) int
+	}
+
+	Query struct {
+		Conference     func(childComplexity int, id string) int
+		Conferences    func(childComplexity int, universeID *string) int
+		Division       func(childComplexity int, id string) int
+		Divisions      func(childComplexity int) int
+		FantasySeason  func(childComplexity int, draftRoomID string) int
+		FreeAgents     func(childComplexity int, draftRoomID string, position *model.Position, limit *int, offset *int) int
+		Matchups       func(childComplexity int, draftRoomID string, week int) int
+		Player         func(childComplexity int, id string) int
+		Players        func(childComplexity int, position *model.Position, teamID *string, universeID *string, limit *int, offset *int) int
+		PlayoffBracket func(childComplexity int, draftRoomID string) int
+		RotoStandings  func(childComplexity int, draftRoomID string, year *int) int
+		SearchPlayers  func(childComplexity int, query string, limit *int) int
+		Standings      func(childComplexity int, draftRoomID string) int
+		Team           func(childComplexity int, id string) int
+		Teams          func(childComplexity int, universeID *string) int
+		Trade          func(childComplexity int, id string) int
+		Trades         func(childComplexity int, draftRoomID string, teamID *string, status *model.TradeStatus) int
+		Transactions   func(childComplexity int, draftRoomID string, teamID *string, limit *int) int
+		Universes      func(childComplexity int) int
+		WaiverClaims   func(childComplexity int, draftRoomID string, teamID *string, status *model.WaiverClaimStatus) int
+	}
+
+	RosterEntry struct {
+		Player     func(childComplexity int) int
+		RosterSpot func(childComplexity int) int
+	}
+
+	RotoCategoryScore struct {
+		Category func(childComplexity int) int
+		Points   func(childComplexity int) int
+		Value    func(childComplexity int) int
+	}
+
+	RotoStanding struct {
+		Categories func(childComplexity int) int
+		Points     func(childComplexity int) int
+		Rank       func(childComplexity int) int
+		Team       func(childComplexity int) int
+	}
+
+	Standing struct {
+		Losses        func(childComplexity int) int
+		PointsAgainst func(childComplexity int) int
+		PointsFor     func(childComplexity int) int
+		Rank          func(childComplexity int) int
+		Team          func(childComplexity int) int
+		Ties          func(childComplexity int) int
+		Wins          func(childComplexity int) int
 	}
 
 	Team struct {
 		Abbreviation func(childComplexity int) int
 		City         func(childComplexity int) int
+		DepthChart   func(childComplexity int, position *model.Position) int
 		Division     func(childComplexity int) int
 		ID           func(childComplexity int) int
 		Name         func(childComplexity int) int
 		Players      func(childComplexity int) int
+		SportType    func(childComplexity int) int
 		State        func(childComplexity int) int
 	}
 
+	Trade struct {
+		CounterOf         func(childComplexity int) int
+		CreatedAt         func(childComplexity int) int
+		FailureReason     func(childComplexity int) int
+		ID                func(childComplexity int) int
+		Players           func(childComplexity int) int
+		ProposingTeam     func(childComplexity int) int
+		ReceivingTeam     func(childComplexity int) int
+		ResolvedAt        func(childComplexity int) int
+		ReviewEndsAt      func(childComplexity int) int
+		Status            func(childComplexity int) int
+		VetoVotes         func(childComplexity int) int
+		VetoVotesRequired func(childComplexity int) int
+	}
+
+	TradePlayer struct {
+		FromTeam func(childComplexity int) int
+		Player   func(childComplexity int) int
+		ToTeam   func(childComplexity int) int
+	}
+
+	TradeSettings struct {
+		ReviewHours func(childComplexity int) int
+		VetoVotes   func(childComplexity int) int
+	}
+
+	Universe struct {
+		ID   func(childComplexity int) int
+		Name func(childComplexity int) int
+	}
+
+	WaiverClaim struct {
+		AddPlayer     func(childComplexity int) int
+		Bid           func(childComplexity int) int
+		CreatedAt     func(childComplexity int) int
+		DropPlayer    func(childComplexity int) int
+		FailureReason func(childComplexity int) int
+		ID            func(childComplexity int) int
+		ProcessedAt   func(childComplexity int) int
+		Status        func(childComplexity int) int
+		Team          func(childComplexity int) int
+	}
+
+	WaiverSettings struct {
+		FaabBudget      func(childComplexity int) int
+		LastProcessedAt func(childComplexity int) int
+		Mode            func(childComplexity int) int
+		NextProcessAt   func(childComplexity int) int
+		ProcessDay      func(childComplexity int) int
+		ProcessHour     func(childComplexity int) int
+	}
+
 	YearlyStat struct {
+		Age                  func(childComplexity int) int
 		FantasyPoints        func(childComplexity int) int
 		FantasyPointsPerGame func(childComplexity int) int
 		GamesPlayed          func(childComplexity int) int
 		ID                   func(childComplexity int) int
+		Skill                func(childComplexity int) int
 		SportType            func(childComplexity int) int
 		Stats                func(childComplexity int) int
 		Year                 func(childComplexity int) int
@@ -140,31 +411,125 @@ type ComplexityRoot struct {
 type ConferenceResolver interface {
 	Divisions(ctx context.Context, obj *model.Conference) ([]*model.Division, error)
 }
+type DepthChartEntryResolver interface {
+	Player(ctx context.Context, obj *model.DepthChartEntry) (*model.Player, error)
+}
 type DivisionResolver interface {
 	Conference(ctx context.Context, obj *model.Division) (*model.Conference, error)
 	Teams(ctx context.Context, obj *model.Division) ([]*model.Team, error)
 }
+type FantasySeasonResolver interface {
+	Champion(ctx context.Context, obj *model.FantasySeason) (*model.FantasyTeam, error)
+}
+type FantasyTeamResolver interface {
+	Roster(ctx context.Context, obj *model.FantasyTeam) ([]*model.RosterEntry, error)
+}
+type FantasyTransactionResolver interface {
+	Team(ctx context.Context, obj *model.FantasyTransaction) (*model.FantasyTeam, error)
+	Player(ctx context.Context, obj *model.FantasyTransaction) (*model.Player, error)
+
+	Trade(ctx context.Context, obj *model.FantasyTransaction) (*model.Trade, error)
+}
+type MatchupResolver interface {
+	HomeTeam(ctx context.Context, obj *model.Matchup) (*model.FantasyTeam, error)
+	AwayTeam(ctx context.Context, obj *model.Matchup) (*model.FantasyTeam, error)
+
+	Winner(ctx context.Context, obj *model.Matchup) (*model.FantasyTeam, error)
+}
+type MutationResolver interface {
+	GenerateSchedule(ctx context.Context, draftRoomID string, regularSeasonWeeks *int, playoffs *model.PlayoffSettingsInput, waivers *model.WaiverSettingsInput, trades *model.TradeSettingsInput) (*model.FantasySeason, error)
+	SimulateWeek(ctx context.Context, draftRoomID string) ([]*model.Matchup, error)
+	SetLineup(ctx context.Context, teamID string, moves []*model.LineupMoveInput) ([]*model.RosterEntry, error)
+	OptimizeLineup(ctx context.Context, teamID string, week int) ([]*model.RosterEntry, error)
+	SubmitWaiverClaim(ctx context.Context, teamID string, addPlayerID string, dropPlayerID *string, bid *int) (*model.WaiverClaim, error)
+	CancelWaiverClaim(ctx context.Context, claimID string) (*model.WaiverClaim, error)
+	AddFreeAgent(ctx context.Context, teamID string, playerID string, dropPlayerID *string) ([]*model.FantasyTransaction, error)
+	DropPlayer(ctx context.Context, teamID string, playerID string) (*model.FantasyTransaction, error)
+	ProcessWaivers(ctx context.Context, draftRoomID string) ([]*model.WaiverClaim, error)
+	ProposeTrade(ctx context.Context, proposingTeamID string, receivingTeamID string, offeredPlayerIds []string, requestedPlayerIds []string) (*model.Trade, error)
+	CounterTrade(ctx context.Context, tradeID string, offeredPlayerIds []string, requestedPlayerIds []string) (*model.Trade, error)
+	AcceptTrade(ctx context.Context, tradeID string) (*model.Trade, error)
+	RejectTrade(ctx context.Context, tradeID string) (*model.Trade, error)
+	CancelTrade(ctx context.Context, tradeID string) (*model.Trade, error)
+	VoteToVetoTrade(ctx context.Context, tradeID string, teamID string) (*model.Trade, error)
+	ApproveTrade(ctx context.Context, tradeID string) (*model.Trade, error)
+	VetoTrade(ctx context.Context, tradeID string) (*model.Trade, error)
+}
 type PlayerResolver interface {
 	FullName(ctx context.Context, obj *model.Player) (string, error)
 
 	Team(ctx context.Context, obj *model.Player) (*model.Team, error)
 
+	DepthChartRank(ctx context.Context, obj *model.Player) (*int, error)
 	YearlyStats(ctx context.Context, obj *model.Player) ([]*model.YearlyStat, error)
+	Injuries(ctx context.Context, obj *model.Player) ([]*model.Injury, error)
+	InjuryRisk(ctx context.Context, obj *model.Player) (float64, error)
+}
+type PlayoffBracketResolver interface {
+	Champion(ctx context.Context, obj *model.PlayoffBracket) (*model.FantasyTeam, error)
+}
+type PlayoffGameResolver interface {
+	HomeTeam(ctx context.Context, obj *model.PlayoffGame) (*model.FantasyTeam, error)
+	AwayTeam(ctx context.Context, obj *model.PlayoffGame) (*model.FantasyTeam, error)
+
+	Winner(ctx context.Context, obj *model.PlayoffGame) (*model.FantasyTeam, error)
+	HomeSource(ctx context.Context, obj *model.PlayoffGame) (*model.PlayoffGame, error)
+	AwaySource(ctx context.Context, obj *model.PlayoffGame) (*model.PlayoffGame, error)
 }
 type QueryResolver interface {
-	Conferences(ctx context.Context) ([]*model.Conference, error)
+	Universes(ctx context.Context) ([]*model.Universe, error)
+	Conferences(ctx context.Context, universeID *string) ([]*model.Conference, error)
 	Conference(ctx context.Context, id string) (*model.Conference, error)
 	Divisions(ctx context.Context) ([]*model.Division, error)
 	Division(ctx context.Context, id string) (*model.Division, error)
-	Teams(ctx context.Context) ([]*model.Team, error)
+	Teams(ctx context.Context, universeID *string) ([]*model.Team, error)
 	Team(ctx context.Context, id string) (*model.Team, error)
-	Players(ctx context.Context, position *model.Position, teamID *string, limit *int, offset *int) ([]*model.Player, error)
+	Players(ctx context.Context, position *model.Position, teamID *string, universeID *string, limit *int, offset *int) ([]*model.Player, error)
 	Player(ctx context.Context, id string) (*model.Player, error)
 	SearchPlayers(ctx context.Context, query string, limit *int) ([]*model.Player, error)
+	FantasySeason(ctx context.Context, draftRoomID string) (*model.FantasySeason, error)
+	Standings(ctx context.Context, draftRoomID string) ([]*model.Standing, error)
+	RotoStandings(ctx context.Context, draftRoomID string, year *int) ([]*model.RotoStanding, error)
+	Matchups(ctx context.Context, draftRoomID string, week int) ([]*model.Matchup, error)
+	PlayoffBracket(ctx context.Context, draftRoomID string) (*model.PlayoffBracket, error)
+	FreeAgents(ctx context.Context, draftRoomID string, position *model.Position, limit *int, offset *int) ([]*model.Player, error)
+	WaiverClaims(ctx context.Context, draftRoomID string, teamID *string, status *model.WaiverClaimStatus) ([]*model.WaiverClaim, error)
+	Transactions(ctx context.Context, draftRoomID string, teamID *string, limit *int) ([]*model.FantasyTransaction, error)
+	Trades(ctx context.Context, draftRoomID string, teamID *string, status *model.TradeStatus) ([]*model.Trade, error)
+	Trade(ctx context.Context, id string) (*model.Trade, error)
+}
+type RosterEntryResolver interface {
+	Player(ctx context.Context, obj *model.RosterEntry) (*model.Player, error)
+}
+type RotoStandingResolver interface {
+	Team(ctx context.Context, obj *model.RotoStanding) (*model.FantasyTeam, error)
+}
+type StandingResolver interface {
+	Team(ctx context.Context, obj *model.Standing) (*model.FantasyTeam, error)
 }
 type TeamResolver interface {
 	Division(ctx context.Context, obj *model.Team) (*model.Division, error)
 	Players(ctx context.Context, obj *model.Team) ([]*model.Player, error)
+	DepthChart(ctx context.Context, obj *model.Team, position *model.Position) ([]*model.DepthChartEntry, error)
+}
+type TradeResolver interface {
+	ProposingTeam(ctx context.Context, obj *model.Trade) (*model.FantasyTeam, error)
+	ReceivingTeam(ctx context.Context, obj *model.Trade) (*model.FantasyTeam, error)
+
+	Players(ctx context.Context, obj *model.Trade) ([]*model.TradePlayer, error)
+	CounterOf(ctx context.Context, obj *model.Trade) (*model.Trade, error)
+	VetoVotes(ctx context.Context, obj *model.Trade) (int, error)
+	VetoVotesRequired(ctx context.Context, obj *model.Trade) (int, error)
+}
+type TradePlayerResolver interface {
+	Player(ctx context.Context, obj *model.TradePlayer) (*model.Player, error)
+	FromTeam(ctx context.Context, obj *model.TradePlayer) (*model.FantasyTeam, error)
+	ToTeam(ctx context.Context, obj *model.TradePlayer) (*model.FantasyTeam, error)
+}
+type WaiverClaimResolver interface {
+	Team(ctx context.Context, obj *model.WaiverClaim) (*model.FantasyTeam, error)
+	AddPlayer(ctx context.Context, obj *model.WaiverClaim) (*model.Player, error)
+	DropPlayer(ctx context.Context, obj *model.WaiverClaim) (*model.Player, error)
 }
 
 type executableSchema struct {
@@ -186,4557 +551,17401 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
-	case "Conference.divisions":
-		if e.complexity.Conference.Divisions == nil {
+	case "BaseballStats.atBats":
+		if e.complexity.BaseballStats.AtBats == nil {
 			break
 		}
 
-		return e.complexity.Conference.Divisions(childComplexity), true
-	case "Conference.id":
-		if e.complexity.Conference.ID == nil {
+		return e.complexity.BaseballStats.AtBats(childComplexity), true
+	case "BaseballStats.battingAverage":
+		if e.complexity.BaseballStats.BattingAverage == nil {
 			break
 		}
 
-		return e.complexity.Conference.ID(childComplexity), true
-	case "Conference.name":
-		if e.complexity.Conference.Name == nil {
+		return e.complexity.BaseballStats.BattingAverage(childComplexity), true
+	case "BaseballStats.earnedRuns":
+		if e.complexity.BaseballStats.EarnedRuns == nil {
 			break
 		}
 
-		return e.complexity.Conference.Name(childComplexity), true
+		return e.complexity.BaseballStats.EarnedRuns(childComplexity), true
+	case "BaseballStats.era":
+		if e.complexity.BaseballStats.Era == nil {
+			break
+		}
 
-	case "Division.conference":
-		if e.complexity.Division.Conference == nil {
+		return e.complexity.BaseballStats.Era(childComplexity), true
+	case "BaseballStats.hits":
+		if e.complexity.BaseballStats.Hits == nil {
 			break
 		}
 
-		return e.complexity.Division.Conference(childComplexity), true
-	case "Division.id":
-		if e.complexity.Division.ID == nil {
+		return e.complexity.BaseballStats.Hits(childComplexity), true
+	case "BaseballStats.hitsAllowed":
+		if e.complexity.BaseballStats.HitsAllowed == nil {
 			break
 		}
 
-		return e.complexity.Division.ID(childComplexity), true
-	case "Division.name":
-		if e.complexity.Division.Name == nil {
+		return e.complexity.BaseballStats.HitsAllowed(childComplexity), true
+	case "BaseballStats.homeRuns":
+		if e.complexity.BaseballStats.HomeRuns == nil {
 			break
 		}
 
-		return e.complexity.Division.Name(childComplexity), true
-	case "Division.teams":
-		if e.complexity.Division.Teams == nil {
+		return e.complexity.BaseballStats.HomeRuns(childComplexity), true
+	case "BaseballStats.inningsPitched":
+		if e.complexity.BaseballStats.InningsPitched == nil {
 			break
 		}
 
-		return e.complexity.Division.Teams(childComplexity), true
+		return e.complexity.BaseballStats.InningsPitched(childComplexity), true
+	case "BaseballStats.outsPitched":
+		if e.complexity.BaseballStats.OutsPitched == nil {
+			break
+		}
 
-	case "FootballStats.extraPoints":
-		if e.complexity.FootballStats.ExtraPoints == nil {
+		return e.complexity.BaseballStats.OutsPitched(childComplexity), true
+	case "BaseballStats.runsBattedIn":
+		if e.complexity.BaseballStats.RunsBattedIn == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.ExtraPoints(childComplexity), true
-	case "FootballStats.extraPointsMade":
-		if e.complexity.FootballStats.ExtraPointsMade == nil {
+		return e.complexity.BaseballStats.RunsBattedIn(childComplexity), true
+	case "BaseballStats.saves":
+		if e.complexity.BaseballStats.Saves == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.ExtraPointsMade(childComplexity), true
-	case "FootballStats.extraPointsMissed":
-		if e.complexity.FootballStats.ExtraPointsMissed == nil {
+		return e.complexity.BaseballStats.Saves(childComplexity), true
+	case "BaseballStats.stolenBases":
+		if e.complexity.BaseballStats.StolenBases == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.ExtraPointsMissed(childComplexity), true
-	case "FootballStats.fieldGoals":
-		if e.complexity.FootballStats.FieldGoals == nil {
+		return e.complexity.BaseballStats.StolenBases(childComplexity), true
+	case "BaseballStats.strikeouts":
+		if e.complexity.BaseballStats.Strikeouts == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.FieldGoals(childComplexity), true
-	case "FootballStats.fieldGoalsMade":
-		if e.complexity.FootballStats.FieldGoalsMade == nil {
+		return e.complexity.BaseballStats.Strikeouts(childComplexity), true
+	case "BaseballStats.walksAllowed":
+		if e.complexity.BaseballStats.WalksAllowed == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.FieldGoalsMade(childComplexity), true
-	case "FootballStats.fieldGoalsMissed":
-		if e.complexity.FootballStats.FieldGoalsMissed == nil {
+		return e.complexity.BaseballStats.WalksAllowed(childComplexity), true
+	case "BaseballStats.whip":
+		if e.complexity.BaseballStats.Whip == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.FieldGoalsMissed(childComplexity), true
-	case "FootballStats.fumbles":
-		if e.complexity.FootballStats.Fumbles == nil {
+		return e.complexity.BaseballStats.Whip(childComplexity), true
+
+	case "BasketballStats.assists":
+		if e.complexity.BasketballStats.Assists == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.Fumbles(childComplexity), true
-	case "FootballStats.fumblesLost":
-		if e.complexity.FootballStats.FumblesLost == nil {
+		return e.complexity.BasketballStats.Assists(childComplexity), true
+	case "BasketballStats.blocks":
+		if e.complexity.BasketballStats.Blocks == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.FumblesLost(childComplexity), true
-	case "FootballStats.passingAttempts":
-		if e.complexity.FootballStats.PassingAttempts == nil {
+		return e.complexity.BasketballStats.Blocks(childComplexity), true
+	case "BasketballStats.fieldGoalsAttempted":
+		if e.complexity.BasketballStats.FieldGoalsAttempted == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.PassingAttempts(childComplexity), true
-	case "FootballStats.passingCompletions":
-		if e.complexity.FootballStats.PassingCompletions == nil {
+		return e.complexity.BasketballStats.FieldGoalsAttempted(childComplexity), true
+	case "BasketballStats.fieldGoalsMade":
+		if e.complexity.BasketballStats.FieldGoalsMade == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.PassingCompletions(childComplexity), true
-	case "FootballStats.passingInterceptions":
-		if e.complexity.FootballStats.PassingInterceptions == nil {
+		return e.complexity.BasketballStats.FieldGoalsMade(childComplexity), true
+	case "BasketballStats.freeThrowsAttempted":
+		if e.complexity.BasketballStats.FreeThrowsAttempted == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.PassingInterceptions(childComplexity), true
-	case "FootballStats.passingTDs":
-		if e.complexity.FootballStats.PassingTDs == nil {
+		return e.complexity.BasketballStats.FreeThrowsAttempted(childComplexity), true
+	case "BasketballStats.freeThrowsMade":
+		if e.complexity.BasketballStats.FreeThrowsMade == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.PassingTDs(childComplexity), true
-	case "FootballStats.passingYards":
-		if e.complexity.FootballStats.PassingYards == nil {
+		return e.complexity.BasketballStats.FreeThrowsMade(childComplexity), true
+	case "BasketballStats.points":
+		if e.complexity.BasketballStats.Points == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.PassingYards(childComplexity), true
-	case "FootballStats.receivingReceptions":
-		if e.complexity.FootballStats.ReceivingReceptions == nil {
+		return e.complexity.BasketballStats.Points(childComplexity), true
+	case "BasketballStats.rebounds":
+		if e.complexity.BasketballStats.Rebounds == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.ReceivingReceptions(childComplexity), true
-	case "FootballStats.receivingTDs":
-		if e.complexity.FootballStats.ReceivingTDs == nil {
+		return e.complexity.BasketballStats.Rebounds(childComplexity), true
+	case "BasketballStats.steals":
+		if e.complexity.BasketballStats.Steals == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.ReceivingTDs(childComplexity), true
-	case "FootballStats.receivingTargets":
-		if e.complexity.FootballStats.ReceivingTargets == nil {
+		return e.complexity.BasketballStats.Steals(childComplexity), true
+	case "BasketballStats.threePointersMade":
+		if e.complexity.BasketballStats.ThreePointersMade == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.ReceivingTargets(childComplexity), true
-	case "FootballStats.receivingYards":
-		if e.complexity.FootballStats.ReceivingYards == nil {
+		return e.complexity.BasketballStats.ThreePointersMade(childComplexity), true
+	case "BasketballStats.turnovers":
+		if e.complexity.BasketballStats.Turnovers == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.ReceivingYards(childComplexity), true
-	case "FootballStats.rushingAttempts":
-		if e.complexity.FootballStats.RushingAttempts == nil {
+		return e.complexity.BasketballStats.Turnovers(childComplexity), true
+
+	case "Conference.divisions":
+		if e.complexity.Conference.Divisions == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.RushingAttempts(childComplexity), true
-	case "FootballStats.rushingTDs":
-		if e.complexity.FootballStats.RushingTDs == nil {
+		return e.complexity.Conference.Divisions(childComplexity), true
+	case "Conference.id":
+		if e.complexity.Conference.ID == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.RushingTDs(childComplexity), true
-	case "FootballStats.rushingYards":
-		if e.complexity.FootballStats.RushingYards == nil {
+		return e.complexity.Conference.ID(childComplexity), true
+	case "Conference.name":
+		if e.complexity.Conference.Name == nil {
 			break
 		}
 
-		return e.complexity.FootballStats.RushingYards(childComplexity), true
+		return e.complexity.Conference.Name(childComplexity), true
 
-	case "Player.age":
-		if e.complexity.Player.Age == nil {
+	case "DepthChartEntry.player":
+		if e.complexity.DepthChartEntry.Player == nil {
 			break
 		}
 
-		return e.complexity.Player.Age(childComplexity), true
-	case "Player.draftYear":
-		if e.complexity.Player.DraftYear == nil {
+		return e.complexity.DepthChartEntry.Player(childComplexity), true
+	case "DepthChartEntry.position":
+		if e.complexity.DepthChartEntry.Position == nil {
 			break
 		}
 
-		return e.complexity.Player.DraftYear(childComplexity), true
-	case "Player.firstName":
-		if e.complexity.Player.FirstName == nil {
+		return e.complexity.DepthChartEntry.Position(childComplexity), true
+	case "DepthChartEntry.rank":
+		if e.complexity.DepthChartEntry.Rank == nil {
 			break
 		}
 
-		return e.complexity.Player.FirstName(childComplexity), true
-	case "Player.fullName":
-		if e.complexity.Player.FullName == nil {
+		return e.complexity.DepthChartEntry.Rank(childComplexity), true
+
+	case "Division.conference":
+		if e.complexity.Division.Conference == nil {
 			break
 		}
 
-		return e.complexity.Player.FullName(childComplexity), true
-	case "Player.height":
-		if e.complexity.Player.Height == nil {
+		return e.complexity.Division.Conference(childComplexity), true
+	case "Division.id":
+		if e.complexity.Division.ID == nil {
 			break
 		}
 
-		return e.complexity.Player.Height(childComplexity), true
-	case "Player.id":
-		if e.complexity.Player.ID == nil {
+		return e.complexity.Division.ID(childComplexity), true
+	case "Division.name":
+		if e.complexity.Division.Name == nil {
 			break
 		}
 
-		return e.complexity.Player.ID(childComplexity), true
-	case "Player.jerseyNumber":
-		if e.complexity.Player.JerseyNumber == nil {
+		return e.complexity.Division.Name(childComplexity), true
+	case "Division.teams":
+		if e.complexity.Division.Teams == nil {
 			break
 		}
 
-		return e.complexity.Player.JerseyNumber(childComplexity), true
-	case "Player.lastName":
-		if e.complexity.Player.LastName == nil {
+		return e.complexity.Division.Teams(childComplexity), true
+
+	case "FantasySeason.champion":
+		if e.complexity.FantasySeason.Champion == nil {
 			break
 		}
 
-		return e.complexity.Player.LastName(childComplexity), true
-	case "Player.position":
-		if e.complexity.Player.Position == nil {
+		return e.complexity.FantasySeason.Champion(childComplexity), true
+	case "FantasySeason.currentWeek":
+		if e.complexity.FantasySeason.CurrentWeek == nil {
 			break
 		}
 
-		return e.complexity.Player.Position(childComplexity), true
-	case "Player.skill":
-		if e.complexity.Player.Skill == nil {
+		return e.complexity.FantasySeason.CurrentWeek(childComplexity), true
+	case "FantasySeason.draftRoomId":
+		if e.complexity.FantasySeason.DraftRoomID == nil {
 			break
 		}
 
-		return e.complexity.Player.Skill(childComplexity), true
-	case "Player.status":
-		if e.complexity.Player.Status == nil {
+		return e.complexity.FantasySeason.DraftRoomID(childComplexity), true
+	case "FantasySeason.id":
+		if e.complexity.FantasySeason.ID == nil {
 			break
 		}
 
-		return e.complexity.Player.Status(childComplexity), true
-	case "Player.team":
-		if e.complexity.Player.Team == nil {
+		return e.complexity.FantasySeason.ID(childComplexity), true
+	case "FantasySeason.playoffSettings":
+		if e.complexity.FantasySeason.PlayoffSettings == nil {
 			break
 		}
 
-		return e.complexity.Player.Team(childComplexity), true
-	case "Player.weight":
-		if e.complexity.Player.Weight == nil {
+		return e.complexity.FantasySeason.PlayoffSettings(childComplexity), true
+	case "FantasySeason.regularSeasonWeeks":
+		if e.complexity.FantasySeason.RegularSeasonWeeks == nil {
 			break
 		}
 
-		return e.complexity.Player.Weight(childComplexity), true
-	case "Player.yearlyStats":
-		if e.complexity.Player.YearlyStats == nil {
+		return e.complexity.FantasySeason.RegularSeasonWeeks(childComplexity), true
+	case "FantasySeason.tradeSettings":
+		if e.complexity.FantasySeason.TradeSettings == nil {
 			break
 		}
 
-		return e.complexity.Player.YearlyStats(childComplexity), true
-	case "Player.yearsOfExperience":
-		if e.complexity.Player.YearsOfExperience == nil {
+		return e.complexity.FantasySeason.TradeSettings(childComplexity), true
+	case "FantasySeason.waiverSettings":
+		if e.complexity.FantasySeason.WaiverSettings == nil {
 			break
 		}
 
-		return e.complexity.Player.YearsOfExperience(childComplexity), true
-
-	case "Query.conference":
-		if e.complexity.Query.Conference == nil {
+		return e.complexity.FantasySeason.WaiverSettings(childComplexity), true
+	case "FantasySeason.year":
+		if e.complexity.FantasySeason.Year == nil {
 			break
 		}
 
-		args, err := ec.field_Query_conference_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.FantasySeason.Year(childComplexity), true
+
+	case "FantasyTeam.draftOrderNumber":
+		if e.complexity.FantasyTeam.DraftOrderNumber == nil {
+			break
 		}
 
-		return e.complexity.Query.Conference(childComplexity, args["id"].(string)), true
-	case "Query.conferences":
-		if e.complexity.Query.Conferences == nil {
+		return e.complexity.FantasyTeam.DraftOrderNumber(childComplexity), true
+	case "FantasyTeam.faabRemaining":
+		if e.complexity.FantasyTeam.FaabRemaining == nil {
 			break
 		}
 
-		return e.complexity.Query.Conferences(childComplexity), true
-	case "Query.division":
-		if e.complexity.Query.Division == nil {
+		return e.complexity.FantasyTeam.FaabRemaining(childComplexity), true
+	case "FantasyTeam.id":
+		if e.complexity.FantasyTeam.ID == nil {
 			break
 		}
 
-		args, err := ec.field_Query_division_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.FantasyTeam.ID(childComplexity), true
+	case "FantasyTeam.isBot":
+		if e.complexity.FantasyTeam.IsBot == nil {
+			break
 		}
 
-		return e.complexity.Query.Division(childComplexity, args["id"].(string)), true
-	case "Query.divisions":
-		if e.complexity.Query.Divisions == nil {
+		return e.complexity.FantasyTeam.IsBot(childComplexity), true
+	case "FantasyTeam.name":
+		if e.complexity.FantasyTeam.Name == nil {
 			break
 		}
 
-		return e.complexity.Query.Divisions(childComplexity), true
-	case "Query.player":
-		if e.complexity.Query.Player == nil {
+		return e.complexity.FantasyTeam.Name(childComplexity), true
+	case "FantasyTeam.roster":
+		if e.complexity.FantasyTeam.Roster == nil {
 			break
 		}
 
-		args, err := ec.field_Query_player_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.FantasyTeam.Roster(childComplexity), true
+	case "FantasyTeam.waiverPriority":
+		if e.complexity.FantasyTeam.WaiverPriority == nil {
+			break
 		}
 
-		return e.complexity.Query.Player(childComplexity, args["id"].(string)), true
-	case "Query.players":
-		if e.complexity.Query.Players == nil {
+		return e.complexity.FantasyTeam.WaiverPriority(childComplexity), true
+
+	case "FantasyTransaction.createdAt":
+		if e.complexity.FantasyTransaction.CreatedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Query_players_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.FantasyTransaction.CreatedAt(childComplexity), true
+	case "FantasyTransaction.faabAmount":
+		if e.complexity.FantasyTransaction.FaabAmount == nil {
+			break
 		}
 
-		return e.complexity.Query.Players(childComplexity, args["position"].(*model.Position), args["teamId"].(*string), args["limit"].(*int), args["offset"].(*int)), true
-	case "Query.searchPlayers":
-		if e.complexity.Query.SearchPlayers == nil {
+		return e.complexity.FantasyTransaction.FaabAmount(childComplexity), true
+	case "FantasyTransaction.id":
+		if e.complexity.FantasyTransaction.ID == nil {
 			break
 		}
 
-		args, err := ec.field_Query_searchPlayers_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.FantasyTransaction.ID(childComplexity), true
+	case "FantasyTransaction.player":
+		if e.complexity.FantasyTransaction.Player == nil {
+			break
 		}
 
-		return e.complexity.Query.SearchPlayers(childComplexity, args["query"].(string), args["limit"].(*int)), true
-	case "Query.team":
-		if e.complexity.Query.Team == nil {
+		return e.complexity.FantasyTransaction.Player(childComplexity), true
+	case "FantasyTransaction.team":
+		if e.complexity.FantasyTransaction.Team == nil {
 			break
 		}
 
-		args, err := ec.field_Query_team_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.FantasyTransaction.Team(childComplexity), true
+	case "FantasyTransaction.trade":
+		if e.complexity.FantasyTransaction.Trade == nil {
+			break
 		}
 
-		return e.complexity.Query.Team(childComplexity, args["id"].(string)), true
-	case "Query.teams":
-		if e.complexity.Query.Teams == nil {
+		return e.complexity.FantasyTransaction.Trade(childComplexity), true
+	case "FantasyTransaction.type":
+		if e.complexity.FantasyTransaction.Type == nil {
 			break
 		}
 
-		return e.complexity.Query.Teams(childComplexity), true
+		return e.complexity.FantasyTransaction.Type(childComplexity), true
 
-	case "Team.abbreviation":
-		if e.complexity.Team.Abbreviation == nil {
+	case "FootballStats.assistedTackles":
+		if e.complexity.FootballStats.AssistedTackles == nil {
 			break
 		}
 
-		return e.complexity.Team.Abbreviation(childComplexity), true
-	case "Team.city":
-		if e.complexity.Team.City == nil {
+		return e.complexity.FootballStats.AssistedTackles(childComplexity), true
+	case "FootballStats.defensiveTDs":
+		if e.complexity.FootballStats.DefensiveTDs == nil {
 			break
 		}
 
-		return e.complexity.Team.City(childComplexity), true
-	case "Team.division":
-		if e.complexity.Team.Division == nil {
+		return e.complexity.FootballStats.DefensiveTDs(childComplexity), true
+	case "FootballStats.extraPoints":
+		if e.complexity.FootballStats.ExtraPoints == nil {
 			break
 		}
 
-		return e.complexity.Team.Division(childComplexity), true
-	case "Team.id":
-		if e.complexity.Team.ID == nil {
+		return e.complexity.FootballStats.ExtraPoints(childComplexity), true
+	case "FootballStats.extraPointsMade":
+		if e.complexity.FootballStats.ExtraPointsMade == nil {
 			break
 		}
 
-		return e.complexity.Team.ID(childComplexity), true
-	case "Team.name":
-		if e.complexity.Team.Name == nil {
+		return e.complexity.FootballStats.ExtraPointsMade(childComplexity), true
+	case "FootballStats.extraPointsMissed":
+		if e.complexity.FootballStats.ExtraPointsMissed == nil {
 			break
 		}
 
-		return e.complexity.Team.Name(childComplexity), true
-	case "Team.players":
-		if e.complexity.Team.Players == nil {
+		return e.complexity.FootballStats.ExtraPointsMissed(childComplexity), true
+	case "FootballStats.fieldGoals":
+		if e.complexity.FootballStats.FieldGoals == nil {
 			break
 		}
 
-		return e.complexity.Team.Players(childComplexity), true
-	case "Team.state":
-		if e.complexity.Team.State == nil {
+		return e.complexity.FootballStats.FieldGoals(childComplexity), true
+	case "FootballStats.fieldGoals40To49":
+		if e.complexity.FootballStats.FieldGoals40To49 == nil {
 			break
 		}
 
-		return e.complexity.Team.State(childComplexity), true
+		return e.complexity.FootballStats.FieldGoals40To49(childComplexity), true
+	case "FootballStats.fieldGoals50Plus":
+		if e.complexity.FootballStats.FieldGoals50Plus == nil {
+			break
+		}
 
-	case "YearlyStat.fantasyPoints":
-		if e.complexity.YearlyStat.FantasyPoints == nil {
+		return e.complexity.FootballStats.FieldGoals50Plus(childComplexity), true
+	case "FootballStats.fieldGoalsMade":
+		if e.complexity.FootballStats.FieldGoalsMade == nil {
 			break
 		}
 
-		return e.complexity.YearlyStat.FantasyPoints(childComplexity), true
-	case "YearlyStat.fantasyPointsPerGame":
-		if e.complexity.YearlyStat.FantasyPointsPerGame == nil {
+		return e.complexity.FootballStats.FieldGoalsMade(childComplexity), true
+	case "FootballStats.fieldGoalsMade40To49":
+		if e.complexity.FootballStats.FieldGoalsMade40To49 == nil {
 			break
 		}
 
-		return e.complexity.YearlyStat.FantasyPointsPerGame(childComplexity), true
-	case "YearlyStat.gamesPlayed":
-		if e.complexity.YearlyStat.GamesPlayed == nil {
+		return e.complexity.FootballStats.FieldGoalsMade40To49(childComplexity), true
+	case "FootballStats.fieldGoalsMade50Plus":
+		if e.complexity.FootballStats.FieldGoalsMade50Plus == nil {
 			break
 		}
 
-		return e.complexity.YearlyStat.GamesPlayed(childComplexity), true
-	case "YearlyStat.id":
-		if e.complexity.YearlyStat.ID == nil {
+		return e.complexity.FootballStats.FieldGoalsMade50Plus(childComplexity), true
+	case "FootballStats.fieldGoalsMadeUnder40":
+		if e.complexity.FootballStats.FieldGoalsMadeUnder40 == nil {
 			break
 		}
 
-		return e.complexity.YearlyStat.ID(childComplexity), true
-	case "YearlyStat.sportType":
-		if e.complexity.YearlyStat.SportType == nil {
+		return e.complexity.FootballStats.FieldGoalsMadeUnder40(childComplexity), true
+	case "FootballStats.fieldGoalsMissed":
+		if e.complexity.FootballStats.FieldGoalsMissed == nil {
 			break
 		}
 
-		return e.complexity.YearlyStat.SportType(childComplexity), true
-	case "YearlyStat.stats":
-		if e.complexity.YearlyStat.Stats == nil {
+		return e.complexity.FootballStats.FieldGoalsMissed(childComplexity), true
+	case "FootballStats.fieldGoalsUnder40":
+		if e.complexity.FootballStats.FieldGoalsUnder40 == nil {
 			break
 		}
 
-		return e.complexity.YearlyStat.Stats(childComplexity), true
-	case "YearlyStat.year":
-		if e.complexity.YearlyStat.Year == nil {
+		return e.complexity.FootballStats.FieldGoalsUnder40(childComplexity), true
+	case "FootballStats.forcedFumbles":
+		if e.complexity.FootballStats.ForcedFumbles == nil {
 			break
 		}
 
-		return e.complexity.YearlyStat.Year(childComplexity), true
+		return e.complexity.FootballStats.ForcedFumbles(childComplexity), true
+	case "FootballStats.fumbleRecoveries":
+		if e.complexity.FootballStats.FumbleRecoveries == nil {
+			break
+		}
 
-	}
-	return 0, false
-}
+		return e.complexity.FootballStats.FumbleRecoveries(childComplexity), true
+	case "FootballStats.fumbles":
+		if e.complexity.FootballStats.Fumbles == nil {
+			break
+		}
 
-func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	opCtx := graphql.GetOperationContext(ctx)
-	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
-	inputUnmarshalMap := graphql.BuildUnmarshalerMap()
-	first := true
+		return e.complexity.FootballStats.Fumbles(childComplexity), true
+	case "FootballStats.fumblesLost":
+		if e.complexity.FootballStats.FumblesLost == nil {
+			break
+		}
 
-	switch opCtx.Operation.Operation {
-	case ast.Query:
-		return func(ctx context.Context) *graphql.Response {
-			var response graphql.Response
-			var data graphql.Marshaler
-			if first {
-				first = false
-				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
-			} else {
-				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
-					result := <-ec.deferredResults
-					atomic.AddInt32(&ec.pendingDeferred, -1)
-					data = result.Result
-					response.Path = result.Path
-					response.Label = result.Label
-					response.Errors = result.Errors
-				} else {
-					return nil
-				}
-			}
-			var buf bytes.Buffer
-			data.MarshalGQL(&buf)
-			response.Data = buf.Bytes()
-			if atomic.LoadInt32(&ec.deferred) > 0 {
-				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
-				response.HasNext = &hasNext
-			}
+		return e.complexity.FootballStats.FumblesLost(childComplexity), true
+	case "FootballStats.interceptions":
+		if e.complexity.FootballStats.Interceptions == nil {
+			break
+		}
 
-			return &response
+		return e.complexity.FootballStats.Interceptions(childComplexity), true
+	case "FootballStats.passesDefended":
+		if e.complexity.FootballStats.PassesDefended == nil {
+			break
 		}
 
-	default:
-		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
-	}
-}
+		return e.complexity.FootballStats.PassesDefended(childComplexity), true
+	case "FootballStats.passingAttempts":
+		if e.complexity.FootballStats.PassingAttempts == nil {
+			break
+		}
 
-type executionContext struct {
-	*graphql.OperationContext
-	*executableSchema
-	deferred        int32
-	pendingDeferred int32
-	deferredResults chan graphql.DeferredResult
-}
+		return e.complexity.FootballStats.PassingAttempts(childComplexity), true
+	case "FootballStats.passingCompletions":
+		if e.complexity.FootballStats.PassingCompletions == nil {
+			break
+		}
 
-func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
-	atomic.AddInt32(&ec.pendingDeferred, 1)
-	go func() {
-		ctx := graphql.WithFreshResponseContext(dg.Context)
-		dg.FieldSet.Dispatch(ctx)
-		ds := graphql.DeferredResult{
-			Path:   dg.Path,
-			Label:  dg.Label,
-			Result: dg.FieldSet,
-			Errors: graphql.GetErrors(ctx),
+		return e.complexity.FootballStats.PassingCompletions(childComplexity), true
+	case "FootballStats.passingInterceptions":
+		if e.complexity.FootballStats.PassingInterceptions == nil {
+			break
 		}
-		// null fields should bubble up
-		if dg.FieldSet.Invalids > 0 {
-			ds.Result = graphql.Null
+
+		return e.complexity.FootballStats.PassingInterceptions(childComplexity), true
+	case "FootballStats.passingTDs":
+		if e.complexity.FootballStats.PassingTDs == nil {
+			break
 		}
-		ec.deferredResults <- ds
-	}()
-}
 
-func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapSchema(ec.Schema()), nil
-}
+		return e.complexity.FootballStats.PassingTDs(childComplexity), true
+	case "FootballStats.passingYards":
+		if e.complexity.FootballStats.PassingYards == nil {
+			break
+		}
 
-func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
-	if ec.DisableIntrospection {
-		return nil, errors.New("introspection disabled")
-	}
-	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
-}
+		return e.complexity.FootballStats.PassingYards(childComplexity), true
+	case "FootballStats.pointsAllowed":
+		if e.complexity.FootballStats.PointsAllowed == nil {
+			break
+		}
 
-//go:embed "schema.graphql"
-var sourcesFS embed.FS
+		return e.complexity.FootballStats.PointsAllowed(childComplexity), true
+	case "FootballStats.receivingReceptions":
+		if e.complexity.FootballStats.ReceivingReceptions == nil {
+			break
+		}
 
-func sourceData(filename string) string {
-	data, err := sourcesFS.ReadFile(filename)
-	if err != nil {
-		panic(fmt.Sprintf("codegen problem: %s not available", filename))
-	}
-	return string(data)
-}
+		return e.complexity.FootballStats.ReceivingReceptions(childComplexity), true
+	case "FootballStats.receivingTDs":
+		if e.complexity.FootballStats.ReceivingTDs == nil {
+			break
+		}
 
-var sources = []*ast.Source{
-	{Name: "schema.graphql", Input: sourceData("schema.graphql"), BuiltIn: false},
-}
-var parsedSchema = gqlparser.MustLoadSchema(sources...)
+		return e.complexity.FootballStats.ReceivingTDs(childComplexity), true
+	case "FootballStats.receivingTargets":
+		if e.complexity.FootballStats.ReceivingTargets == nil {
+			break
+		}
 
-// endregion ************************** generated!.gotpl **************************
+		return e.complexity.FootballStats.ReceivingTargets(childComplexity), true
+	case "FootballStats.receivingYards":
+		if e.complexity.FootballStats.ReceivingYards == nil {
+			break
+		}
 
-// region    ***************************** args.gotpl *****************************
+		return e.complexity.FootballStats.ReceivingYards(childComplexity), true
+	case "FootballStats.rushingAttempts":
+		if e.complexity.FootballStats.RushingAttempts == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
-	if err != nil {
-		return nil, err
-	}
-	args["name"] = arg0
-	return args, nil
-}
+		return e.complexity.FootballStats.RushingAttempts(childComplexity), true
+	case "FootballStats.rushingTDs":
+		if e.complexity.FootballStats.RushingTDs == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_conference_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		return e.complexity.FootballStats.RushingTDs(childComplexity), true
+	case "FootballStats.rushingYards":
+		if e.complexity.FootballStats.RushingYards == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_division_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		return e.complexity.FootballStats.RushingYards(childComplexity), true
+	case "FootballStats.sacks":
+		if e.complexity.FootballStats.Sacks == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_player_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+		return e.complexity.FootballStats.Sacks(childComplexity), true
+	case "FootballStats.tackles":
+		if e.complexity.FootballStats.Tackles == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_players_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "position", ec.unmarshalOPosition2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPosition)
-	if err != nil {
-		return nil, err
-	}
-	args["position"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "teamId", ec.unmarshalOID2ᚖstring)
-	if err != nil {
-		return nil, err
-	}
-	args["teamId"] = arg1
-	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
-	if err != nil {
-		return nil, err
-	}
-	args["limit"] = arg2
-	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "offset", ec.unmarshalOInt2ᚖint)
-	if err != nil {
-		return nil, err
-	}
-	args["offset"] = arg3
-	return args, nil
-}
+		return e.complexity.FootballStats.Tackles(childComplexity), true
+	case "FootballStats.yardsAllowed":
+		if e.complexity.FootballStats.YardsAllowed == nil {
+			break
+		}
 
-func (ec *executionContext) field_Query_searchPlayers_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "query", ec.unmarshalNString2string)
-	if err != nil {
-		return nil, err
-	}
-	args["query"] = arg0
-	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
-	if err != nil {
-		return nil, err
-	}
-	args["limit"] = arg1
-	return args, nil
-}
+		return e.complexity.FootballStats.YardsAllowed(childComplexity), true
 
-func (ec *executionContext) field_Query_team_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
+	case "Injury.gamesMissed":
+		if e.complexity.Injury.GamesMissed == nil {
+			break
+		}
 
-func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.Injury.GamesMissed(childComplexity), true
+	case "Injury.id":
+		if e.complexity.Injury.ID == nil {
+			break
+		}
 
-func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.Injury.ID(childComplexity), true
+	case "Injury.injuryType":
+		if e.complexity.Injury.InjuryType == nil {
+			break
+		}
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.Injury.InjuryType(childComplexity), true
+	case "Injury.severity":
+		if e.complexity.Injury.Severity == nil {
+			break
+		}
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
-	var err error
-	args := map[string]any{}
-	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
-	if err != nil {
-		return nil, err
-	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
+		return e.complexity.Injury.Severity(childComplexity), true
+	case "Injury.week":
+		if e.complexity.Injury.Week == nil {
+			break
+		}
 
-// endregion ***************************** args.gotpl *****************************
+		return e.complexity.Injury.Week(childComplexity), true
+	case "Injury.year":
+		if e.complexity.Injury.Year == nil {
+			break
+		}
 
-// region    ************************** directives.gotpl **************************
+		return e.complexity.Injury.Year(childComplexity), true
 
-// endregion ************************** directives.gotpl **************************
+	case "Matchup.awayScore":
+		if e.complexity.Matchup.AwayScore == nil {
+			break
+		}
 
-// region    **************************** field.gotpl *****************************
+		return e.complexity.Matchup.AwayScore(childComplexity), true
+	case "Matchup.awayTeam":
+		if e.complexity.Matchup.AwayTeam == nil {
+			break
+		}
 
-func (ec *executionContext) _Conference_id(ctx context.Context, field graphql.CollectedField, obj *model.Conference) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Conference_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Matchup.AwayTeam(childComplexity), true
+	case "Matchup.homeScore":
+		if e.complexity.Matchup.HomeScore == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Conference_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Conference",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Matchup.HomeScore(childComplexity), true
+	case "Matchup.homeTeam":
+		if e.complexity.Matchup.HomeTeam == nil {
+			break
+		}
 
-func (ec *executionContext) _Conference_name(ctx context.Context, field graphql.CollectedField, obj *model.Conference) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Conference_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Matchup.HomeTeam(childComplexity), true
+	case "Matchup.id":
+		if e.complexity.Matchup.ID == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Conference_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Conference",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Matchup.ID(childComplexity), true
+	case "Matchup.isComplete":
+		if e.complexity.Matchup.IsComplete == nil {
+			break
+		}
 
-func (ec *executionContext) _Conference_divisions(ctx context.Context, field graphql.CollectedField, obj *model.Conference) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Conference_divisions,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Conference().Divisions(ctx, obj)
-		},
-		nil,
-		ec.marshalNDivision2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐDivisionᚄ,
-		true,
-		true,
-	)
-}
+		return e.complexity.Matchup.IsComplete(childComplexity), true
+	case "Matchup.week":
+		if e.complexity.Matchup.Week == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Conference_divisions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Conference",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Division_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Division_name(ctx, field)
-			case "conference":
-				return ec.fieldContext_Division_conference(ctx, field)
-			case "teams":
-				return ec.fieldContext_Division_teams(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Division", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Matchup.Week(childComplexity), true
+	case "Matchup.winner":
+		if e.complexity.Matchup.Winner == nil {
+			break
+		}
 
-func (ec *executionContext) _Division_id(ctx context.Context, field graphql.CollectedField, obj *model.Division) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Division_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Matchup.Winner(childComplexity), true
 
-func (ec *executionContext) fieldContext_Division_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Division",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "Mutation.acceptTrade":
+		if e.complexity.Mutation.AcceptTrade == nil {
+			break
+		}
 
-func (ec *executionContext) _Division_name(ctx context.Context, field graphql.CollectedField, obj *model.Division) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Division_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_acceptTrade_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Division_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Division",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.AcceptTrade(childComplexity, args["tradeId"].(string)), true
+	case "Mutation.addFreeAgent":
+		if e.complexity.Mutation.AddFreeAgent == nil {
+			break
+		}
 
-func (ec *executionContext) _Division_conference(ctx context.Context, field graphql.CollectedField, obj *model.Division) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Division_conference,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Division().Conference(ctx, obj)
-		},
-		nil,
-		ec.marshalNConference2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐConference,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_addFreeAgent_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Division_conference(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Division",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Conference_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Conference_name(ctx, field)
-			case "divisions":
-				return ec.fieldContext_Conference_divisions(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Conference", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.AddFreeAgent(childComplexity, args["teamId"].(string), args["playerId"].(string), args["dropPlayerId"].(*string)), true
+	case "Mutation.approveTrade":
+		if e.complexity.Mutation.ApproveTrade == nil {
+			break
+		}
 
-func (ec *executionContext) _Division_teams(ctx context.Context, field graphql.CollectedField, obj *model.Division) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Division_teams,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Division().Teams(ctx, obj)
-		},
-		nil,
-		ec.marshalNTeam2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐTeamᚄ,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_approveTrade_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Division_teams(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Division",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Team_id(ctx, field)
-			case "city":
-				return ec.fieldContext_Team_city(ctx, field)
-			case "state":
-				return ec.fieldContext_Team_state(ctx, field)
-			case "name":
-				return ec.fieldContext_Team_name(ctx, field)
-			case "abbreviation":
-				return ec.fieldContext_Team_abbreviation(ctx, field)
-			case "division":
-				return ec.fieldContext_Team_division(ctx, field)
-			case "players":
-				return ec.fieldContext_Team_players(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Team", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.ApproveTrade(childComplexity, args["tradeId"].(string)), true
+	case "Mutation.cancelTrade":
+		if e.complexity.Mutation.CancelTrade == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_passingAttempts(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_passingAttempts,
-		func(ctx context.Context) (any, error) {
-			return obj.PassingAttempts, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_cancelTrade_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_passingAttempts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.CancelTrade(childComplexity, args["tradeId"].(string)), true
+	case "Mutation.cancelWaiverClaim":
+		if e.complexity.Mutation.CancelWaiverClaim == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_passingCompletions(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_passingCompletions,
-		func(ctx context.Context) (any, error) {
-			return obj.PassingCompletions, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_cancelWaiverClaim_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_passingCompletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.CancelWaiverClaim(childComplexity, args["claimId"].(string)), true
+	case "Mutation.counterTrade":
+		if e.complexity.Mutation.CounterTrade == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_passingYards(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_passingYards,
-		func(ctx context.Context) (any, error) {
-			return obj.PassingYards, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_counterTrade_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_passingYards(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.CounterTrade(childComplexity, args["tradeId"].(string), args["offeredPlayerIds"].([]string), args["requestedPlayerIds"].([]string)), true
+	case "Mutation.dropPlayer":
+		if e.complexity.Mutation.DropPlayer == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_passingTDs(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_passingTDs,
-		func(ctx context.Context) (any, error) {
-			return obj.PassingTDs, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_dropPlayer_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_passingTDs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.DropPlayer(childComplexity, args["teamId"].(string), args["playerId"].(string)), true
+	case "Mutation.generateSchedule":
+		if e.complexity.Mutation.GenerateSchedule == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_passingInterceptions(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_passingInterceptions,
-		func(ctx context.Context) (any, error) {
-			return obj.PassingInterceptions, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_generateSchedule_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_passingInterceptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.GenerateSchedule(childComplexity, args["draftRoomId"].(string), args["regularSeasonWeeks"].(*int), args["playoffs"].(*model.PlayoffSettingsInput), args["waivers"].(*model.WaiverSettingsInput), args["trades"].(*model.TradeSettingsInput)), true
+	case "Mutation.optimizeLineup":
+		if e.complexity.Mutation.OptimizeLineup == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_rushingAttempts(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_rushingAttempts,
-		func(ctx context.Context) (any, error) {
-			return obj.RushingAttempts, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_optimizeLineup_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_rushingAttempts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.OptimizeLineup(childComplexity, args["teamId"].(string), args["week"].(int)), true
+	case "Mutation.processWaivers":
+		if e.complexity.Mutation.ProcessWaivers == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_rushingYards(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_rushingYards,
-		func(ctx context.Context) (any, error) {
-			return obj.RushingYards, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_processWaivers_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_rushingYards(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.ProcessWaivers(childComplexity, args["draftRoomId"].(string)), true
+	case "Mutation.proposeTrade":
+		if e.complexity.Mutation.ProposeTrade == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_rushingTDs(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_rushingTDs,
-		func(ctx context.Context) (any, error) {
-			return obj.RushingTDs, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_proposeTrade_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_rushingTDs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.ProposeTrade(childComplexity, args["proposingTeamId"].(string), args["receivingTeamId"].(string), args["offeredPlayerIds"].([]string), args["requestedPlayerIds"].([]string)), true
+	case "Mutation.rejectTrade":
+		if e.complexity.Mutation.RejectTrade == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_receivingTargets(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_receivingTargets,
-		func(ctx context.Context) (any, error) {
-			return obj.ReceivingTargets, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_rejectTrade_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_receivingTargets(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.RejectTrade(childComplexity, args["tradeId"].(string)), true
+	case "Mutation.setLineup":
+		if e.complexity.Mutation.SetLineup == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_receivingReceptions(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_receivingReceptions,
-		func(ctx context.Context) (any, error) {
-			return obj.ReceivingReceptions, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_setLineup_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_receivingReceptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.SetLineup(childComplexity, args["teamId"].(string), args["moves"].([]*model.LineupMoveInput)), true
+	case "Mutation.simulateWeek":
+		if e.complexity.Mutation.SimulateWeek == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_receivingYards(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_receivingYards,
-		func(ctx context.Context) (any, error) {
-			return obj.ReceivingYards, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_simulateWeek_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_receivingYards(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.SimulateWeek(childComplexity, args["draftRoomId"].(string)), true
+	case "Mutation.submitWaiverClaim":
+		if e.complexity.Mutation.SubmitWaiverClaim == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_receivingTDs(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_receivingTDs,
-		func(ctx context.Context) (any, error) {
-			return obj.ReceivingTDs, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Mutation_submitWaiverClaim_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_receivingTDs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Mutation.SubmitWaiverClaim(childComplexity, args["teamId"].(string), args["addPlayerId"].(string), args["dropPlayerId"].(*string), args["bid"].(*int)), true
+	case "Mutation.vetoTrade":
+		if e.complexity.Mutation.VetoTrade == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_fumbles(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_fumbles,
-		func(ctx context.Context) (any, error) {
-			return obj.Fumbles, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
-
-func (ec *executionContext) fieldContext_FootballStats_fumbles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_vetoTrade_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _FootballStats_fumblesLost(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_fumblesLost,
-		func(ctx context.Context) (any, error) {
-			return obj.FumblesLost, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.Mutation.VetoTrade(childComplexity, args["tradeId"].(string)), true
+	case "Mutation.voteToVetoTrade":
+		if e.complexity.Mutation.VoteToVetoTrade == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_fumblesLost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		args, err := ec.field_Mutation_voteToVetoTrade_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) _FootballStats_fieldGoals(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_fieldGoals,
-		func(ctx context.Context) (any, error) {
-			return obj.FieldGoals, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.Mutation.VoteToVetoTrade(childComplexity, args["tradeId"].(string), args["teamId"].(string)), true
 
-func (ec *executionContext) fieldContext_FootballStats_fieldGoals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	case "Player.age":
+		if e.complexity.Player.Age == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_fieldGoalsMade(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_fieldGoalsMade,
-		func(ctx context.Context) (any, error) {
-			return obj.FieldGoalsMade, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.Player.Age(childComplexity), true
+	case "Player.depthChartRank":
+		if e.complexity.Player.DepthChartRank == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_fieldGoalsMade(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Player.DepthChartRank(childComplexity), true
+	case "Player.draftPick":
+		if e.complexity.Player.DraftPick == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_fieldGoalsMissed(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_fieldGoalsMissed,
-		func(ctx context.Context) (any, error) {
-			return obj.FieldGoalsMissed, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.Player.DraftPick(childComplexity), true
+	case "Player.draftRound":
+		if e.complexity.Player.DraftRound == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_fieldGoalsMissed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Player.DraftRound(childComplexity), true
+	case "Player.draftYear":
+		if e.complexity.Player.DraftYear == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_extraPoints(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_extraPoints,
-		func(ctx context.Context) (any, error) {
-			return obj.ExtraPoints, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.Player.DraftYear(childComplexity), true
+	case "Player.firstName":
+		if e.complexity.Player.FirstName == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_extraPoints(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Player.FirstName(childComplexity), true
+	case "Player.fullName":
+		if e.complexity.Player.FullName == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_extraPointsMade(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_extraPointsMade,
-		func(ctx context.Context) (any, error) {
-			return obj.ExtraPointsMade, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.Player.FullName(childComplexity), true
+	case "Player.height":
+		if e.complexity.Player.Height == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_extraPointsMade(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Player.Height(childComplexity), true
+	case "Player.id":
+		if e.complexity.Player.ID == nil {
+			break
+		}
 
-func (ec *executionContext) _FootballStats_extraPointsMissed(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_FootballStats_extraPointsMissed,
-		func(ctx context.Context) (any, error) {
-			return obj.ExtraPointsMissed, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+		return e.complexity.Player.ID(childComplexity), true
+	case "Player.injuries":
+		if e.complexity.Player.Injuries == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_FootballStats_extraPointsMissed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "FootballStats",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Player.Injuries(childComplexity), true
+	case "Player.injuryRisk":
+		if e.complexity.Player.InjuryRisk == nil {
+			break
+		}
 
-func (ec *executionContext) _Player_id(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Player_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Player.InjuryRisk(childComplexity), true
+	case "Player.jerseyNumber":
+		if e.complexity.Player.JerseyNumber == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Player_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Player",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Player.JerseyNumber(childComplexity), true
+	case "Player.lastName":
+		if e.complexity.Player.LastName == nil {
+			break
+		}
 
-func (ec *executionContext) _Player_firstName(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Player_firstName,
-		func(ctx context.Context) (any, error) {
-			return obj.FirstName, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Player.LastName(childComplexity), true
+	case "Player.position":
+		if e.complexity.Player.Position == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Player_firstName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Player",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Player.Position(childComplexity), true
+	case "Player.scoutedSkill":
+		if e.complexity.Player.ScoutedSkill == nil {
+			break
+		}
 
-func (ec *executionContext) _Player_lastName(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Player_lastName,
-		func(ctx context.Context) (any, error) {
-			return obj.LastName, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Player.ScoutedSkill(childComplexity), true
+	case "Player.skill":
+		if e.complexity.Player.Skill == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Player_lastName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Player",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Player.Skill(childComplexity), true
+	case "Player.status":
+		if e.complexity.Player.Status == nil {
+			break
+		}
 
-func (ec *executionContext) _Player_fullName(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Player_fullName,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Player().FullName(ctx, obj)
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+		return e.complexity.Player.Status(childComplexity), true
+	case "Player.team":
+		if e.complexity.Player.Team == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Player_fullName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Player",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Player.Team(childComplexity), true
+	case "Player.weight":
+		if e.complexity.Player.Weight == nil {
+			break
+		}
 
-func (ec *executionContext) _Player_position(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Player_position,
-		func(ctx context.Context) (any, error) {
-			return obj.Position, nil
-		},
-		nil,
-		ec.marshalNPosition2fantasyᚑdraftᚋgraphᚋmodelᚐPosition,
-		true,
-		true,
-	)
-}
+		return e.complexity.Player.Weight(childComplexity), true
+	case "Player.yearlyStats":
+		if e.complexity.Player.YearlyStats == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Player_position(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Player",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Position does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Player.YearlyStats(childComplexity), true
+	case "Player.yearsOfExperience":
+		if e.complexity.Player.YearsOfExperience == nil {
+			break
+		}
 
-func (ec *executionContext) _Player_team(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Player_team,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Player().Team(ctx, obj)
-		},
-		nil,
-		ec.marshalNTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTeam,
-		true,
-		true,
-	)
-}
+		return e.complexity.Player.YearsOfExperience(childComplexity), true
 
-func (ec *executionContext) fieldContext_Player_team(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Player",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Team_id(ctx, field)
-			case "city":
-				return ec.fieldContext_Team_city(ctx, field)
-			case "state":
-				return ec.fieldContext_Team_state(ctx, field)
-			case "name":
-				return ec.fieldContext_Team_name(ctx, field)
-			case "abbreviation":
-				return ec.fieldContext_Team_abbreviation(ctx, field)
-			case "division":
-				return ec.fieldContext_Team_division(ctx, field)
-			case "players":
-				return ec.fieldContext_Team_players(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Team", field.Name)
-		},
-	}
-	return fc, nil
-}
+	case "PlayoffBracket.champion":
+		if e.complexity.PlayoffBracket.Champion == nil {
+			break
+		}
 
-func (ec *executionContext) _Player_height(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Player_height,
-		func(ctx context.Context) (any, error) {
-			return obj.Height, nil
-		},
-		nil,
-		ec.marshalOInt2ᚖint,
-		true,
-		false,
-	)
-}
+		return e.complexity.PlayoffBracket.Champion(childComplexity), true
+	case "PlayoffBracket.final":
+		if e.complexity.PlayoffBracket.Final == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Player_height(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Player",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.PlayoffBracket.Final(childComplexity), true
+	case "PlayoffBracket.games":
+		if e.complexity.PlayoffBracket.Games == nil {
+			break
+		}
 
-func (ec *executionContext) _Player_weight(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Player_weight,
-		func(ctx context.Context) (any, error) {
-			return obj.Weight, nil
-		},
-		nil,
-		ec.marshalOInt2ᚖint,
-		true,
-		false,
-	)
-}
+		return e.complexity.PlayoffBracket.Games(childComplexity), true
+	case "PlayoffBracket.rounds":
+		if e.complexity.PlayoffBracket.Rounds == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Player_weight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Player",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.PlayoffBracket.Rounds(childComplexity), true
 
-func (ec *executionContext) _Player_age(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Player_age,
-		func(ctx context.Context) (any, error) {
-			return obj.Age, nil
-		},
-		nil,
-		ec.marshalOInt2ᚖint,
-		true,
-		false,
-	)
-}
+	case "PlayoffGame.awayScore":
+		if e.complexity.PlayoffGame.AwayScore == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Player_age(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Player",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.PlayoffGame.AwayScore(childComplexity), true
+	case "PlayoffGame.awaySeed":
+		if e.complexity.PlayoffGame.AwaySeed == nil {
+			break
+		}
 
-func (ec *executionContext) _Player_yearsOfExperience(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Player_yearsOfExperience,
-		func(ctx context.Context) (any, error) {
-			return obj.YearsOfExperience, nil
-		},
-		nil,
-		ec.marshalOInt2ᚖint,
-		true,
-		false,
-	)
-}
+		return e.complexity.PlayoffGame.AwaySeed(childComplexity), true
+	case "PlayoffGame.awaySource":
+		if e.complexity.PlayoffGame.AwaySource == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Player_yearsOfExperience(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Player",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.PlayoffGame.AwaySource(childComplexity), true
+	case "PlayoffGame.awayTeam":
+		if e.complexity.PlayoffGame.AwayTeam == nil {
+			break
+		}
 
-func (ec *executionContext) _Player_draftYear(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Player_draftYear,
-		func(ctx context.Context) (any, error) {
-			return obj.DraftYear, nil
-		},
-		nil,
-		ec.marshalOInt2ᚖint,
-		true,
-		false,
-	)
-}
+		return e.complexity.PlayoffGame.AwayTeam(childComplexity), true
+	case "PlayoffGame.bracketPosition":
+		if e.complexity.PlayoffGame.BracketPosition == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Player_draftYear(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Player",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.PlayoffGame.BracketPosition(childComplexity), true
+	case "PlayoffGame.homeScore":
+		if e.complexity.PlayoffGame.HomeScore == nil {
+			break
+		}
 
-func (ec *executionContext) _Player_jerseyNumber(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Player_jerseyNumber,
-		func(ctx context.Context) (any, error) {
-			return obj.JerseyNumber, nil
-		},
-		nil,
-		ec.marshalOInt2ᚖint,
-		true,
-		false,
-	)
-}
+		return e.complexity.PlayoffGame.HomeScore(childComplexity), true
+	case "PlayoffGame.homeSeed":
+		if e.complexity.PlayoffGame.HomeSeed == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Player_jerseyNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Player",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.PlayoffGame.HomeSeed(childComplexity), true
+	case "PlayoffGame.homeSource":
+		if e.complexity.PlayoffGame.HomeSource == nil {
+			break
+		}
 
-func (ec *executionContext) _Player_status(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Player_status,
-		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
-		},
-		nil,
-		ec.marshalNPlayerStatus2fantasyᚑdraftᚋgraphᚋmodelᚐPlayerStatus,
-		true,
-		true,
-	)
-}
+		return e.complexity.PlayoffGame.HomeSource(childComplexity), true
+	case "PlayoffGame.homeTeam":
+		if e.complexity.PlayoffGame.HomeTeam == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Player_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Player",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type PlayerStatus does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.PlayoffGame.HomeTeam(childComplexity), true
+	case "PlayoffGame.id":
+		if e.complexity.PlayoffGame.ID == nil {
+			break
+		}
 
-func (ec *executionContext) _Player_skill(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Player_skill,
-		func(ctx context.Context) (any, error) {
-			return obj.Skill, nil
-		},
-		nil,
-		ec.marshalOFloat2ᚖfloat64,
-		true,
-		false,
-	)
-}
+		return e.complexity.PlayoffGame.ID(childComplexity), true
+	case "PlayoffGame.isComplete":
+		if e.complexity.PlayoffGame.IsComplete == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Player_skill(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Player",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.PlayoffGame.IsComplete(childComplexity), true
+	case "PlayoffGame.round":
+		if e.complexity.PlayoffGame.Round == nil {
+			break
+		}
 
-func (ec *executionContext) _Player_yearlyStats(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Player_yearlyStats,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Player().YearlyStats(ctx, obj)
-		},
-		nil,
-		ec.marshalNYearlyStat2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐYearlyStatᚄ,
-		true,
-		true,
-	)
-}
+		return e.complexity.PlayoffGame.Round(childComplexity), true
+	case "PlayoffGame.winner":
+		if e.complexity.PlayoffGame.Winner == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Player_yearlyStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Player",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_YearlyStat_id(ctx, field)
-			case "year":
-				return ec.fieldContext_YearlyStat_year(ctx, field)
-			case "sportType":
-				return ec.fieldContext_YearlyStat_sportType(ctx, field)
-			case "stats":
-				return ec.fieldContext_YearlyStat_stats(ctx, field)
-			case "fantasyPoints":
-				return ec.fieldContext_YearlyStat_fantasyPoints(ctx, field)
-			case "gamesPlayed":
-				return ec.fieldContext_YearlyStat_gamesPlayed(ctx, field)
-			case "fantasyPointsPerGame":
-				return ec.fieldContext_YearlyStat_fantasyPointsPerGame(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type YearlyStat", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.PlayoffGame.Winner(childComplexity), true
 
-func (ec *executionContext) _Query_conferences(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_conferences,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Conferences(ctx)
-		},
-		nil,
-		ec.marshalNConference2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐConferenceᚄ,
-		true,
-		true,
-	)
-}
+	case "PlayoffSettings.byes":
+		if e.complexity.PlayoffSettings.Byes == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Query_conferences(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Conference_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Conference_name(ctx, field)
-			case "divisions":
-				return ec.fieldContext_Conference_divisions(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Conference", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.PlayoffSettings.Byes(childComplexity), true
+	case "PlayoffSettings.teams":
+		if e.complexity.PlayoffSettings.Teams == nil {
+			break
+		}
 
-func (ec *executionContext) _Query_conference(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_conference,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Conference(ctx, fc.Args["id"].(string))
-		},
-		nil,
-		ec.marshalOConference2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐConference,
-		true,
-		false,
-	)
-}
+		return e.complexity.PlayoffSettings.Teams(childComplexity), true
+	case "PlayoffSettings.tiebreakers":
+		if e.complexity.PlayoffSettings.Tiebreakers == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Query_conference(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Conference_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Conference_name(ctx, field)
-			case "divisions":
-				return ec.fieldContext_Conference_divisions(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Conference", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.PlayoffSettings.Tiebreakers(childComplexity), true
+	case "PlayoffSettings.weeksPerRound":
+		if e.complexity.PlayoffSettings.WeeksPerRound == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_conference_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query_divisions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_divisions,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Divisions(ctx)
-		},
-		nil,
-		ec.marshalNDivision2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐDivisionᚄ,
-		true,
-		true,
-	)
-}
+		return e.complexity.PlayoffSettings.WeeksPerRound(childComplexity), true
 
-func (ec *executionContext) fieldContext_Query_divisions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Division_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Division_name(ctx, field)
-			case "conference":
-				return ec.fieldContext_Division_conference(ctx, field)
-			case "teams":
-				return ec.fieldContext_Division_teams(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Division", field.Name)
-		},
-	}
-	return fc, nil
-}
+	case "Query.conference":
+		if e.complexity.Query.Conference == nil {
+			break
+		}
 
-func (ec *executionContext) _Query_division(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_division,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Division(ctx, fc.Args["id"].(string))
-		},
-		nil,
-		ec.marshalODivision2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐDivision,
-		true,
-		false,
-	)
-}
+		args, err := ec.field_Query_conference_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Query_division(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Division_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Division_name(ctx, field)
-			case "conference":
-				return ec.fieldContext_Division_conference(ctx, field)
-			case "teams":
-				return ec.fieldContext_Division_teams(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Division", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.Query.Conference(childComplexity, args["id"].(string)), true
+	case "Query.conferences":
+		if e.complexity.Query.Conferences == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_division_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query_teams(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_teams,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Query().Teams(ctx)
-		},
-		nil,
-		ec.marshalNTeam2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐTeamᚄ,
-		true,
-		true,
-	)
-}
+		args, err := ec.field_Query_conferences_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Query_teams(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Team_id(ctx, field)
-			case "city":
-				return ec.fieldContext_Team_city(ctx, field)
-			case "state":
-				return ec.fieldContext_Team_state(ctx, field)
-			case "name":
-				return ec.fieldContext_Team_name(ctx, field)
-			case "abbreviation":
-				return ec.fieldContext_Team_abbreviation(ctx, field)
-			case "division":
-				return ec.fieldContext_Team_division(ctx, field)
-			case "players":
-				return ec.fieldContext_Team_players(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Team", field.Name)
-		},
-	}
-	return fc, nil
-}
+		return e.complexity.Query.Conferences(childComplexity, args["universeId"].(*string)), true
+	case "Query.division":
+		if e.complexity.Query.Division == nil {
+			break
+		}
 
-func (ec *executionContext) _Query_team(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_team,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Team(ctx, fc.Args["id"].(string))
-		},
-		nil,
-		ec.marshalOTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTeam,
-		true,
-		false,
-	)
-}
+		args, err := ec.field_Query_division_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-func (ec *executionContext) fieldContext_Query_team(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Team_id(ctx, field)
-			case "city":
-				return ec.fieldContext_Team_city(ctx, field)
-			case "state":
-				return ec.fieldContext_Team_state(ctx, field)
-			case "name":
-				return ec.fieldContext_Team_name(ctx, field)
-			case "abbreviation":
-				return ec.fieldContext_Team_abbreviation(ctx, field)
-			case "division":
-				return ec.fieldContext_Team_division(ctx, field)
-			case "players":
-				return ec.fieldContext_Team_players(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Team", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		return e.complexity.Query.Division(childComplexity, args["id"].(string)), true
+	case "Query.divisions":
+		if e.complexity.Query.Divisions == nil {
+			break
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_team_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query_players(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_players,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Players(ctx, fc.Args["position"].(*model.Position), fc.Args["teamId"].(*string), fc.Args["limit"].(*int), fc.Args["offset"].(*int))
-		},
-		nil,
-		ec.marshalNPlayer2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayerᚄ,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.Divisions(childComplexity), true
+	case "Query.fantasySeason":
+		if e.complexity.Query.FantasySeason == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Query_players(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Player_id(ctx, field)
-			case "firstName":
-				return ec.fieldContext_Player_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_Player_lastName(ctx, field)
-			case "fullName":
-				return ec.fieldContext_Player_fullName(ctx, field)
-			case "position":
-				return ec.fieldContext_Player_position(ctx, field)
-			case "team":
-				return ec.fieldContext_Player_team(ctx, field)
-			case "height":
-				return ec.fieldContext_Player_height(ctx, field)
-			case "weight":
-				return ec.fieldContext_Player_weight(ctx, field)
-			case "age":
-				return ec.fieldContext_Player_age(ctx, field)
-			case "yearsOfExperience":
-				return ec.fieldContext_Player_yearsOfExperience(ctx, field)
-			case "draftYear":
-				return ec.fieldContext_Player_draftYear(ctx, field)
-			case "jerseyNumber":
-				return ec.fieldContext_Player_jerseyNumber(ctx, field)
-			case "status":
-				return ec.fieldContext_Player_status(ctx, field)
-			case "skill":
-				return ec.fieldContext_Player_skill(ctx, field)
-			case "yearlyStats":
-				return ec.fieldContext_Player_yearlyStats(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		args, err := ec.field_Query_fantasySeason_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_players_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query_player(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_player,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().Player(ctx, fc.Args["id"].(string))
-		},
-		nil,
-		ec.marshalOPlayer2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayer,
-		true,
-		false,
-	)
-}
+		return e.complexity.Query.FantasySeason(childComplexity, args["draftRoomId"].(string)), true
+	case "Query.freeAgents":
+		if e.complexity.Query.FreeAgents == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Query_player(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Player_id(ctx, field)
-			case "firstName":
-				return ec.fieldContext_Player_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_Player_lastName(ctx, field)
-			case "fullName":
-				return ec.fieldContext_Player_fullName(ctx, field)
-			case "position":
-				return ec.fieldContext_Player_position(ctx, field)
-			case "team":
-				return ec.fieldContext_Player_team(ctx, field)
-			case "height":
-				return ec.fieldContext_Player_height(ctx, field)
-			case "weight":
-				return ec.fieldContext_Player_weight(ctx, field)
-			case "age":
-				return ec.fieldContext_Player_age(ctx, field)
-			case "yearsOfExperience":
-				return ec.fieldContext_Player_yearsOfExperience(ctx, field)
-			case "draftYear":
-				return ec.fieldContext_Player_draftYear(ctx, field)
-			case "jerseyNumber":
-				return ec.fieldContext_Player_jerseyNumber(ctx, field)
-			case "status":
-				return ec.fieldContext_Player_status(ctx, field)
-			case "skill":
-				return ec.fieldContext_Player_skill(ctx, field)
-			case "yearlyStats":
-				return ec.fieldContext_Player_yearlyStats(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		args, err := ec.field_Query_freeAgents_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_player_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query_searchPlayers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query_searchPlayers,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.resolvers.Query().SearchPlayers(ctx, fc.Args["query"].(string), fc.Args["limit"].(*int))
-		},
-		nil,
-		ec.marshalNPlayer2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayerᚄ,
-		true,
-		true,
-	)
-}
+		return e.complexity.Query.FreeAgents(childComplexity, args["draftRoomId"].(string), args["position"].(*model.Position), args["limit"].(*int), args["offset"].(*int)), true
+	case "Query.matchups":
+		if e.complexity.Query.Matchups == nil {
+			break
+		}
 
-func (ec *executionContext) fieldContext_Query_searchPlayers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Player_id(ctx, field)
-			case "firstName":
-				return ec.fieldContext_Player_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_Player_lastName(ctx, field)
-			case "fullName":
-				return ec.fieldContext_Player_fullName(ctx, field)
-			case "position":
-				return ec.fieldContext_Player_position(ctx, field)
-			case "team":
-				return ec.fieldContext_Player_team(ctx, field)
-			case "height":
-				return ec.fieldContext_Player_height(ctx, field)
-			case "weight":
-				return ec.fieldContext_Player_weight(ctx, field)
-			case "age":
-				return ec.fieldContext_Player_age(ctx, field)
-			case "yearsOfExperience":
-				return ec.fieldContext_Player_yearsOfExperience(ctx, field)
-			case "draftYear":
-				return ec.fieldContext_Player_draftYear(ctx, field)
-			case "jerseyNumber":
-				return ec.fieldContext_Player_jerseyNumber(ctx, field)
-			case "status":
-				return ec.fieldContext_Player_status(ctx, field)
-			case "skill":
-				return ec.fieldContext_Player_skill(ctx, field)
-			case "yearlyStats":
-				return ec.fieldContext_Player_yearlyStats(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		args, err := ec.field_Query_matchups_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
 		}
-	}()
+
+		return e.complexity.Query.Matchups(childComplexity, args["draftRoomId"].(string), args["week"].(int)), true
+	case "Query.player":
+		if e.complexity.Query.Player == nil {
+			break
+		}
+
+		args, err := ec.field_Query_player_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Player(childComplexity, args["id"].(string)), true
+	case "Query.players":
+		if e.complexity.Query.Players == nil {
+			break
+		}
+
+		args, err := ec.field_Query_players_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Players(childComplexity, args["position"].(*model.Position), args["teamId"].(*string), args["universeId"].(*string), args["limit"].(*int), args["offset"].(*int)), true
+	case "Query.playoffBracket":
+		if e.complexity.Query.PlayoffBracket == nil {
+			break
+		}
+
+		args, err := ec.field_Query_playoffBracket_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PlayoffBracket(childComplexity, args["draftRoomId"].(string)), true
+	case "Query.rotoStandings":
+		if e.complexity.Query.RotoStandings == nil {
+			break
+		}
+
+		args, err := ec.field_Query_rotoStandings_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RotoStandings(childComplexity, args["draftRoomId"].(string), args["year"].(*int)), true
+	case "Query.searchPlayers":
+		if e.complexity.Query.SearchPlayers == nil {
+			break
+		}
+
+		args, err := ec.field_Query_searchPlayers_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SearchPlayers(childComplexity, args["query"].(string), args["limit"].(*int)), true
+	case "Query.standings":
+		if e.complexity.Query.Standings == nil {
+			break
+		}
+
+		args, err := ec.field_Query_standings_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Standings(childComplexity, args["draftRoomId"].(string)), true
+	case "Query.team":
+		if e.complexity.Query.Team == nil {
+			break
+		}
+
+		args, err := ec.field_Query_team_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Team(childComplexity, args["id"].(string)), true
+	case "Query.teams":
+		if e.complexity.Query.Teams == nil {
+			break
+		}
+
+		args, err := ec.field_Query_teams_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Teams(childComplexity, args["universeId"].(*string)), true
+	case "Query.trade":
+		if e.complexity.Query.Trade == nil {
+			break
+		}
+
+		args, err := ec.field_Query_trade_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Trade(childComplexity, args["id"].(string)), true
+	case "Query.trades":
+		if e.complexity.Query.Trades == nil {
+			break
+		}
+
+		args, err := ec.field_Query_trades_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Trades(childComplexity, args["draftRoomId"].(string), args["teamId"].(*string), args["status"].(*model.TradeStatus)), true
+	case "Query.transactions":
+		if e.complexity.Query.Transactions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_transactions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Transactions(childComplexity, args["draftRoomId"].(string), args["teamId"].(*string), args["limit"].(*int)), true
+	case "Query.universes":
+		if e.complexity.Query.Universes == nil {
+			break
+		}
+
+		return e.complexity.Query.Universes(childComplexity), true
+	case "Query.waiverClaims":
+		if e.complexity.Query.WaiverClaims == nil {
+			break
+		}
+
+		args, err := ec.field_Query_waiverClaims_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.WaiverClaims(childComplexity, args["draftRoomId"].(string), args["teamId"].(*string), args["status"].(*model.WaiverClaimStatus)), true
+
+	case "RosterEntry.player":
+		if e.complexity.RosterEntry.Player == nil {
+			break
+		}
+
+		return e.complexity.RosterEntry.Player(childComplexity), true
+	case "RosterEntry.rosterSpot":
+		if e.complexity.RosterEntry.RosterSpot == nil {
+			break
+		}
+
+		return e.complexity.RosterEntry.RosterSpot(childComplexity), true
+
+	case "RotoCategoryScore.category":
+		if e.complexity.RotoCategoryScore.Category == nil {
+			break
+		}
+
+		return e.complexity.RotoCategoryScore.Category(childComplexity), true
+	case "RotoCategoryScore.points":
+		if e.complexity.RotoCategoryScore.Points == nil {
+			break
+		}
+
+		return e.complexity.RotoCategoryScore.Points(childComplexity), true
+	case "RotoCategoryScore.value":
+		if e.complexity.RotoCategoryScore.Value == nil {
+			break
+		}
+
+		return e.complexity.RotoCategoryScore.Value(childComplexity), true
+
+	case "RotoStanding.categories":
+		if e.complexity.RotoStanding.Categories == nil {
+			break
+		}
+
+		return e.complexity.RotoStanding.Categories(childComplexity), true
+	case "RotoStanding.points":
+		if e.complexity.RotoStanding.Points == nil {
+			break
+		}
+
+		return e.complexity.RotoStanding.Points(childComplexity), true
+	case "RotoStanding.rank":
+		if e.complexity.RotoStanding.Rank == nil {
+			break
+		}
+
+		return e.complexity.RotoStanding.Rank(childComplexity), true
+	case "RotoStanding.team":
+		if e.complexity.RotoStanding.Team == nil {
+			break
+		}
+
+		return e.complexity.RotoStanding.Team(childComplexity), true
+
+	case "Standing.losses":
+		if e.complexity.Standing.Losses == nil {
+			break
+		}
+
+		return e.complexity.Standing.Losses(childComplexity), true
+	case "Standing.pointsAgainst":
+		if e.complexity.Standing.PointsAgainst == nil {
+			break
+		}
+
+		return e.complexity.Standing.PointsAgainst(childComplexity), true
+	case "Standing.pointsFor":
+		if e.complexity.Standing.PointsFor == nil {
+			break
+		}
+
+		return e.complexity.Standing.PointsFor(childComplexity), true
+	case "Standing.rank":
+		if e.complexity.Standing.Rank == nil {
+			break
+		}
+
+		return e.complexity.Standing.Rank(childComplexity), true
+	case "Standing.team":
+		if e.complexity.Standing.Team == nil {
+			break
+		}
+
+		return e.complexity.Standing.Team(childComplexity), true
+	case "Standing.ties":
+		if e.complexity.Standing.Ties == nil {
+			break
+		}
+
+		return e.complexity.Standing.Ties(childComplexity), true
+	case "Standing.wins":
+		if e.complexity.Standing.Wins == nil {
+			break
+		}
+
+		return e.complexity.Standing.Wins(childComplexity), true
+
+	case "Team.abbreviation":
+		if e.complexity.Team.Abbreviation == nil {
+			break
+		}
+
+		return e.complexity.Team.Abbreviation(childComplexity), true
+	case "Team.city":
+		if e.complexity.Team.City == nil {
+			break
+		}
+
+		return e.complexity.Team.City(childComplexity), true
+	case "Team.depthChart":
+		if e.complexity.Team.DepthChart == nil {
+			break
+		}
+
+		args, err := ec.field_Team_depthChart_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Team.DepthChart(childComplexity, args["position"].(*model.Position)), true
+	case "Team.division":
+		if e.complexity.Team.Division == nil {
+			break
+		}
+
+		return e.complexity.Team.Division(childComplexity), true
+	case "Team.id":
+		if e.complexity.Team.ID == nil {
+			break
+		}
+
+		return e.complexity.Team.ID(childComplexity), true
+	case "Team.name":
+		if e.complexity.Team.Name == nil {
+			break
+		}
+
+		return e.complexity.Team.Name(childComplexity), true
+	case "Team.players":
+		if e.complexity.Team.Players == nil {
+			break
+		}
+
+		return e.complexity.Team.Players(childComplexity), true
+	case "Team.sportType":
+		if e.complexity.Team.SportType == nil {
+			break
+		}
+
+		return e.complexity.Team.SportType(childComplexity), true
+	case "Team.state":
+		if e.complexity.Team.State == nil {
+			break
+		}
+
+		return e.complexity.Team.State(childComplexity), true
+
+	case "Trade.counterOf":
+		if e.complexity.Trade.CounterOf == nil {
+			break
+		}
+
+		return e.complexity.Trade.CounterOf(childComplexity), true
+	case "Trade.createdAt":
+		if e.complexity.Trade.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Trade.CreatedAt(childComplexity), true
+	case "Trade.failureReason":
+		if e.complexity.Trade.FailureReason == nil {
+			break
+		}
+
+		return e.complexity.Trade.FailureReason(childComplexity), true
+	case "Trade.id":
+		if e.complexity.Trade.ID == nil {
+			break
+		}
+
+		return e.complexity.Trade.ID(childComplexity), true
+	case "Trade.players":
+		if e.complexity.Trade.Players == nil {
+			break
+		}
+
+		return e.complexity.Trade.Players(childComplexity), true
+	case "Trade.proposingTeam":
+		if e.complexity.Trade.ProposingTeam == nil {
+			break
+		}
+
+		return e.complexity.Trade.ProposingTeam(childComplexity), true
+	case "Trade.receivingTeam":
+		if e.complexity.Trade.ReceivingTeam == nil {
+			break
+		}
+
+		return e.complexity.Trade.ReceivingTeam(childComplexity), true
+	case "Trade.resolvedAt":
+		if e.complexity.Trade.ResolvedAt == nil {
+			break
+		}
+
+		return e.complexity.Trade.ResolvedAt(childComplexity), true
+	case "Trade.reviewEndsAt":
+		if e.complexity.Trade.ReviewEndsAt == nil {
+			break
+		}
+
+		return e.complexity.Trade.ReviewEndsAt(childComplexity), true
+	case "Trade.status":
+		if e.complexity.Trade.Status == nil {
+			break
+		}
+
+		return e.complexity.Trade.Status(childComplexity), true
+	case "Trade.vetoVotes":
+		if e.complexity.Trade.VetoVotes == nil {
+			break
+		}
+
+		return e.complexity.Trade.VetoVotes(childComplexity), true
+	case "Trade.vetoVotesRequired":
+		if e.complexity.Trade.VetoVotesRequired == nil {
+			break
+		}
+
+		return e.complexity.Trade.VetoVotesRequired(childComplexity), true
+
+	case "TradePlayer.fromTeam":
+		if e.complexity.TradePlayer.FromTeam == nil {
+			break
+		}
+
+		return e.complexity.TradePlayer.FromTeam(childComplexity), true
+	case "TradePlayer.player":
+		if e.complexity.TradePlayer.Player == nil {
+			break
+		}
+
+		return e.complexity.TradePlayer.Player(childComplexity), true
+	case "TradePlayer.toTeam":
+		if e.complexity.TradePlayer.ToTeam == nil {
+			break
+		}
+
+		return e.complexity.TradePlayer.ToTeam(childComplexity), true
+
+	case "TradeSettings.reviewHours":
+		if e.complexity.TradeSettings.ReviewHours == nil {
+			break
+		}
+
+		return e.complexity.TradeSettings.ReviewHours(childComplexity), true
+	case "TradeSettings.vetoVotes":
+		if e.complexity.TradeSettings.VetoVotes == nil {
+			break
+		}
+
+		return e.complexity.TradeSettings.VetoVotes(childComplexity), true
+
+	case "Universe.id":
+		if e.complexity.Universe.ID == nil {
+			break
+		}
+
+		return e.complexity.Universe.ID(childComplexity), true
+	case "Universe.name":
+		if e.complexity.Universe.Name == nil {
+			break
+		}
+
+		return e.complexity.Universe.Name(childComplexity), true
+
+	case "WaiverClaim.addPlayer":
+		if e.complexity.WaiverClaim.AddPlayer == nil {
+			break
+		}
+
+		return e.complexity.WaiverClaim.AddPlayer(childComplexity), true
+	case "WaiverClaim.bid":
+		if e.complexity.WaiverClaim.Bid == nil {
+			break
+		}
+
+		return e.complexity.WaiverClaim.Bid(childComplexity), true
+	case "WaiverClaim.createdAt":
+		if e.complexity.WaiverClaim.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.WaiverClaim.CreatedAt(childComplexity), true
+	case "WaiverClaim.dropPlayer":
+		if e.complexity.WaiverClaim.DropPlayer == nil {
+			break
+		}
+
+		return e.complexity.WaiverClaim.DropPlayer(childComplexity), true
+	case "WaiverClaim.failureReason":
+		if e.complexity.WaiverClaim.FailureReason == nil {
+			break
+		}
+
+		return e.complexity.WaiverClaim.FailureReason(childComplexity), true
+	case "WaiverClaim.id":
+		if e.complexity.WaiverClaim.ID == nil {
+			break
+		}
+
+		return e.complexity.WaiverClaim.ID(childComplexity), true
+	case "WaiverClaim.processedAt":
+		if e.complexity.WaiverClaim.ProcessedAt == nil {
+			break
+		}
+
+		return e.complexity.WaiverClaim.ProcessedAt(childComplexity), true
+	case "WaiverClaim.status":
+		if e.complexity.WaiverClaim.Status == nil {
+			break
+		}
+
+		return e.complexity.WaiverClaim.Status(childComplexity), true
+	case "WaiverClaim.team":
+		if e.complexity.WaiverClaim.Team == nil {
+			break
+		}
+
+		return e.complexity.WaiverClaim.Team(childComplexity), true
+
+	case "WaiverSettings.faabBudget":
+		if e.complexity.WaiverSettings.FaabBudget == nil {
+			break
+		}
+
+		return e.complexity.WaiverSettings.FaabBudget(childComplexity), true
+	case "WaiverSettings.lastProcessedAt":
+		if e.complexity.WaiverSettings.LastProcessedAt == nil {
+			break
+		}
+
+		return e.complexity.WaiverSettings.LastProcessedAt(childComplexity), true
+	case "WaiverSettings.mode":
+		if e.complexity.WaiverSettings.Mode == nil {
+			break
+		}
+
+		return e.complexity.WaiverSettings.Mode(childComplexity), true
+	case "WaiverSettings.nextProcessAt":
+		if e.complexity.WaiverSettings.NextProcessAt == nil {
+			break
+		}
+
+		return e.complexity.WaiverSettings.NextProcessAt(childComplexity), true
+	case "WaiverSettings.processDay":
+		if e.complexity.WaiverSettings.ProcessDay == nil {
+			break
+		}
+
+		return e.complexity.WaiverSettings.ProcessDay(childComplexity), true
+	case "WaiverSettings.processHour":
+		if e.complexity.WaiverSettings.ProcessHour == nil {
+			break
+		}
+
+		return e.complexity.WaiverSettings.ProcessHour(childComplexity), true
+
+	case "YearlyStat.age":
+		if e.complexity.YearlyStat.Age == nil {
+			break
+		}
+
+		return e.complexity.YearlyStat.Age(childComplexity), true
+	case "YearlyStat.fantasyPoints":
+		if e.complexity.YearlyStat.FantasyPoints == nil {
+			break
+		}
+
+		return e.complexity.YearlyStat.FantasyPoints(childComplexity), true
+	case "YearlyStat.fantasyPointsPerGame":
+		if e.complexity.YearlyStat.FantasyPointsPerGame == nil {
+			break
+		}
+
+		return e.complexity.YearlyStat.FantasyPointsPerGame(childComplexity), true
+	case "YearlyStat.gamesPlayed":
+		if e.complexity.YearlyStat.GamesPlayed == nil {
+			break
+		}
+
+		return e.complexity.YearlyStat.GamesPlayed(childComplexity), true
+	case "YearlyStat.id":
+		if e.complexity.YearlyStat.ID == nil {
+			break
+		}
+
+		return e.complexity.YearlyStat.ID(childComplexity), true
+	case "YearlyStat.skill":
+		if e.complexity.YearlyStat.Skill == nil {
+			break
+		}
+
+		return e.complexity.YearlyStat.Skill(childComplexity), true
+	case "YearlyStat.sportType":
+		if e.complexity.YearlyStat.SportType == nil {
+			break
+		}
+
+		return e.complexity.YearlyStat.SportType(childComplexity), true
+	case "YearlyStat.stats":
+		if e.complexity.YearlyStat.Stats == nil {
+			break
+		}
+
+		return e.complexity.YearlyStat.Stats(childComplexity), true
+	case "YearlyStat.year":
+		if e.complexity.YearlyStat.Year == nil {
+			break
+		}
+
+		return e.complexity.YearlyStat.Year(childComplexity), true
+
+	}
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
+	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
+		ec.unmarshalInputLineupMoveInput,
+		ec.unmarshalInputPlayoffSettingsInput,
+		ec.unmarshalInputTradeSettingsInput,
+		ec.unmarshalInputWaiverSettingsInput,
+	)
+	first := true
+
+	switch opCtx.Operation.Operation {
+	case ast.Query:
+		return func(ctx context.Context) *graphql.Response {
+			var response graphql.Response
+			var data graphql.Marshaler
+			if first {
+				first = false
+				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
+			} else {
+				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
+					result := <-ec.deferredResults
+					atomic.AddInt32(&ec.pendingDeferred, -1)
+					data = result.Result
+					response.Path = result.Path
+					response.Label = result.Label
+					response.Errors = result.Errors
+				} else {
+					return nil
+				}
+			}
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+			response.Data = buf.Bytes()
+			if atomic.LoadInt32(&ec.deferred) > 0 {
+				hasNext := atomic.LoadInt32(&ec.pendingDeferred) > 0
+				response.HasNext = &hasNext
+			}
+
+			return &response
+		}
+	case ast.Mutation:
+		return func(ctx context.Context) *graphql.Response {
+			if !first {
+				return nil
+			}
+			first = false
+			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
+			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
+			var buf bytes.Buffer
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
+
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
+	}
+}
+
+type executionContext struct {
+	*graphql.OperationContext
+	*executableSchema
+	deferred        int32
+	pendingDeferred int32
+	deferredResults chan graphql.DeferredResult
+}
+
+func (ec *executionContext) processDeferredGroup(dg graphql.DeferredGroup) {
+	atomic.AddInt32(&ec.pendingDeferred, 1)
+	go func() {
+		ctx := graphql.WithFreshResponseContext(dg.Context)
+		dg.FieldSet.Dispatch(ctx)
+		ds := graphql.DeferredResult{
+			Path:   dg.Path,
+			Label:  dg.Label,
+			Result: dg.FieldSet,
+			Errors: graphql.GetErrors(ctx),
+		}
+		// null fields should bubble up
+		if dg.FieldSet.Invalids > 0 {
+			ds.Result = graphql.Null
+		}
+		ec.deferredResults <- ds
+	}()
+}
+
+func (ec *executionContext) introspectSchema() (*introspection.Schema, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapSchema(ec.Schema()), nil
+}
+
+func (ec *executionContext) introspectType(name string) (*introspection.Type, error) {
+	if ec.DisableIntrospection {
+		return nil, errors.New("introspection disabled")
+	}
+	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
+}
+
+//go:embed "schema.graphql"
+var sourcesFS embed.FS
+
+func sourceData(filename string) string {
+	data, err := sourcesFS.ReadFile(filename)
+	if err != nil {
+		panic(fmt.Sprintf("codegen problem: %s not available", filename))
+	}
+	return string(data)
+}
+
+var sources = []*ast.Source{
+	{Name: "schema.graphql", Input: sourceData("schema.graphql"), BuiltIn: false},
+}
+var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+func (ec *executionContext) field_Mutation_acceptTrade_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tradeId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["tradeId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_addFreeAgent_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "teamId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["teamId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "playerId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["playerId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "dropPlayerId", ec.unmarshalOID2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["dropPlayerId"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_approveTrade_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tradeId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["tradeId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_cancelTrade_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tradeId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["tradeId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_cancelWaiverClaim_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "claimId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["claimId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_counterTrade_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tradeId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["tradeId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "offeredPlayerIds", ec.unmarshalNID2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["offeredPlayerIds"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "requestedPlayerIds", ec.unmarshalNID2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["requestedPlayerIds"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_dropPlayer_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "teamId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["teamId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "playerId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["playerId"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_generateSchedule_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "draftRoomId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["draftRoomId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "regularSeasonWeeks", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["regularSeasonWeeks"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "playoffs", ec.unmarshalOPlayoffSettingsInput2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayoffSettingsInput)
+	if err != nil {
+		return nil, err
+	}
+	args["playoffs"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "waivers", ec.unmarshalOWaiverSettingsInput2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐWaiverSettingsInput)
+	if err != nil {
+		return nil, err
+	}
+	args["waivers"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "trades", ec.unmarshalOTradeSettingsInput2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTradeSettingsInput)
+	if err != nil {
+		return nil, err
+	}
+	args["trades"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_optimizeLineup_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "teamId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["teamId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "week", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["week"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_processWaivers_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "draftRoomId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["draftRoomId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_proposeTrade_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "proposingTeamId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["proposingTeamId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "receivingTeamId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["receivingTeamId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "offeredPlayerIds", ec.unmarshalNID2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["offeredPlayerIds"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "requestedPlayerIds", ec.unmarshalNID2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["requestedPlayerIds"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_rejectTrade_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tradeId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["tradeId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_setLineup_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "teamId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["teamId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "moves", ec.unmarshalNLineupMoveInput2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐLineupMoveInputᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["moves"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_simulateWeek_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "draftRoomId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["draftRoomId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_submitWaiverClaim_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "teamId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["teamId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "addPlayerId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["addPlayerId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "dropPlayerId", ec.unmarshalOID2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["dropPlayerId"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "bid", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["bid"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_vetoTrade_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tradeId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["tradeId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Mutation_voteToVetoTrade_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tradeId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["tradeId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "teamId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["teamId"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "name", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_conference_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_conferences_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "universeId", ec.unmarshalOID2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["universeId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_division_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_fantasySeason_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "draftRoomId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["draftRoomId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_freeAgents_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "draftRoomId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["draftRoomId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "position", ec.unmarshalOPosition2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPosition)
+	if err != nil {
+		return nil, err
+	}
+	args["position"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "offset", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["offset"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_matchups_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "draftRoomId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["draftRoomId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "week", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["week"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_player_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_players_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "position", ec.unmarshalOPosition2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPosition)
+	if err != nil {
+		return nil, err
+	}
+	args["position"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "teamId", ec.unmarshalOID2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["teamId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "universeId", ec.unmarshalOID2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["universeId"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "offset", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["offset"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_playoffBracket_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "draftRoomId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["draftRoomId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_rotoStandings_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "draftRoomId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["draftRoomId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "year", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["year"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_searchPlayers_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "query", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["query"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_standings_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "draftRoomId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["draftRoomId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_team_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_teams_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "universeId", ec.unmarshalOID2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["universeId"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_trade_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_trades_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "draftRoomId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["draftRoomId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "teamId", ec.unmarshalOID2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["teamId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "status", ec.unmarshalOTradeStatus2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTradeStatus)
+	if err != nil {
+		return nil, err
+	}
+	args["status"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_transactions_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "draftRoomId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["draftRoomId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "teamId", ec.unmarshalOID2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["teamId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_waiverClaims_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "draftRoomId", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["draftRoomId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "teamId", ec.unmarshalOID2ᚖstring)
+	if err != nil {
+		return nil, err
+	}
+	args["teamId"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "status", ec.unmarshalOWaiverClaimStatus2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐWaiverClaimStatus)
+	if err != nil {
+		return nil, err
+	}
+	args["status"] = arg2
+	return args, nil
+}
+
+func (ec *executionContext) field_Team_depthChart_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "position", ec.unmarshalOPosition2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPosition)
+	if err != nil {
+		return nil, err
+	}
+	args["position"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Field_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2ᚖbool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "includeDeprecated", ec.unmarshalOBoolean2bool)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _BaseballStats_atBats(ctx context.Context, field graphql.CollectedField, obj *model.BaseballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BaseballStats_atBats,
+		func(ctx context.Context) (any, error) {
+			return obj.AtBats, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BaseballStats_atBats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BaseballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BaseballStats_hits(ctx context.Context, field graphql.CollectedField, obj *model.BaseballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BaseballStats_hits,
+		func(ctx context.Context) (any, error) {
+			return obj.Hits, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BaseballStats_hits(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BaseballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BaseballStats_homeRuns(ctx context.Context, field graphql.CollectedField, obj *model.BaseballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BaseballStats_homeRuns,
+		func(ctx context.Context) (any, error) {
+			return obj.HomeRuns, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BaseballStats_homeRuns(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BaseballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BaseballStats_runsBattedIn(ctx context.Context, field graphql.CollectedField, obj *model.BaseballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BaseballStats_runsBattedIn,
+		func(ctx context.Context) (any, error) {
+			return obj.RunsBattedIn, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BaseballStats_runsBattedIn(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BaseballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BaseballStats_stolenBases(ctx context.Context, field graphql.CollectedField, obj *model.BaseballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BaseballStats_stolenBases,
+		func(ctx context.Context) (any, error) {
+			return obj.StolenBases, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BaseballStats_stolenBases(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BaseballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BaseballStats_battingAverage(ctx context.Context, field graphql.CollectedField, obj *model.BaseballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BaseballStats_battingAverage,
+		func(ctx context.Context) (any, error) {
+			return obj.BattingAverage, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BaseballStats_battingAverage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BaseballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BaseballStats_outsPitched(ctx context.Context, field graphql.CollectedField, obj *model.BaseballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BaseballStats_outsPitched,
+		func(ctx context.Context) (any, error) {
+			return obj.OutsPitched, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BaseballStats_outsPitched(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BaseballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BaseballStats_inningsPitched(ctx context.Context, field graphql.CollectedField, obj *model.BaseballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BaseballStats_inningsPitched,
+		func(ctx context.Context) (any, error) {
+			return obj.InningsPitched, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BaseballStats_inningsPitched(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BaseballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BaseballStats_strikeouts(ctx context.Context, field graphql.CollectedField, obj *model.BaseballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BaseballStats_strikeouts,
+		func(ctx context.Context) (any, error) {
+			return obj.Strikeouts, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BaseballStats_strikeouts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BaseballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BaseballStats_earnedRuns(ctx context.Context, field graphql.CollectedField, obj *model.BaseballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BaseballStats_earnedRuns,
+		func(ctx context.Context) (any, error) {
+			return obj.EarnedRuns, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BaseballStats_earnedRuns(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BaseballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BaseballStats_hitsAllowed(ctx context.Context, field graphql.CollectedField, obj *model.BaseballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BaseballStats_hitsAllowed,
+		func(ctx context.Context) (any, error) {
+			return obj.HitsAllowed, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BaseballStats_hitsAllowed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BaseballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BaseballStats_walksAllowed(ctx context.Context, field graphql.CollectedField, obj *model.BaseballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BaseballStats_walksAllowed,
+		func(ctx context.Context) (any, error) {
+			return obj.WalksAllowed, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BaseballStats_walksAllowed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BaseballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BaseballStats_saves(ctx context.Context, field graphql.CollectedField, obj *model.BaseballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BaseballStats_saves,
+		func(ctx context.Context) (any, error) {
+			return obj.Saves, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BaseballStats_saves(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BaseballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BaseballStats_era(ctx context.Context, field graphql.CollectedField, obj *model.BaseballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BaseballStats_era,
+		func(ctx context.Context) (any, error) {
+			return obj.Era, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BaseballStats_era(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BaseballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BaseballStats_whip(ctx context.Context, field graphql.CollectedField, obj *model.BaseballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BaseballStats_whip,
+		func(ctx context.Context) (any, error) {
+			return obj.Whip, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BaseballStats_whip(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BaseballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BasketballStats_points(ctx context.Context, field graphql.CollectedField, obj *model.BasketballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BasketballStats_points,
+		func(ctx context.Context) (any, error) {
+			return obj.Points, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BasketballStats_points(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BasketballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BasketballStats_rebounds(ctx context.Context, field graphql.CollectedField, obj *model.BasketballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BasketballStats_rebounds,
+		func(ctx context.Context) (any, error) {
+			return obj.Rebounds, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BasketballStats_rebounds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BasketballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BasketballStats_assists(ctx context.Context, field graphql.CollectedField, obj *model.BasketballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BasketballStats_assists,
+		func(ctx context.Context) (any, error) {
+			return obj.Assists, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BasketballStats_assists(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BasketballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BasketballStats_steals(ctx context.Context, field graphql.CollectedField, obj *model.BasketballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BasketballStats_steals,
+		func(ctx context.Context) (any, error) {
+			return obj.Steals, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BasketballStats_steals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BasketballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BasketballStats_blocks(ctx context.Context, field graphql.CollectedField, obj *model.BasketballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BasketballStats_blocks,
+		func(ctx context.Context) (any, error) {
+			return obj.Blocks, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BasketballStats_blocks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BasketballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BasketballStats_threePointersMade(ctx context.Context, field graphql.CollectedField, obj *model.BasketballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BasketballStats_threePointersMade,
+		func(ctx context.Context) (any, error) {
+			return obj.ThreePointersMade, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BasketballStats_threePointersMade(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BasketballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BasketballStats_turnovers(ctx context.Context, field graphql.CollectedField, obj *model.BasketballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BasketballStats_turnovers,
+		func(ctx context.Context) (any, error) {
+			return obj.Turnovers, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BasketballStats_turnovers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BasketballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BasketballStats_fieldGoalsMade(ctx context.Context, field graphql.CollectedField, obj *model.BasketballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BasketballStats_fieldGoalsMade,
+		func(ctx context.Context) (any, error) {
+			return obj.FieldGoalsMade, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BasketballStats_fieldGoalsMade(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BasketballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BasketballStats_fieldGoalsAttempted(ctx context.Context, field graphql.CollectedField, obj *model.BasketballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BasketballStats_fieldGoalsAttempted,
+		func(ctx context.Context) (any, error) {
+			return obj.FieldGoalsAttempted, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BasketballStats_fieldGoalsAttempted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BasketballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BasketballStats_freeThrowsMade(ctx context.Context, field graphql.CollectedField, obj *model.BasketballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BasketballStats_freeThrowsMade,
+		func(ctx context.Context) (any, error) {
+			return obj.FreeThrowsMade, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BasketballStats_freeThrowsMade(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BasketballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _BasketballStats_freeThrowsAttempted(ctx context.Context, field graphql.CollectedField, obj *model.BasketballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_BasketballStats_freeThrowsAttempted,
+		func(ctx context.Context) (any, error) {
+			return obj.FreeThrowsAttempted, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_BasketballStats_freeThrowsAttempted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "BasketballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Conference_id(ctx context.Context, field graphql.CollectedField, obj *model.Conference) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Conference_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Conference_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Conference",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Conference_name(ctx context.Context, field graphql.CollectedField, obj *model.Conference) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Conference_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Conference_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Conference",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Conference_divisions(ctx context.Context, field graphql.CollectedField, obj *model.Conference) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Conference_divisions,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Conference().Divisions(ctx, obj)
+		},
+		nil,
+		ec.marshalNDivision2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐDivisionᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Conference_divisions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Conference",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Division_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Division_name(ctx, field)
+			case "conference":
+				return ec.fieldContext_Division_conference(ctx, field)
+			case "teams":
+				return ec.fieldContext_Division_teams(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Division", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DepthChartEntry_position(ctx context.Context, field graphql.CollectedField, obj *model.DepthChartEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DepthChartEntry_position,
+		func(ctx context.Context) (any, error) {
+			return obj.Position, nil
+		},
+		nil,
+		ec.marshalNPosition2fantasyᚑdraftᚋgraphᚋmodelᚐPosition,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DepthChartEntry_position(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DepthChartEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Position does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DepthChartEntry_rank(ctx context.Context, field graphql.CollectedField, obj *model.DepthChartEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DepthChartEntry_rank,
+		func(ctx context.Context) (any, error) {
+			return obj.Rank, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DepthChartEntry_rank(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DepthChartEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DepthChartEntry_player(ctx context.Context, field graphql.CollectedField, obj *model.DepthChartEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DepthChartEntry_player,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.DepthChartEntry().Player(ctx, obj)
+		},
+		nil,
+		ec.marshalNPlayer2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayer,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DepthChartEntry_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DepthChartEntry",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Player_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Player_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Player_lastName(ctx, field)
+			case "fullName":
+				return ec.fieldContext_Player_fullName(ctx, field)
+			case "position":
+				return ec.fieldContext_Player_position(ctx, field)
+			case "team":
+				return ec.fieldContext_Player_team(ctx, field)
+			case "height":
+				return ec.fieldContext_Player_height(ctx, field)
+			case "weight":
+				return ec.fieldContext_Player_weight(ctx, field)
+			case "age":
+				return ec.fieldContext_Player_age(ctx, field)
+			case "yearsOfExperience":
+				return ec.fieldContext_Player_yearsOfExperience(ctx, field)
+			case "draftYear":
+				return ec.fieldContext_Player_draftYear(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "status":
+				return ec.fieldContext_Player_status(ctx, field)
+			case "skill":
+				return ec.fieldContext_Player_skill(ctx, field)
+			case "scoutedSkill":
+				return ec.fieldContext_Player_scoutedSkill(ctx, field)
+			case "draftRound":
+				return ec.fieldContext_Player_draftRound(ctx, field)
+			case "draftPick":
+				return ec.fieldContext_Player_draftPick(ctx, field)
+			case "depthChartRank":
+				return ec.fieldContext_Player_depthChartRank(ctx, field)
+			case "yearlyStats":
+				return ec.fieldContext_Player_yearlyStats(ctx, field)
+			case "injuries":
+				return ec.fieldContext_Player_injuries(ctx, field)
+			case "injuryRisk":
+				return ec.fieldContext_Player_injuryRisk(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Division_id(ctx context.Context, field graphql.CollectedField, obj *model.Division) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Division_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Division_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Division",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Division_name(ctx context.Context, field graphql.CollectedField, obj *model.Division) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Division_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Division_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Division",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Division_conference(ctx context.Context, field graphql.CollectedField, obj *model.Division) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Division_conference,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Division().Conference(ctx, obj)
+		},
+		nil,
+		ec.marshalNConference2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐConference,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Division_conference(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Division",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Conference_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Conference_name(ctx, field)
+			case "divisions":
+				return ec.fieldContext_Conference_divisions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Conference", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Division_teams(ctx context.Context, field graphql.CollectedField, obj *model.Division) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Division_teams,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Division().Teams(ctx, obj)
+		},
+		nil,
+		ec.marshalNTeam2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐTeamᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Division_teams(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Division",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Team_id(ctx, field)
+			case "city":
+				return ec.fieldContext_Team_city(ctx, field)
+			case "state":
+				return ec.fieldContext_Team_state(ctx, field)
+			case "name":
+				return ec.fieldContext_Team_name(ctx, field)
+			case "abbreviation":
+				return ec.fieldContext_Team_abbreviation(ctx, field)
+			case "sportType":
+				return ec.fieldContext_Team_sportType(ctx, field)
+			case "division":
+				return ec.fieldContext_Team_division(ctx, field)
+			case "players":
+				return ec.fieldContext_Team_players(ctx, field)
+			case "depthChart":
+				return ec.fieldContext_Team_depthChart(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Team", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasySeason_id(ctx context.Context, field graphql.CollectedField, obj *model.FantasySeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasySeason_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasySeason_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasySeason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasySeason_draftRoomId(ctx context.Context, field graphql.CollectedField, obj *model.FantasySeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasySeason_draftRoomId,
+		func(ctx context.Context) (any, error) {
+			return obj.DraftRoomID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasySeason_draftRoomId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasySeason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasySeason_year(ctx context.Context, field graphql.CollectedField, obj *model.FantasySeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasySeason_year,
+		func(ctx context.Context) (any, error) {
+			return obj.Year, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasySeason_year(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasySeason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasySeason_regularSeasonWeeks(ctx context.Context, field graphql.CollectedField, obj *model.FantasySeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasySeason_regularSeasonWeeks,
+		func(ctx context.Context) (any, error) {
+			return obj.RegularSeasonWeeks, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasySeason_regularSeasonWeeks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasySeason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasySeason_currentWeek(ctx context.Context, field graphql.CollectedField, obj *model.FantasySeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasySeason_currentWeek,
+		func(ctx context.Context) (any, error) {
+			return obj.CurrentWeek, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasySeason_currentWeek(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasySeason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasySeason_playoffSettings(ctx context.Context, field graphql.CollectedField, obj *model.FantasySeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasySeason_playoffSettings,
+		func(ctx context.Context) (any, error) {
+			return obj.PlayoffSettings, nil
+		},
+		nil,
+		ec.marshalNPlayoffSettings2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayoffSettings,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasySeason_playoffSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasySeason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "teams":
+				return ec.fieldContext_PlayoffSettings_teams(ctx, field)
+			case "byes":
+				return ec.fieldContext_PlayoffSettings_byes(ctx, field)
+			case "weeksPerRound":
+				return ec.fieldContext_PlayoffSettings_weeksPerRound(ctx, field)
+			case "tiebreakers":
+				return ec.fieldContext_PlayoffSettings_tiebreakers(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PlayoffSettings", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasySeason_waiverSettings(ctx context.Context, field graphql.CollectedField, obj *model.FantasySeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasySeason_waiverSettings,
+		func(ctx context.Context) (any, error) {
+			return obj.WaiverSettings, nil
+		},
+		nil,
+		ec.marshalNWaiverSettings2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐWaiverSettings,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasySeason_waiverSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasySeason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "mode":
+				return ec.fieldContext_WaiverSettings_mode(ctx, field)
+			case "faabBudget":
+				return ec.fieldContext_WaiverSettings_faabBudget(ctx, field)
+			case "processDay":
+				return ec.fieldContext_WaiverSettings_processDay(ctx, field)
+			case "processHour":
+				return ec.fieldContext_WaiverSettings_processHour(ctx, field)
+			case "lastProcessedAt":
+				return ec.fieldContext_WaiverSettings_lastProcessedAt(ctx, field)
+			case "nextProcessAt":
+				return ec.fieldContext_WaiverSettings_nextProcessAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WaiverSettings", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasySeason_tradeSettings(ctx context.Context, field graphql.CollectedField, obj *model.FantasySeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasySeason_tradeSettings,
+		func(ctx context.Context) (any, error) {
+			return obj.TradeSettings, nil
+		},
+		nil,
+		ec.marshalNTradeSettings2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTradeSettings,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasySeason_tradeSettings(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasySeason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "reviewHours":
+				return ec.fieldContext_TradeSettings_reviewHours(ctx, field)
+			case "vetoVotes":
+				return ec.fieldContext_TradeSettings_vetoVotes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TradeSettings", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasySeason_champion(ctx context.Context, field graphql.CollectedField, obj *model.FantasySeason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasySeason_champion,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.FantasySeason().Champion(ctx, obj)
+		},
+		nil,
+		ec.marshalOFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasySeason_champion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasySeason",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasyTeam_id(ctx context.Context, field graphql.CollectedField, obj *model.FantasyTeam) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasyTeam_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasyTeam_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasyTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasyTeam_name(ctx context.Context, field graphql.CollectedField, obj *model.FantasyTeam) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasyTeam_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasyTeam_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasyTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasyTeam_draftOrderNumber(ctx context.Context, field graphql.CollectedField, obj *model.FantasyTeam) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasyTeam_draftOrderNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.DraftOrderNumber, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasyTeam_draftOrderNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasyTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasyTeam_isBot(ctx context.Context, field graphql.CollectedField, obj *model.FantasyTeam) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasyTeam_isBot,
+		func(ctx context.Context) (any, error) {
+			return obj.IsBot, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasyTeam_isBot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasyTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasyTeam_waiverPriority(ctx context.Context, field graphql.CollectedField, obj *model.FantasyTeam) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasyTeam_waiverPriority,
+		func(ctx context.Context) (any, error) {
+			return obj.WaiverPriority, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasyTeam_waiverPriority(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasyTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasyTeam_faabRemaining(ctx context.Context, field graphql.CollectedField, obj *model.FantasyTeam) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasyTeam_faabRemaining,
+		func(ctx context.Context) (any, error) {
+			return obj.FaabRemaining, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasyTeam_faabRemaining(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasyTeam",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasyTeam_roster(ctx context.Context, field graphql.CollectedField, obj *model.FantasyTeam) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasyTeam_roster,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.FantasyTeam().Roster(ctx, obj)
+		},
+		nil,
+		ec.marshalNRosterEntry2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐRosterEntryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasyTeam_roster(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasyTeam",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "player":
+				return ec.fieldContext_RosterEntry_player(ctx, field)
+			case "rosterSpot":
+				return ec.fieldContext_RosterEntry_rosterSpot(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RosterEntry", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasyTransaction_id(ctx context.Context, field graphql.CollectedField, obj *model.FantasyTransaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasyTransaction_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasyTransaction_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasyTransaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasyTransaction_type(ctx context.Context, field graphql.CollectedField, obj *model.FantasyTransaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasyTransaction_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalNTransactionType2fantasyᚑdraftᚋgraphᚋmodelᚐTransactionType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasyTransaction_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasyTransaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type TransactionType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasyTransaction_team(ctx context.Context, field graphql.CollectedField, obj *model.FantasyTransaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasyTransaction_team,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.FantasyTransaction().Team(ctx, obj)
+		},
+		nil,
+		ec.marshalNFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasyTransaction_team(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasyTransaction",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasyTransaction_player(ctx context.Context, field graphql.CollectedField, obj *model.FantasyTransaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasyTransaction_player,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.FantasyTransaction().Player(ctx, obj)
+		},
+		nil,
+		ec.marshalNPlayer2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayer,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasyTransaction_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasyTransaction",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Player_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Player_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Player_lastName(ctx, field)
+			case "fullName":
+				return ec.fieldContext_Player_fullName(ctx, field)
+			case "position":
+				return ec.fieldContext_Player_position(ctx, field)
+			case "team":
+				return ec.fieldContext_Player_team(ctx, field)
+			case "height":
+				return ec.fieldContext_Player_height(ctx, field)
+			case "weight":
+				return ec.fieldContext_Player_weight(ctx, field)
+			case "age":
+				return ec.fieldContext_Player_age(ctx, field)
+			case "yearsOfExperience":
+				return ec.fieldContext_Player_yearsOfExperience(ctx, field)
+			case "draftYear":
+				return ec.fieldContext_Player_draftYear(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "status":
+				return ec.fieldContext_Player_status(ctx, field)
+			case "skill":
+				return ec.fieldContext_Player_skill(ctx, field)
+			case "scoutedSkill":
+				return ec.fieldContext_Player_scoutedSkill(ctx, field)
+			case "draftRound":
+				return ec.fieldContext_Player_draftRound(ctx, field)
+			case "draftPick":
+				return ec.fieldContext_Player_draftPick(ctx, field)
+			case "depthChartRank":
+				return ec.fieldContext_Player_depthChartRank(ctx, field)
+			case "yearlyStats":
+				return ec.fieldContext_Player_yearlyStats(ctx, field)
+			case "injuries":
+				return ec.fieldContext_Player_injuries(ctx, field)
+			case "injuryRisk":
+				return ec.fieldContext_Player_injuryRisk(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasyTransaction_faabAmount(ctx context.Context, field graphql.CollectedField, obj *model.FantasyTransaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasyTransaction_faabAmount,
+		func(ctx context.Context) (any, error) {
+			return obj.FaabAmount, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasyTransaction_faabAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasyTransaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasyTransaction_trade(ctx context.Context, field graphql.CollectedField, obj *model.FantasyTransaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasyTransaction_trade,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.FantasyTransaction().Trade(ctx, obj)
+		},
+		nil,
+		ec.marshalOTrade2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTrade,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasyTransaction_trade(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasyTransaction",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Trade_id(ctx, field)
+			case "proposingTeam":
+				return ec.fieldContext_Trade_proposingTeam(ctx, field)
+			case "receivingTeam":
+				return ec.fieldContext_Trade_receivingTeam(ctx, field)
+			case "status":
+				return ec.fieldContext_Trade_status(ctx, field)
+			case "players":
+				return ec.fieldContext_Trade_players(ctx, field)
+			case "counterOf":
+				return ec.fieldContext_Trade_counterOf(ctx, field)
+			case "vetoVotes":
+				return ec.fieldContext_Trade_vetoVotes(ctx, field)
+			case "vetoVotesRequired":
+				return ec.fieldContext_Trade_vetoVotesRequired(ctx, field)
+			case "reviewEndsAt":
+				return ec.fieldContext_Trade_reviewEndsAt(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_Trade_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Trade_createdAt(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Trade_resolvedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Trade", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FantasyTransaction_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.FantasyTransaction) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FantasyTransaction_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FantasyTransaction_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FantasyTransaction",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_passingAttempts(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_passingAttempts,
+		func(ctx context.Context) (any, error) {
+			return obj.PassingAttempts, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_passingAttempts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_passingCompletions(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_passingCompletions,
+		func(ctx context.Context) (any, error) {
+			return obj.PassingCompletions, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_passingCompletions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_passingYards(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_passingYards,
+		func(ctx context.Context) (any, error) {
+			return obj.PassingYards, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_passingYards(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_passingTDs(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_passingTDs,
+		func(ctx context.Context) (any, error) {
+			return obj.PassingTDs, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_passingTDs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_passingInterceptions(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_passingInterceptions,
+		func(ctx context.Context) (any, error) {
+			return obj.PassingInterceptions, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_passingInterceptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_rushingAttempts(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_rushingAttempts,
+		func(ctx context.Context) (any, error) {
+			return obj.RushingAttempts, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_rushingAttempts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_rushingYards(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_rushingYards,
+		func(ctx context.Context) (any, error) {
+			return obj.RushingYards, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_rushingYards(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_rushingTDs(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_rushingTDs,
+		func(ctx context.Context) (any, error) {
+			return obj.RushingTDs, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_rushingTDs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_receivingTargets(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_receivingTargets,
+		func(ctx context.Context) (any, error) {
+			return obj.ReceivingTargets, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_receivingTargets(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_receivingReceptions(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_receivingReceptions,
+		func(ctx context.Context) (any, error) {
+			return obj.ReceivingReceptions, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_receivingReceptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_receivingYards(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_receivingYards,
+		func(ctx context.Context) (any, error) {
+			return obj.ReceivingYards, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_receivingYards(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_receivingTDs(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_receivingTDs,
+		func(ctx context.Context) (any, error) {
+			return obj.ReceivingTDs, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_receivingTDs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_fumbles(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_fumbles,
+		func(ctx context.Context) (any, error) {
+			return obj.Fumbles, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_fumbles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_fumblesLost(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_fumblesLost,
+		func(ctx context.Context) (any, error) {
+			return obj.FumblesLost, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_fumblesLost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_fieldGoals(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_fieldGoals,
+		func(ctx context.Context) (any, error) {
+			return obj.FieldGoals, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_fieldGoals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_fieldGoalsMade(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_fieldGoalsMade,
+		func(ctx context.Context) (any, error) {
+			return obj.FieldGoalsMade, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_fieldGoalsMade(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_fieldGoalsMissed(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_fieldGoalsMissed,
+		func(ctx context.Context) (any, error) {
+			return obj.FieldGoalsMissed, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_fieldGoalsMissed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_fieldGoalsUnder40(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_fieldGoalsUnder40,
+		func(ctx context.Context) (any, error) {
+			return obj.FieldGoalsUnder40, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_fieldGoalsUnder40(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_fieldGoalsMadeUnder40(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_fieldGoalsMadeUnder40,
+		func(ctx context.Context) (any, error) {
+			return obj.FieldGoalsMadeUnder40, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_fieldGoalsMadeUnder40(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_fieldGoals40To49(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_fieldGoals40To49,
+		func(ctx context.Context) (any, error) {
+			return obj.FieldGoals40To49, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_fieldGoals40To49(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_fieldGoalsMade40To49(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_fieldGoalsMade40To49,
+		func(ctx context.Context) (any, error) {
+			return obj.FieldGoalsMade40To49, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_fieldGoalsMade40To49(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_fieldGoals50Plus(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_fieldGoals50Plus,
+		func(ctx context.Context) (any, error) {
+			return obj.FieldGoals50Plus, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_fieldGoals50Plus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_fieldGoalsMade50Plus(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_fieldGoalsMade50Plus,
+		func(ctx context.Context) (any, error) {
+			return obj.FieldGoalsMade50Plus, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_fieldGoalsMade50Plus(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_extraPoints(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_extraPoints,
+		func(ctx context.Context) (any, error) {
+			return obj.ExtraPoints, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_extraPoints(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_extraPointsMade(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_extraPointsMade,
+		func(ctx context.Context) (any, error) {
+			return obj.ExtraPointsMade, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_extraPointsMade(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_extraPointsMissed(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_extraPointsMissed,
+		func(ctx context.Context) (any, error) {
+			return obj.ExtraPointsMissed, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_extraPointsMissed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_tackles(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_tackles,
+		func(ctx context.Context) (any, error) {
+			return obj.Tackles, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_tackles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_assistedTackles(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_assistedTackles,
+		func(ctx context.Context) (any, error) {
+			return obj.AssistedTackles, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_assistedTackles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_sacks(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_sacks,
+		func(ctx context.Context) (any, error) {
+			return obj.Sacks, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_sacks(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_interceptions(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_interceptions,
+		func(ctx context.Context) (any, error) {
+			return obj.Interceptions, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_interceptions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_passesDefended(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_passesDefended,
+		func(ctx context.Context) (any, error) {
+			return obj.PassesDefended, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_passesDefended(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_forcedFumbles(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_forcedFumbles,
+		func(ctx context.Context) (any, error) {
+			return obj.ForcedFumbles, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_forcedFumbles(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_fumbleRecoveries(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_fumbleRecoveries,
+		func(ctx context.Context) (any, error) {
+			return obj.FumbleRecoveries, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_fumbleRecoveries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_defensiveTDs(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_defensiveTDs,
+		func(ctx context.Context) (any, error) {
+			return obj.DefensiveTDs, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_defensiveTDs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_pointsAllowed(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_pointsAllowed,
+		func(ctx context.Context) (any, error) {
+			return obj.PointsAllowed, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_pointsAllowed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FootballStats_yardsAllowed(ctx context.Context, field graphql.CollectedField, obj *model.FootballStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FootballStats_yardsAllowed,
+		func(ctx context.Context) (any, error) {
+			return obj.YardsAllowed, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FootballStats_yardsAllowed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FootballStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Injury_id(ctx context.Context, field graphql.CollectedField, obj *model.Injury) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Injury_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Injury_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Injury",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Injury_year(ctx context.Context, field graphql.CollectedField, obj *model.Injury) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Injury_year,
+		func(ctx context.Context) (any, error) {
+			return obj.Year, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Injury_year(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Injury",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Injury_week(ctx context.Context, field graphql.CollectedField, obj *model.Injury) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Injury_week,
+		func(ctx context.Context) (any, error) {
+			return obj.Week, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Injury_week(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Injury",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Injury_gamesMissed(ctx context.Context, field graphql.CollectedField, obj *model.Injury) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Injury_gamesMissed,
+		func(ctx context.Context) (any, error) {
+			return obj.GamesMissed, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Injury_gamesMissed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Injury",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Injury_injuryType(ctx context.Context, field graphql.CollectedField, obj *model.Injury) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Injury_injuryType,
+		func(ctx context.Context) (any, error) {
+			return obj.InjuryType, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Injury_injuryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Injury",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Injury_severity(ctx context.Context, field graphql.CollectedField, obj *model.Injury) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Injury_severity,
+		func(ctx context.Context) (any, error) {
+			return obj.Severity, nil
+		},
+		nil,
+		ec.marshalNInjurySeverity2fantasyᚑdraftᚋgraphᚋmodelᚐInjurySeverity,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Injury_severity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Injury",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type InjurySeverity does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Matchup_id(ctx context.Context, field graphql.CollectedField, obj *model.Matchup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Matchup_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Matchup_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Matchup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Matchup_week(ctx context.Context, field graphql.CollectedField, obj *model.Matchup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Matchup_week,
+		func(ctx context.Context) (any, error) {
+			return obj.Week, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Matchup_week(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Matchup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Matchup_homeTeam(ctx context.Context, field graphql.CollectedField, obj *model.Matchup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Matchup_homeTeam,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Matchup().HomeTeam(ctx, obj)
+		},
+		nil,
+		ec.marshalNFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Matchup_homeTeam(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Matchup",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Matchup_awayTeam(ctx context.Context, field graphql.CollectedField, obj *model.Matchup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Matchup_awayTeam,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Matchup().AwayTeam(ctx, obj)
+		},
+		nil,
+		ec.marshalNFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Matchup_awayTeam(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Matchup",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Matchup_homeScore(ctx context.Context, field graphql.CollectedField, obj *model.Matchup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Matchup_homeScore,
+		func(ctx context.Context) (any, error) {
+			return obj.HomeScore, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Matchup_homeScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Matchup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Matchup_awayScore(ctx context.Context, field graphql.CollectedField, obj *model.Matchup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Matchup_awayScore,
+		func(ctx context.Context) (any, error) {
+			return obj.AwayScore, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Matchup_awayScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Matchup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Matchup_isComplete(ctx context.Context, field graphql.CollectedField, obj *model.Matchup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Matchup_isComplete,
+		func(ctx context.Context) (any, error) {
+			return obj.IsComplete, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Matchup_isComplete(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Matchup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Matchup_winner(ctx context.Context, field graphql.CollectedField, obj *model.Matchup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Matchup_winner,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Matchup().Winner(ctx, obj)
+		},
+		nil,
+		ec.marshalOFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Matchup_winner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Matchup",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_generateSchedule(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_generateSchedule,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().GenerateSchedule(ctx, fc.Args["draftRoomId"].(string), fc.Args["regularSeasonWeeks"].(*int), fc.Args["playoffs"].(*model.PlayoffSettingsInput), fc.Args["waivers"].(*model.WaiverSettingsInput), fc.Args["trades"].(*model.TradeSettingsInput))
+		},
+		nil,
+		ec.marshalNFantasySeason2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasySeason,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_generateSchedule(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasySeason_id(ctx, field)
+			case "draftRoomId":
+				return ec.fieldContext_FantasySeason_draftRoomId(ctx, field)
+			case "year":
+				return ec.fieldContext_FantasySeason_year(ctx, field)
+			case "regularSeasonWeeks":
+				return ec.fieldContext_FantasySeason_regularSeasonWeeks(ctx, field)
+			case "currentWeek":
+				return ec.fieldContext_FantasySeason_currentWeek(ctx, field)
+			case "playoffSettings":
+				return ec.fieldContext_FantasySeason_playoffSettings(ctx, field)
+			case "waiverSettings":
+				return ec.fieldContext_FantasySeason_waiverSettings(ctx, field)
+			case "tradeSettings":
+				return ec.fieldContext_FantasySeason_tradeSettings(ctx, field)
+			case "champion":
+				return ec.fieldContext_FantasySeason_champion(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasySeason", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_generateSchedule_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_simulateWeek(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_simulateWeek,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SimulateWeek(ctx, fc.Args["draftRoomId"].(string))
+		},
+		nil,
+		ec.marshalNMatchup2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐMatchupᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_simulateWeek(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Matchup_id(ctx, field)
+			case "week":
+				return ec.fieldContext_Matchup_week(ctx, field)
+			case "homeTeam":
+				return ec.fieldContext_Matchup_homeTeam(ctx, field)
+			case "awayTeam":
+				return ec.fieldContext_Matchup_awayTeam(ctx, field)
+			case "homeScore":
+				return ec.fieldContext_Matchup_homeScore(ctx, field)
+			case "awayScore":
+				return ec.fieldContext_Matchup_awayScore(ctx, field)
+			case "isComplete":
+				return ec.fieldContext_Matchup_isComplete(ctx, field)
+			case "winner":
+				return ec.fieldContext_Matchup_winner(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Matchup", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_simulateWeek_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_setLineup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_setLineup,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SetLineup(ctx, fc.Args["teamId"].(string), fc.Args["moves"].([]*model.LineupMoveInput))
+		},
+		nil,
+		ec.marshalNRosterEntry2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐRosterEntryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_setLineup(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "player":
+				return ec.fieldContext_RosterEntry_player(ctx, field)
+			case "rosterSpot":
+				return ec.fieldContext_RosterEntry_rosterSpot(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RosterEntry", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setLineup_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_optimizeLineup(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_optimizeLineup,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().OptimizeLineup(ctx, fc.Args["teamId"].(string), fc.Args["week"].(int))
+		},
+		nil,
+		ec.marshalNRosterEntry2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐRosterEntryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_optimizeLineup(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "player":
+				return ec.fieldContext_RosterEntry_player(ctx, field)
+			case "rosterSpot":
+				return ec.fieldContext_RosterEntry_rosterSpot(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RosterEntry", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_optimizeLineup_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_submitWaiverClaim(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_submitWaiverClaim,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().SubmitWaiverClaim(ctx, fc.Args["teamId"].(string), fc.Args["addPlayerId"].(string), fc.Args["dropPlayerId"].(*string), fc.Args["bid"].(*int))
+		},
+		nil,
+		ec.marshalNWaiverClaim2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐWaiverClaim,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_submitWaiverClaim(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_WaiverClaim_id(ctx, field)
+			case "team":
+				return ec.fieldContext_WaiverClaim_team(ctx, field)
+			case "addPlayer":
+				return ec.fieldContext_WaiverClaim_addPlayer(ctx, field)
+			case "dropPlayer":
+				return ec.fieldContext_WaiverClaim_dropPlayer(ctx, field)
+			case "bid":
+				return ec.fieldContext_WaiverClaim_bid(ctx, field)
+			case "status":
+				return ec.fieldContext_WaiverClaim_status(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_WaiverClaim_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_WaiverClaim_createdAt(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_WaiverClaim_processedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WaiverClaim", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_submitWaiverClaim_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_cancelWaiverClaim(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_cancelWaiverClaim,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CancelWaiverClaim(ctx, fc.Args["claimId"].(string))
+		},
+		nil,
+		ec.marshalNWaiverClaim2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐWaiverClaim,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_cancelWaiverClaim(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_WaiverClaim_id(ctx, field)
+			case "team":
+				return ec.fieldContext_WaiverClaim_team(ctx, field)
+			case "addPlayer":
+				return ec.fieldContext_WaiverClaim_addPlayer(ctx, field)
+			case "dropPlayer":
+				return ec.fieldContext_WaiverClaim_dropPlayer(ctx, field)
+			case "bid":
+				return ec.fieldContext_WaiverClaim_bid(ctx, field)
+			case "status":
+				return ec.fieldContext_WaiverClaim_status(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_WaiverClaim_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_WaiverClaim_createdAt(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_WaiverClaim_processedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WaiverClaim", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_cancelWaiverClaim_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_addFreeAgent(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_addFreeAgent,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AddFreeAgent(ctx, fc.Args["teamId"].(string), fc.Args["playerId"].(string), fc.Args["dropPlayerId"].(*string))
+		},
+		nil,
+		ec.marshalNFantasyTransaction2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTransactionᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_addFreeAgent(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTransaction_id(ctx, field)
+			case "type":
+				return ec.fieldContext_FantasyTransaction_type(ctx, field)
+			case "team":
+				return ec.fieldContext_FantasyTransaction_team(ctx, field)
+			case "player":
+				return ec.fieldContext_FantasyTransaction_player(ctx, field)
+			case "faabAmount":
+				return ec.fieldContext_FantasyTransaction_faabAmount(ctx, field)
+			case "trade":
+				return ec.fieldContext_FantasyTransaction_trade(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_FantasyTransaction_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTransaction", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addFreeAgent_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_dropPlayer(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_dropPlayer,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().DropPlayer(ctx, fc.Args["teamId"].(string), fc.Args["playerId"].(string))
+		},
+		nil,
+		ec.marshalNFantasyTransaction2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTransaction,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_dropPlayer(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTransaction_id(ctx, field)
+			case "type":
+				return ec.fieldContext_FantasyTransaction_type(ctx, field)
+			case "team":
+				return ec.fieldContext_FantasyTransaction_team(ctx, field)
+			case "player":
+				return ec.fieldContext_FantasyTransaction_player(ctx, field)
+			case "faabAmount":
+				return ec.fieldContext_FantasyTransaction_faabAmount(ctx, field)
+			case "trade":
+				return ec.fieldContext_FantasyTransaction_trade(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_FantasyTransaction_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTransaction", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_dropPlayer_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_processWaivers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_processWaivers,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ProcessWaivers(ctx, fc.Args["draftRoomId"].(string))
+		},
+		nil,
+		ec.marshalNWaiverClaim2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐWaiverClaimᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_processWaivers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_WaiverClaim_id(ctx, field)
+			case "team":
+				return ec.fieldContext_WaiverClaim_team(ctx, field)
+			case "addPlayer":
+				return ec.fieldContext_WaiverClaim_addPlayer(ctx, field)
+			case "dropPlayer":
+				return ec.fieldContext_WaiverClaim_dropPlayer(ctx, field)
+			case "bid":
+				return ec.fieldContext_WaiverClaim_bid(ctx, field)
+			case "status":
+				return ec.fieldContext_WaiverClaim_status(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_WaiverClaim_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_WaiverClaim_createdAt(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_WaiverClaim_processedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WaiverClaim", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_processWaivers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_proposeTrade(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_proposeTrade,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ProposeTrade(ctx, fc.Args["proposingTeamId"].(string), fc.Args["receivingTeamId"].(string), fc.Args["offeredPlayerIds"].([]string), fc.Args["requestedPlayerIds"].([]string))
+		},
+		nil,
+		ec.marshalNTrade2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTrade,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_proposeTrade(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Trade_id(ctx, field)
+			case "proposingTeam":
+				return ec.fieldContext_Trade_proposingTeam(ctx, field)
+			case "receivingTeam":
+				return ec.fieldContext_Trade_receivingTeam(ctx, field)
+			case "status":
+				return ec.fieldContext_Trade_status(ctx, field)
+			case "players":
+				return ec.fieldContext_Trade_players(ctx, field)
+			case "counterOf":
+				return ec.fieldContext_Trade_counterOf(ctx, field)
+			case "vetoVotes":
+				return ec.fieldContext_Trade_vetoVotes(ctx, field)
+			case "vetoVotesRequired":
+				return ec.fieldContext_Trade_vetoVotesRequired(ctx, field)
+			case "reviewEndsAt":
+				return ec.fieldContext_Trade_reviewEndsAt(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_Trade_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Trade_createdAt(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Trade_resolvedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Trade", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_proposeTrade_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_counterTrade(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_counterTrade,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CounterTrade(ctx, fc.Args["tradeId"].(string), fc.Args["offeredPlayerIds"].([]string), fc.Args["requestedPlayerIds"].([]string))
+		},
+		nil,
+		ec.marshalNTrade2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTrade,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_counterTrade(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Trade_id(ctx, field)
+			case "proposingTeam":
+				return ec.fieldContext_Trade_proposingTeam(ctx, field)
+			case "receivingTeam":
+				return ec.fieldContext_Trade_receivingTeam(ctx, field)
+			case "status":
+				return ec.fieldContext_Trade_status(ctx, field)
+			case "players":
+				return ec.fieldContext_Trade_players(ctx, field)
+			case "counterOf":
+				return ec.fieldContext_Trade_counterOf(ctx, field)
+			case "vetoVotes":
+				return ec.fieldContext_Trade_vetoVotes(ctx, field)
+			case "vetoVotesRequired":
+				return ec.fieldContext_Trade_vetoVotesRequired(ctx, field)
+			case "reviewEndsAt":
+				return ec.fieldContext_Trade_reviewEndsAt(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_Trade_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Trade_createdAt(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Trade_resolvedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Trade", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_counterTrade_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_acceptTrade(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_acceptTrade,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().AcceptTrade(ctx, fc.Args["tradeId"].(string))
+		},
+		nil,
+		ec.marshalNTrade2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTrade,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_acceptTrade(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Trade_id(ctx, field)
+			case "proposingTeam":
+				return ec.fieldContext_Trade_proposingTeam(ctx, field)
+			case "receivingTeam":
+				return ec.fieldContext_Trade_receivingTeam(ctx, field)
+			case "status":
+				return ec.fieldContext_Trade_status(ctx, field)
+			case "players":
+				return ec.fieldContext_Trade_players(ctx, field)
+			case "counterOf":
+				return ec.fieldContext_Trade_counterOf(ctx, field)
+			case "vetoVotes":
+				return ec.fieldContext_Trade_vetoVotes(ctx, field)
+			case "vetoVotesRequired":
+				return ec.fieldContext_Trade_vetoVotesRequired(ctx, field)
+			case "reviewEndsAt":
+				return ec.fieldContext_Trade_reviewEndsAt(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_Trade_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Trade_createdAt(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Trade_resolvedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Trade", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_acceptTrade_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_rejectTrade(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_rejectTrade,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().RejectTrade(ctx, fc.Args["tradeId"].(string))
+		},
+		nil,
+		ec.marshalNTrade2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTrade,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_rejectTrade(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Trade_id(ctx, field)
+			case "proposingTeam":
+				return ec.fieldContext_Trade_proposingTeam(ctx, field)
+			case "receivingTeam":
+				return ec.fieldContext_Trade_receivingTeam(ctx, field)
+			case "status":
+				return ec.fieldContext_Trade_status(ctx, field)
+			case "players":
+				return ec.fieldContext_Trade_players(ctx, field)
+			case "counterOf":
+				return ec.fieldContext_Trade_counterOf(ctx, field)
+			case "vetoVotes":
+				return ec.fieldContext_Trade_vetoVotes(ctx, field)
+			case "vetoVotesRequired":
+				return ec.fieldContext_Trade_vetoVotesRequired(ctx, field)
+			case "reviewEndsAt":
+				return ec.fieldContext_Trade_reviewEndsAt(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_Trade_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Trade_createdAt(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Trade_resolvedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Trade", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_rejectTrade_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_cancelTrade(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_cancelTrade,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().CancelTrade(ctx, fc.Args["tradeId"].(string))
+		},
+		nil,
+		ec.marshalNTrade2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTrade,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_cancelTrade(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Trade_id(ctx, field)
+			case "proposingTeam":
+				return ec.fieldContext_Trade_proposingTeam(ctx, field)
+			case "receivingTeam":
+				return ec.fieldContext_Trade_receivingTeam(ctx, field)
+			case "status":
+				return ec.fieldContext_Trade_status(ctx, field)
+			case "players":
+				return ec.fieldContext_Trade_players(ctx, field)
+			case "counterOf":
+				return ec.fieldContext_Trade_counterOf(ctx, field)
+			case "vetoVotes":
+				return ec.fieldContext_Trade_vetoVotes(ctx, field)
+			case "vetoVotesRequired":
+				return ec.fieldContext_Trade_vetoVotesRequired(ctx, field)
+			case "reviewEndsAt":
+				return ec.fieldContext_Trade_reviewEndsAt(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_Trade_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Trade_createdAt(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Trade_resolvedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Trade", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_cancelTrade_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_voteToVetoTrade(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_voteToVetoTrade,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().VoteToVetoTrade(ctx, fc.Args["tradeId"].(string), fc.Args["teamId"].(string))
+		},
+		nil,
+		ec.marshalNTrade2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTrade,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_voteToVetoTrade(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Trade_id(ctx, field)
+			case "proposingTeam":
+				return ec.fieldContext_Trade_proposingTeam(ctx, field)
+			case "receivingTeam":
+				return ec.fieldContext_Trade_receivingTeam(ctx, field)
+			case "status":
+				return ec.fieldContext_Trade_status(ctx, field)
+			case "players":
+				return ec.fieldContext_Trade_players(ctx, field)
+			case "counterOf":
+				return ec.fieldContext_Trade_counterOf(ctx, field)
+			case "vetoVotes":
+				return ec.fieldContext_Trade_vetoVotes(ctx, field)
+			case "vetoVotesRequired":
+				return ec.fieldContext_Trade_vetoVotesRequired(ctx, field)
+			case "reviewEndsAt":
+				return ec.fieldContext_Trade_reviewEndsAt(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_Trade_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Trade_createdAt(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Trade_resolvedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Trade", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_voteToVetoTrade_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_approveTrade(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_approveTrade,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().ApproveTrade(ctx, fc.Args["tradeId"].(string))
+		},
+		nil,
+		ec.marshalNTrade2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTrade,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_approveTrade(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Trade_id(ctx, field)
+			case "proposingTeam":
+				return ec.fieldContext_Trade_proposingTeam(ctx, field)
+			case "receivingTeam":
+				return ec.fieldContext_Trade_receivingTeam(ctx, field)
+			case "status":
+				return ec.fieldContext_Trade_status(ctx, field)
+			case "players":
+				return ec.fieldContext_Trade_players(ctx, field)
+			case "counterOf":
+				return ec.fieldContext_Trade_counterOf(ctx, field)
+			case "vetoVotes":
+				return ec.fieldContext_Trade_vetoVotes(ctx, field)
+			case "vetoVotesRequired":
+				return ec.fieldContext_Trade_vetoVotesRequired(ctx, field)
+			case "reviewEndsAt":
+				return ec.fieldContext_Trade_reviewEndsAt(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_Trade_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Trade_createdAt(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Trade_resolvedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Trade", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_approveTrade_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Mutation_vetoTrade(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Mutation_vetoTrade,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Mutation().VetoTrade(ctx, fc.Args["tradeId"].(string))
+		},
+		nil,
+		ec.marshalNTrade2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTrade,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Mutation_vetoTrade(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Mutation",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Trade_id(ctx, field)
+			case "proposingTeam":
+				return ec.fieldContext_Trade_proposingTeam(ctx, field)
+			case "receivingTeam":
+				return ec.fieldContext_Trade_receivingTeam(ctx, field)
+			case "status":
+				return ec.fieldContext_Trade_status(ctx, field)
+			case "players":
+				return ec.fieldContext_Trade_players(ctx, field)
+			case "counterOf":
+				return ec.fieldContext_Trade_counterOf(ctx, field)
+			case "vetoVotes":
+				return ec.fieldContext_Trade_vetoVotes(ctx, field)
+			case "vetoVotesRequired":
+				return ec.fieldContext_Trade_vetoVotesRequired(ctx, field)
+			case "reviewEndsAt":
+				return ec.fieldContext_Trade_reviewEndsAt(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_Trade_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Trade_createdAt(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Trade_resolvedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Trade", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_vetoTrade_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_id(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_firstName(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_firstName,
+		func(ctx context.Context) (any, error) {
+			return obj.FirstName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_firstName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_lastName(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_lastName,
+		func(ctx context.Context) (any, error) {
+			return obj.LastName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_lastName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_fullName(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_fullName,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Player().FullName(ctx, obj)
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_fullName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_position(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_position,
+		func(ctx context.Context) (any, error) {
+			return obj.Position, nil
+		},
+		nil,
+		ec.marshalNPosition2fantasyᚑdraftᚋgraphᚋmodelᚐPosition,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_position(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Position does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_team(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_team,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Player().Team(ctx, obj)
+		},
+		nil,
+		ec.marshalNTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTeam,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_team(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Team_id(ctx, field)
+			case "city":
+				return ec.fieldContext_Team_city(ctx, field)
+			case "state":
+				return ec.fieldContext_Team_state(ctx, field)
+			case "name":
+				return ec.fieldContext_Team_name(ctx, field)
+			case "abbreviation":
+				return ec.fieldContext_Team_abbreviation(ctx, field)
+			case "sportType":
+				return ec.fieldContext_Team_sportType(ctx, field)
+			case "division":
+				return ec.fieldContext_Team_division(ctx, field)
+			case "players":
+				return ec.fieldContext_Team_players(ctx, field)
+			case "depthChart":
+				return ec.fieldContext_Team_depthChart(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Team", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_height(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_height,
+		func(ctx context.Context) (any, error) {
+			return obj.Height, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_height(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_weight(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_weight,
+		func(ctx context.Context) (any, error) {
+			return obj.Weight, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_weight(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_age(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_age,
+		func(ctx context.Context) (any, error) {
+			return obj.Age, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_age(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_yearsOfExperience(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_yearsOfExperience,
+		func(ctx context.Context) (any, error) {
+			return obj.YearsOfExperience, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_yearsOfExperience(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_draftYear(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_draftYear,
+		func(ctx context.Context) (any, error) {
+			return obj.DraftYear, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_draftYear(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_jerseyNumber(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_jerseyNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.JerseyNumber, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_jerseyNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_status(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNPlayerStatus2fantasyᚑdraftᚋgraphᚋmodelᚐPlayerStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PlayerStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_skill(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_skill,
+		func(ctx context.Context) (any, error) {
+			return obj.Skill, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_skill(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_scoutedSkill(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_scoutedSkill,
+		func(ctx context.Context) (any, error) {
+			return obj.ScoutedSkill, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_scoutedSkill(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_draftRound(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_draftRound,
+		func(ctx context.Context) (any, error) {
+			return obj.DraftRound, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_draftRound(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_draftPick(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_draftPick,
+		func(ctx context.Context) (any, error) {
+			return obj.DraftPick, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_draftPick(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_depthChartRank(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_depthChartRank,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Player().DepthChartRank(ctx, obj)
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_depthChartRank(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_yearlyStats(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_yearlyStats,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Player().YearlyStats(ctx, obj)
+		},
+		nil,
+		ec.marshalNYearlyStat2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐYearlyStatᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_yearlyStats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_YearlyStat_id(ctx, field)
+			case "year":
+				return ec.fieldContext_YearlyStat_year(ctx, field)
+			case "sportType":
+				return ec.fieldContext_YearlyStat_sportType(ctx, field)
+			case "stats":
+				return ec.fieldContext_YearlyStat_stats(ctx, field)
+			case "fantasyPoints":
+				return ec.fieldContext_YearlyStat_fantasyPoints(ctx, field)
+			case "gamesPlayed":
+				return ec.fieldContext_YearlyStat_gamesPlayed(ctx, field)
+			case "fantasyPointsPerGame":
+				return ec.fieldContext_YearlyStat_fantasyPointsPerGame(ctx, field)
+			case "age":
+				return ec.fieldContext_YearlyStat_age(ctx, field)
+			case "skill":
+				return ec.fieldContext_YearlyStat_skill(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type YearlyStat", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_injuries(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_injuries,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Player().Injuries(ctx, obj)
+		},
+		nil,
+		ec.marshalNInjury2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐInjuryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_injuries(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Injury_id(ctx, field)
+			case "year":
+				return ec.fieldContext_Injury_year(ctx, field)
+			case "week":
+				return ec.fieldContext_Injury_week(ctx, field)
+			case "gamesMissed":
+				return ec.fieldContext_Injury_gamesMissed(ctx, field)
+			case "injuryType":
+				return ec.fieldContext_Injury_injuryType(ctx, field)
+			case "severity":
+				return ec.fieldContext_Injury_severity(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Injury", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Player_injuryRisk(ctx context.Context, field graphql.CollectedField, obj *model.Player) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Player_injuryRisk,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Player().InjuryRisk(ctx, obj)
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Player_injuryRisk(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Player",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffBracket_rounds(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffBracket) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffBracket_rounds,
+		func(ctx context.Context) (any, error) {
+			return obj.Rounds, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffBracket_rounds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffBracket",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffBracket_games(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffBracket) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffBracket_games,
+		func(ctx context.Context) (any, error) {
+			return obj.Games, nil
+		},
+		nil,
+		ec.marshalNPlayoffGame2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayoffGameᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffBracket_games(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffBracket",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PlayoffGame_id(ctx, field)
+			case "round":
+				return ec.fieldContext_PlayoffGame_round(ctx, field)
+			case "bracketPosition":
+				return ec.fieldContext_PlayoffGame_bracketPosition(ctx, field)
+			case "homeSeed":
+				return ec.fieldContext_PlayoffGame_homeSeed(ctx, field)
+			case "awaySeed":
+				return ec.fieldContext_PlayoffGame_awaySeed(ctx, field)
+			case "homeTeam":
+				return ec.fieldContext_PlayoffGame_homeTeam(ctx, field)
+			case "awayTeam":
+				return ec.fieldContext_PlayoffGame_awayTeam(ctx, field)
+			case "homeScore":
+				return ec.fieldContext_PlayoffGame_homeScore(ctx, field)
+			case "awayScore":
+				return ec.fieldContext_PlayoffGame_awayScore(ctx, field)
+			case "isComplete":
+				return ec.fieldContext_PlayoffGame_isComplete(ctx, field)
+			case "winner":
+				return ec.fieldContext_PlayoffGame_winner(ctx, field)
+			case "homeSource":
+				return ec.fieldContext_PlayoffGame_homeSource(ctx, field)
+			case "awaySource":
+				return ec.fieldContext_PlayoffGame_awaySource(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PlayoffGame", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffBracket_final(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffBracket) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffBracket_final,
+		func(ctx context.Context) (any, error) {
+			return obj.Final, nil
+		},
+		nil,
+		ec.marshalNPlayoffGame2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayoffGame,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffBracket_final(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffBracket",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PlayoffGame_id(ctx, field)
+			case "round":
+				return ec.fieldContext_PlayoffGame_round(ctx, field)
+			case "bracketPosition":
+				return ec.fieldContext_PlayoffGame_bracketPosition(ctx, field)
+			case "homeSeed":
+				return ec.fieldContext_PlayoffGame_homeSeed(ctx, field)
+			case "awaySeed":
+				return ec.fieldContext_PlayoffGame_awaySeed(ctx, field)
+			case "homeTeam":
+				return ec.fieldContext_PlayoffGame_homeTeam(ctx, field)
+			case "awayTeam":
+				return ec.fieldContext_PlayoffGame_awayTeam(ctx, field)
+			case "homeScore":
+				return ec.fieldContext_PlayoffGame_homeScore(ctx, field)
+			case "awayScore":
+				return ec.fieldContext_PlayoffGame_awayScore(ctx, field)
+			case "isComplete":
+				return ec.fieldContext_PlayoffGame_isComplete(ctx, field)
+			case "winner":
+				return ec.fieldContext_PlayoffGame_winner(ctx, field)
+			case "homeSource":
+				return ec.fieldContext_PlayoffGame_homeSource(ctx, field)
+			case "awaySource":
+				return ec.fieldContext_PlayoffGame_awaySource(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PlayoffGame", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffBracket_champion(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffBracket) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffBracket_champion,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.PlayoffBracket().Champion(ctx, obj)
+		},
+		nil,
+		ec.marshalOFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffBracket_champion(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffBracket",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffGame_id(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffGame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffGame_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffGame_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffGame",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffGame_round(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffGame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffGame_round,
+		func(ctx context.Context) (any, error) {
+			return obj.Round, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffGame_round(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffGame",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffGame_bracketPosition(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffGame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffGame_bracketPosition,
+		func(ctx context.Context) (any, error) {
+			return obj.BracketPosition, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffGame_bracketPosition(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffGame",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffGame_homeSeed(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffGame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffGame_homeSeed,
+		func(ctx context.Context) (any, error) {
+			return obj.HomeSeed, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffGame_homeSeed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffGame",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffGame_awaySeed(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffGame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffGame_awaySeed,
+		func(ctx context.Context) (any, error) {
+			return obj.AwaySeed, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffGame_awaySeed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffGame",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffGame_homeTeam(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffGame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffGame_homeTeam,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.PlayoffGame().HomeTeam(ctx, obj)
+		},
+		nil,
+		ec.marshalOFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffGame_homeTeam(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffGame",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffGame_awayTeam(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffGame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffGame_awayTeam,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.PlayoffGame().AwayTeam(ctx, obj)
+		},
+		nil,
+		ec.marshalOFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffGame_awayTeam(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffGame",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffGame_homeScore(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffGame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffGame_homeScore,
+		func(ctx context.Context) (any, error) {
+			return obj.HomeScore, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffGame_homeScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffGame",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffGame_awayScore(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffGame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffGame_awayScore,
+		func(ctx context.Context) (any, error) {
+			return obj.AwayScore, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffGame_awayScore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffGame",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffGame_isComplete(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffGame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffGame_isComplete,
+		func(ctx context.Context) (any, error) {
+			return obj.IsComplete, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffGame_isComplete(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffGame",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffGame_winner(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffGame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffGame_winner,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.PlayoffGame().Winner(ctx, obj)
+		},
+		nil,
+		ec.marshalOFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffGame_winner(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffGame",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffGame_homeSource(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffGame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffGame_homeSource,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.PlayoffGame().HomeSource(ctx, obj)
+		},
+		nil,
+		ec.marshalOPlayoffGame2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayoffGame,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffGame_homeSource(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffGame",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PlayoffGame_id(ctx, field)
+			case "round":
+				return ec.fieldContext_PlayoffGame_round(ctx, field)
+			case "bracketPosition":
+				return ec.fieldContext_PlayoffGame_bracketPosition(ctx, field)
+			case "homeSeed":
+				return ec.fieldContext_PlayoffGame_homeSeed(ctx, field)
+			case "awaySeed":
+				return ec.fieldContext_PlayoffGame_awaySeed(ctx, field)
+			case "homeTeam":
+				return ec.fieldContext_PlayoffGame_homeTeam(ctx, field)
+			case "awayTeam":
+				return ec.fieldContext_PlayoffGame_awayTeam(ctx, field)
+			case "homeScore":
+				return ec.fieldContext_PlayoffGame_homeScore(ctx, field)
+			case "awayScore":
+				return ec.fieldContext_PlayoffGame_awayScore(ctx, field)
+			case "isComplete":
+				return ec.fieldContext_PlayoffGame_isComplete(ctx, field)
+			case "winner":
+				return ec.fieldContext_PlayoffGame_winner(ctx, field)
+			case "homeSource":
+				return ec.fieldContext_PlayoffGame_homeSource(ctx, field)
+			case "awaySource":
+				return ec.fieldContext_PlayoffGame_awaySource(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PlayoffGame", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffGame_awaySource(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffGame) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffGame_awaySource,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.PlayoffGame().AwaySource(ctx, obj)
+		},
+		nil,
+		ec.marshalOPlayoffGame2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayoffGame,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffGame_awaySource(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffGame",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PlayoffGame_id(ctx, field)
+			case "round":
+				return ec.fieldContext_PlayoffGame_round(ctx, field)
+			case "bracketPosition":
+				return ec.fieldContext_PlayoffGame_bracketPosition(ctx, field)
+			case "homeSeed":
+				return ec.fieldContext_PlayoffGame_homeSeed(ctx, field)
+			case "awaySeed":
+				return ec.fieldContext_PlayoffGame_awaySeed(ctx, field)
+			case "homeTeam":
+				return ec.fieldContext_PlayoffGame_homeTeam(ctx, field)
+			case "awayTeam":
+				return ec.fieldContext_PlayoffGame_awayTeam(ctx, field)
+			case "homeScore":
+				return ec.fieldContext_PlayoffGame_homeScore(ctx, field)
+			case "awayScore":
+				return ec.fieldContext_PlayoffGame_awayScore(ctx, field)
+			case "isComplete":
+				return ec.fieldContext_PlayoffGame_isComplete(ctx, field)
+			case "winner":
+				return ec.fieldContext_PlayoffGame_winner(ctx, field)
+			case "homeSource":
+				return ec.fieldContext_PlayoffGame_homeSource(ctx, field)
+			case "awaySource":
+				return ec.fieldContext_PlayoffGame_awaySource(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PlayoffGame", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffSettings_teams(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffSettings_teams,
+		func(ctx context.Context) (any, error) {
+			return obj.Teams, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffSettings_teams(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffSettings_byes(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffSettings_byes,
+		func(ctx context.Context) (any, error) {
+			return obj.Byes, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffSettings_byes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffSettings_weeksPerRound(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffSettings_weeksPerRound,
+		func(ctx context.Context) (any, error) {
+			return obj.WeeksPerRound, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffSettings_weeksPerRound(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PlayoffSettings_tiebreakers(ctx context.Context, field graphql.CollectedField, obj *model.PlayoffSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PlayoffSettings_tiebreakers,
+		func(ctx context.Context) (any, error) {
+			return obj.Tiebreakers, nil
+		},
+		nil,
+		ec.marshalNSeedingTiebreaker2ᚕfantasyᚑdraftᚋgraphᚋmodelᚐSeedingTiebreakerᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PlayoffSettings_tiebreakers(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PlayoffSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type SeedingTiebreaker does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_universes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_universes,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().Universes(ctx)
+		},
+		nil,
+		ec.marshalNUniverse2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐUniverseᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_universes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Universe_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Universe_name(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Universe", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_conferences(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_conferences,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Conferences(ctx, fc.Args["universeId"].(*string))
+		},
+		nil,
+		ec.marshalNConference2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐConferenceᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_conferences(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Conference_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Conference_name(ctx, field)
+			case "divisions":
+				return ec.fieldContext_Conference_divisions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Conference", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_conferences_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_conference(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_conference,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Conference(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOConference2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐConference,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_conference(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Conference_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Conference_name(ctx, field)
+			case "divisions":
+				return ec.fieldContext_Conference_divisions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Conference", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_conference_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_divisions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_divisions,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Query().Divisions(ctx)
+		},
+		nil,
+		ec.marshalNDivision2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐDivisionᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_divisions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Division_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Division_name(ctx, field)
+			case "conference":
+				return ec.fieldContext_Division_conference(ctx, field)
+			case "teams":
+				return ec.fieldContext_Division_teams(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Division", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_division(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_division,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Division(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalODivision2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐDivision,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_division(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Division_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Division_name(ctx, field)
+			case "conference":
+				return ec.fieldContext_Division_conference(ctx, field)
+			case "teams":
+				return ec.fieldContext_Division_teams(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Division", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_division_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_teams(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_teams,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Teams(ctx, fc.Args["universeId"].(*string))
+		},
+		nil,
+		ec.marshalNTeam2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐTeamᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_teams(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Team_id(ctx, field)
+			case "city":
+				return ec.fieldContext_Team_city(ctx, field)
+			case "state":
+				return ec.fieldContext_Team_state(ctx, field)
+			case "name":
+				return ec.fieldContext_Team_name(ctx, field)
+			case "abbreviation":
+				return ec.fieldContext_Team_abbreviation(ctx, field)
+			case "sportType":
+				return ec.fieldContext_Team_sportType(ctx, field)
+			case "division":
+				return ec.fieldContext_Team_division(ctx, field)
+			case "players":
+				return ec.fieldContext_Team_players(ctx, field)
+			case "depthChart":
+				return ec.fieldContext_Team_depthChart(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Team", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_teams_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_team(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_team,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Team(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTeam,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_team(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Team_id(ctx, field)
+			case "city":
+				return ec.fieldContext_Team_city(ctx, field)
+			case "state":
+				return ec.fieldContext_Team_state(ctx, field)
+			case "name":
+				return ec.fieldContext_Team_name(ctx, field)
+			case "abbreviation":
+				return ec.fieldContext_Team_abbreviation(ctx, field)
+			case "sportType":
+				return ec.fieldContext_Team_sportType(ctx, field)
+			case "division":
+				return ec.fieldContext_Team_division(ctx, field)
+			case "players":
+				return ec.fieldContext_Team_players(ctx, field)
+			case "depthChart":
+				return ec.fieldContext_Team_depthChart(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Team", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_team_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_players(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_players,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Players(ctx, fc.Args["position"].(*model.Position), fc.Args["teamId"].(*string), fc.Args["universeId"].(*string), fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+		},
+		nil,
+		ec.marshalNPlayer2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayerᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_players(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Player_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Player_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Player_lastName(ctx, field)
+			case "fullName":
+				return ec.fieldContext_Player_fullName(ctx, field)
+			case "position":
+				return ec.fieldContext_Player_position(ctx, field)
+			case "team":
+				return ec.fieldContext_Player_team(ctx, field)
+			case "height":
+				return ec.fieldContext_Player_height(ctx, field)
+			case "weight":
+				return ec.fieldContext_Player_weight(ctx, field)
+			case "age":
+				return ec.fieldContext_Player_age(ctx, field)
+			case "yearsOfExperience":
+				return ec.fieldContext_Player_yearsOfExperience(ctx, field)
+			case "draftYear":
+				return ec.fieldContext_Player_draftYear(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "status":
+				return ec.fieldContext_Player_status(ctx, field)
+			case "skill":
+				return ec.fieldContext_Player_skill(ctx, field)
+			case "scoutedSkill":
+				return ec.fieldContext_Player_scoutedSkill(ctx, field)
+			case "draftRound":
+				return ec.fieldContext_Player_draftRound(ctx, field)
+			case "draftPick":
+				return ec.fieldContext_Player_draftPick(ctx, field)
+			case "depthChartRank":
+				return ec.fieldContext_Player_depthChartRank(ctx, field)
+			case "yearlyStats":
+				return ec.fieldContext_Player_yearlyStats(ctx, field)
+			case "injuries":
+				return ec.fieldContext_Player_injuries(ctx, field)
+			case "injuryRisk":
+				return ec.fieldContext_Player_injuryRisk(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_players_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_player(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_player,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Player(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOPlayer2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_player(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Player_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Player_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Player_lastName(ctx, field)
+			case "fullName":
+				return ec.fieldContext_Player_fullName(ctx, field)
+			case "position":
+				return ec.fieldContext_Player_position(ctx, field)
+			case "team":
+				return ec.fieldContext_Player_team(ctx, field)
+			case "height":
+				return ec.fieldContext_Player_height(ctx, field)
+			case "weight":
+				return ec.fieldContext_Player_weight(ctx, field)
+			case "age":
+				return ec.fieldContext_Player_age(ctx, field)
+			case "yearsOfExperience":
+				return ec.fieldContext_Player_yearsOfExperience(ctx, field)
+			case "draftYear":
+				return ec.fieldContext_Player_draftYear(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "status":
+				return ec.fieldContext_Player_status(ctx, field)
+			case "skill":
+				return ec.fieldContext_Player_skill(ctx, field)
+			case "scoutedSkill":
+				return ec.fieldContext_Player_scoutedSkill(ctx, field)
+			case "draftRound":
+				return ec.fieldContext_Player_draftRound(ctx, field)
+			case "draftPick":
+				return ec.fieldContext_Player_draftPick(ctx, field)
+			case "depthChartRank":
+				return ec.fieldContext_Player_depthChartRank(ctx, field)
+			case "yearlyStats":
+				return ec.fieldContext_Player_yearlyStats(ctx, field)
+			case "injuries":
+				return ec.fieldContext_Player_injuries(ctx, field)
+			case "injuryRisk":
+				return ec.fieldContext_Player_injuryRisk(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_player_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_searchPlayers(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_searchPlayers,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().SearchPlayers(ctx, fc.Args["query"].(string), fc.Args["limit"].(*int))
+		},
+		nil,
+		ec.marshalNPlayer2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayerᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_searchPlayers(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Player_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Player_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Player_lastName(ctx, field)
+			case "fullName":
+				return ec.fieldContext_Player_fullName(ctx, field)
+			case "position":
+				return ec.fieldContext_Player_position(ctx, field)
+			case "team":
+				return ec.fieldContext_Player_team(ctx, field)
+			case "height":
+				return ec.fieldContext_Player_height(ctx, field)
+			case "weight":
+				return ec.fieldContext_Player_weight(ctx, field)
+			case "age":
+				return ec.fieldContext_Player_age(ctx, field)
+			case "yearsOfExperience":
+				return ec.fieldContext_Player_yearsOfExperience(ctx, field)
+			case "draftYear":
+				return ec.fieldContext_Player_draftYear(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "status":
+				return ec.fieldContext_Player_status(ctx, field)
+			case "skill":
+				return ec.fieldContext_Player_skill(ctx, field)
+			case "scoutedSkill":
+				return ec.fieldContext_Player_scoutedSkill(ctx, field)
+			case "draftRound":
+				return ec.fieldContext_Player_draftRound(ctx, field)
+			case "draftPick":
+				return ec.fieldContext_Player_draftPick(ctx, field)
+			case "depthChartRank":
+				return ec.fieldContext_Player_depthChartRank(ctx, field)
+			case "yearlyStats":
+				return ec.fieldContext_Player_yearlyStats(ctx, field)
+			case "injuries":
+				return ec.fieldContext_Player_injuries(ctx, field)
+			case "injuryRisk":
+				return ec.fieldContext_Player_injuryRisk(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_searchPlayers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_fantasySeason(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_fantasySeason,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().FantasySeason(ctx, fc.Args["draftRoomId"].(string))
+		},
+		nil,
+		ec.marshalOFantasySeason2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasySeason,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_fantasySeason(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasySeason_id(ctx, field)
+			case "draftRoomId":
+				return ec.fieldContext_FantasySeason_draftRoomId(ctx, field)
+			case "year":
+				return ec.fieldContext_FantasySeason_year(ctx, field)
+			case "regularSeasonWeeks":
+				return ec.fieldContext_FantasySeason_regularSeasonWeeks(ctx, field)
+			case "currentWeek":
+				return ec.fieldContext_FantasySeason_currentWeek(ctx, field)
+			case "playoffSettings":
+				return ec.fieldContext_FantasySeason_playoffSettings(ctx, field)
+			case "waiverSettings":
+				return ec.fieldContext_FantasySeason_waiverSettings(ctx, field)
+			case "tradeSettings":
+				return ec.fieldContext_FantasySeason_tradeSettings(ctx, field)
+			case "champion":
+				return ec.fieldContext_FantasySeason_champion(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasySeason", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_fantasySeason_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_standings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_standings,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Standings(ctx, fc.Args["draftRoomId"].(string))
+		},
+		nil,
+		ec.marshalNStanding2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐStandingᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_standings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "rank":
+				return ec.fieldContext_Standing_rank(ctx, field)
+			case "team":
+				return ec.fieldContext_Standing_team(ctx, field)
+			case "wins":
+				return ec.fieldContext_Standing_wins(ctx, field)
+			case "losses":
+				return ec.fieldContext_Standing_losses(ctx, field)
+			case "ties":
+				return ec.fieldContext_Standing_ties(ctx, field)
+			case "pointsFor":
+				return ec.fieldContext_Standing_pointsFor(ctx, field)
+			case "pointsAgainst":
+				return ec.fieldContext_Standing_pointsAgainst(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Standing", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_standings_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_rotoStandings(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_rotoStandings,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().RotoStandings(ctx, fc.Args["draftRoomId"].(string), fc.Args["year"].(*int))
+		},
+		nil,
+		ec.marshalNRotoStanding2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐRotoStandingᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_rotoStandings(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "rank":
+				return ec.fieldContext_RotoStanding_rank(ctx, field)
+			case "team":
+				return ec.fieldContext_RotoStanding_team(ctx, field)
+			case "points":
+				return ec.fieldContext_RotoStanding_points(ctx, field)
+			case "categories":
+				return ec.fieldContext_RotoStanding_categories(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RotoStanding", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_rotoStandings_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_matchups(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_matchups,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Matchups(ctx, fc.Args["draftRoomId"].(string), fc.Args["week"].(int))
+		},
+		nil,
+		ec.marshalNMatchup2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐMatchupᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_matchups(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Matchup_id(ctx, field)
+			case "week":
+				return ec.fieldContext_Matchup_week(ctx, field)
+			case "homeTeam":
+				return ec.fieldContext_Matchup_homeTeam(ctx, field)
+			case "awayTeam":
+				return ec.fieldContext_Matchup_awayTeam(ctx, field)
+			case "homeScore":
+				return ec.fieldContext_Matchup_homeScore(ctx, field)
+			case "awayScore":
+				return ec.fieldContext_Matchup_awayScore(ctx, field)
+			case "isComplete":
+				return ec.fieldContext_Matchup_isComplete(ctx, field)
+			case "winner":
+				return ec.fieldContext_Matchup_winner(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Matchup", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_matchups_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_playoffBracket(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_playoffBracket,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().PlayoffBracket(ctx, fc.Args["draftRoomId"].(string))
+		},
+		nil,
+		ec.marshalOPlayoffBracket2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayoffBracket,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_playoffBracket(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "rounds":
+				return ec.fieldContext_PlayoffBracket_rounds(ctx, field)
+			case "games":
+				return ec.fieldContext_PlayoffBracket_games(ctx, field)
+			case "final":
+				return ec.fieldContext_PlayoffBracket_final(ctx, field)
+			case "champion":
+				return ec.fieldContext_PlayoffBracket_champion(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PlayoffBracket", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_playoffBracket_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_freeAgents(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_freeAgents,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().FreeAgents(ctx, fc.Args["draftRoomId"].(string), fc.Args["position"].(*model.Position), fc.Args["limit"].(*int), fc.Args["offset"].(*int))
+		},
+		nil,
+		ec.marshalNPlayer2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayerᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_freeAgents(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Player_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Player_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Player_lastName(ctx, field)
+			case "fullName":
+				return ec.fieldContext_Player_fullName(ctx, field)
+			case "position":
+				return ec.fieldContext_Player_position(ctx, field)
+			case "team":
+				return ec.fieldContext_Player_team(ctx, field)
+			case "height":
+				return ec.fieldContext_Player_height(ctx, field)
+			case "weight":
+				return ec.fieldContext_Player_weight(ctx, field)
+			case "age":
+				return ec.fieldContext_Player_age(ctx, field)
+			case "yearsOfExperience":
+				return ec.fieldContext_Player_yearsOfExperience(ctx, field)
+			case "draftYear":
+				return ec.fieldContext_Player_draftYear(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "status":
+				return ec.fieldContext_Player_status(ctx, field)
+			case "skill":
+				return ec.fieldContext_Player_skill(ctx, field)
+			case "scoutedSkill":
+				return ec.fieldContext_Player_scoutedSkill(ctx, field)
+			case "draftRound":
+				return ec.fieldContext_Player_draftRound(ctx, field)
+			case "draftPick":
+				return ec.fieldContext_Player_draftPick(ctx, field)
+			case "depthChartRank":
+				return ec.fieldContext_Player_depthChartRank(ctx, field)
+			case "yearlyStats":
+				return ec.fieldContext_Player_yearlyStats(ctx, field)
+			case "injuries":
+				return ec.fieldContext_Player_injuries(ctx, field)
+			case "injuryRisk":
+				return ec.fieldContext_Player_injuryRisk(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_freeAgents_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_waiverClaims(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_waiverClaims,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().WaiverClaims(ctx, fc.Args["draftRoomId"].(string), fc.Args["teamId"].(*string), fc.Args["status"].(*model.WaiverClaimStatus))
+		},
+		nil,
+		ec.marshalNWaiverClaim2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐWaiverClaimᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_waiverClaims(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_WaiverClaim_id(ctx, field)
+			case "team":
+				return ec.fieldContext_WaiverClaim_team(ctx, field)
+			case "addPlayer":
+				return ec.fieldContext_WaiverClaim_addPlayer(ctx, field)
+			case "dropPlayer":
+				return ec.fieldContext_WaiverClaim_dropPlayer(ctx, field)
+			case "bid":
+				return ec.fieldContext_WaiverClaim_bid(ctx, field)
+			case "status":
+				return ec.fieldContext_WaiverClaim_status(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_WaiverClaim_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_WaiverClaim_createdAt(ctx, field)
+			case "processedAt":
+				return ec.fieldContext_WaiverClaim_processedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type WaiverClaim", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_waiverClaims_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_transactions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_transactions,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Transactions(ctx, fc.Args["draftRoomId"].(string), fc.Args["teamId"].(*string), fc.Args["limit"].(*int))
+		},
+		nil,
+		ec.marshalNFantasyTransaction2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTransactionᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_transactions(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTransaction_id(ctx, field)
+			case "type":
+				return ec.fieldContext_FantasyTransaction_type(ctx, field)
+			case "team":
+				return ec.fieldContext_FantasyTransaction_team(ctx, field)
+			case "player":
+				return ec.fieldContext_FantasyTransaction_player(ctx, field)
+			case "faabAmount":
+				return ec.fieldContext_FantasyTransaction_faabAmount(ctx, field)
+			case "trade":
+				return ec.fieldContext_FantasyTransaction_trade(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_FantasyTransaction_createdAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTransaction", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_transactions_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_trades(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_trades,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Trades(ctx, fc.Args["draftRoomId"].(string), fc.Args["teamId"].(*string), fc.Args["status"].(*model.TradeStatus))
+		},
+		nil,
+		ec.marshalNTrade2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐTradeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_trades(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Trade_id(ctx, field)
+			case "proposingTeam":
+				return ec.fieldContext_Trade_proposingTeam(ctx, field)
+			case "receivingTeam":
+				return ec.fieldContext_Trade_receivingTeam(ctx, field)
+			case "status":
+				return ec.fieldContext_Trade_status(ctx, field)
+			case "players":
+				return ec.fieldContext_Trade_players(ctx, field)
+			case "counterOf":
+				return ec.fieldContext_Trade_counterOf(ctx, field)
+			case "vetoVotes":
+				return ec.fieldContext_Trade_vetoVotes(ctx, field)
+			case "vetoVotesRequired":
+				return ec.fieldContext_Trade_vetoVotesRequired(ctx, field)
+			case "reviewEndsAt":
+				return ec.fieldContext_Trade_reviewEndsAt(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_Trade_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Trade_createdAt(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Trade_resolvedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Trade", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_trades_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_trade(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_trade,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Query().Trade(ctx, fc.Args["id"].(string))
+		},
+		nil,
+		ec.marshalOTrade2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTrade,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_trade(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Trade_id(ctx, field)
+			case "proposingTeam":
+				return ec.fieldContext_Trade_proposingTeam(ctx, field)
+			case "receivingTeam":
+				return ec.fieldContext_Trade_receivingTeam(ctx, field)
+			case "status":
+				return ec.fieldContext_Trade_status(ctx, field)
+			case "players":
+				return ec.fieldContext_Trade_players(ctx, field)
+			case "counterOf":
+				return ec.fieldContext_Trade_counterOf(ctx, field)
+			case "vetoVotes":
+				return ec.fieldContext_Trade_vetoVotes(ctx, field)
+			case "vetoVotesRequired":
+				return ec.fieldContext_Trade_vetoVotesRequired(ctx, field)
+			case "reviewEndsAt":
+				return ec.fieldContext_Trade_reviewEndsAt(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_Trade_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Trade_createdAt(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Trade_resolvedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Trade", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_trade_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___type,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.introspectType(fc.Args["name"].(string))
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query___schema,
+		func(ctx context.Context) (any, error) {
+			return ec.introspectSchema()
+		},
+		nil,
+		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RosterEntry_player(ctx context.Context, field graphql.CollectedField, obj *model.RosterEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RosterEntry_player,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.RosterEntry().Player(ctx, obj)
+		},
+		nil,
+		ec.marshalNPlayer2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayer,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RosterEntry_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RosterEntry",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Player_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Player_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Player_lastName(ctx, field)
+			case "fullName":
+				return ec.fieldContext_Player_fullName(ctx, field)
+			case "position":
+				return ec.fieldContext_Player_position(ctx, field)
+			case "team":
+				return ec.fieldContext_Player_team(ctx, field)
+			case "height":
+				return ec.fieldContext_Player_height(ctx, field)
+			case "weight":
+				return ec.fieldContext_Player_weight(ctx, field)
+			case "age":
+				return ec.fieldContext_Player_age(ctx, field)
+			case "yearsOfExperience":
+				return ec.fieldContext_Player_yearsOfExperience(ctx, field)
+			case "draftYear":
+				return ec.fieldContext_Player_draftYear(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "status":
+				return ec.fieldContext_Player_status(ctx, field)
+			case "skill":
+				return ec.fieldContext_Player_skill(ctx, field)
+			case "scoutedSkill":
+				return ec.fieldContext_Player_scoutedSkill(ctx, field)
+			case "draftRound":
+				return ec.fieldContext_Player_draftRound(ctx, field)
+			case "draftPick":
+				return ec.fieldContext_Player_draftPick(ctx, field)
+			case "depthChartRank":
+				return ec.fieldContext_Player_depthChartRank(ctx, field)
+			case "yearlyStats":
+				return ec.fieldContext_Player_yearlyStats(ctx, field)
+			case "injuries":
+				return ec.fieldContext_Player_injuries(ctx, field)
+			case "injuryRisk":
+				return ec.fieldContext_Player_injuryRisk(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RosterEntry_rosterSpot(ctx context.Context, field graphql.CollectedField, obj *model.RosterEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RosterEntry_rosterSpot,
+		func(ctx context.Context) (any, error) {
+			return obj.RosterSpot, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RosterEntry_rosterSpot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RosterEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RotoCategoryScore_category(ctx context.Context, field graphql.CollectedField, obj *model.RotoCategoryScore) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RotoCategoryScore_category,
+		func(ctx context.Context) (any, error) {
+			return obj.Category, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RotoCategoryScore_category(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RotoCategoryScore",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RotoCategoryScore_value(ctx context.Context, field graphql.CollectedField, obj *model.RotoCategoryScore) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RotoCategoryScore_value,
+		func(ctx context.Context) (any, error) {
+			return obj.Value, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RotoCategoryScore_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RotoCategoryScore",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RotoCategoryScore_points(ctx context.Context, field graphql.CollectedField, obj *model.RotoCategoryScore) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RotoCategoryScore_points,
+		func(ctx context.Context) (any, error) {
+			return obj.Points, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RotoCategoryScore_points(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RotoCategoryScore",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RotoStanding_rank(ctx context.Context, field graphql.CollectedField, obj *model.RotoStanding) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RotoStanding_rank,
+		func(ctx context.Context) (any, error) {
+			return obj.Rank, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RotoStanding_rank(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RotoStanding",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RotoStanding_team(ctx context.Context, field graphql.CollectedField, obj *model.RotoStanding) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RotoStanding_team,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.RotoStanding().Team(ctx, obj)
+		},
+		nil,
+		ec.marshalNFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RotoStanding_team(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RotoStanding",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RotoStanding_points(ctx context.Context, field graphql.CollectedField, obj *model.RotoStanding) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RotoStanding_points,
+		func(ctx context.Context) (any, error) {
+			return obj.Points, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RotoStanding_points(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RotoStanding",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RotoStanding_categories(ctx context.Context, field graphql.CollectedField, obj *model.RotoStanding) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RotoStanding_categories,
+		func(ctx context.Context) (any, error) {
+			return obj.Categories, nil
+		},
+		nil,
+		ec.marshalNRotoCategoryScore2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐRotoCategoryScoreᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RotoStanding_categories(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RotoStanding",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "category":
+				return ec.fieldContext_RotoCategoryScore_category(ctx, field)
+			case "value":
+				return ec.fieldContext_RotoCategoryScore_value(ctx, field)
+			case "points":
+				return ec.fieldContext_RotoCategoryScore_points(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RotoCategoryScore", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Standing_rank(ctx context.Context, field graphql.CollectedField, obj *model.Standing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Standing_rank,
+		func(ctx context.Context) (any, error) {
+			return obj.Rank, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Standing_rank(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Standing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Standing_team(ctx context.Context, field graphql.CollectedField, obj *model.Standing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Standing_team,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Standing().Team(ctx, obj)
+		},
+		nil,
+		ec.marshalNFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Standing_team(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Standing",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Standing_wins(ctx context.Context, field graphql.CollectedField, obj *model.Standing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Standing_wins,
+		func(ctx context.Context) (any, error) {
+			return obj.Wins, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Standing_wins(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Standing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Standing_losses(ctx context.Context, field graphql.CollectedField, obj *model.Standing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Standing_losses,
+		func(ctx context.Context) (any, error) {
+			return obj.Losses, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Standing_losses(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Standing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Standing_ties(ctx context.Context, field graphql.CollectedField, obj *model.Standing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Standing_ties,
+		func(ctx context.Context) (any, error) {
+			return obj.Ties, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Standing_ties(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Standing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Standing_pointsFor(ctx context.Context, field graphql.CollectedField, obj *model.Standing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Standing_pointsFor,
+		func(ctx context.Context) (any, error) {
+			return obj.PointsFor, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Standing_pointsFor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Standing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Standing_pointsAgainst(ctx context.Context, field graphql.CollectedField, obj *model.Standing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Standing_pointsAgainst,
+		func(ctx context.Context) (any, error) {
+			return obj.PointsAgainst, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Standing_pointsAgainst(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Standing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Team_id(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Team_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Team_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Team_city(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Team_city,
+		func(ctx context.Context) (any, error) {
+			return obj.City, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Team_city(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Team_state(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Team_state,
+		func(ctx context.Context) (any, error) {
+			return obj.State, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Team_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Team_name(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Team_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Team_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Team_abbreviation(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Team_abbreviation,
+		func(ctx context.Context) (any, error) {
+			return obj.Abbreviation, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Team_abbreviation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Team_sportType(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Team_sportType,
+		func(ctx context.Context) (any, error) {
+			return obj.SportType, nil
+		},
+		nil,
+		ec.marshalNSportType2fantasyᚑdraftᚋgraphᚋmodelᚐSportType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Team_sportType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type SportType does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Team_division(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Team_division,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Team().Division(ctx, obj)
+		},
+		nil,
+		ec.marshalNDivision2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐDivision,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Team_division(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Division_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Division_name(ctx, field)
+			case "conference":
+				return ec.fieldContext_Division_conference(ctx, field)
+			case "teams":
+				return ec.fieldContext_Division_teams(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Division", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Team_players(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Team_players,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Team().Players(ctx, obj)
+		},
+		nil,
+		ec.marshalNPlayer2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayerᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Team_players(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Player_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Player_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Player_lastName(ctx, field)
+			case "fullName":
+				return ec.fieldContext_Player_fullName(ctx, field)
+			case "position":
+				return ec.fieldContext_Player_position(ctx, field)
+			case "team":
+				return ec.fieldContext_Player_team(ctx, field)
+			case "height":
+				return ec.fieldContext_Player_height(ctx, field)
+			case "weight":
+				return ec.fieldContext_Player_weight(ctx, field)
+			case "age":
+				return ec.fieldContext_Player_age(ctx, field)
+			case "yearsOfExperience":
+				return ec.fieldContext_Player_yearsOfExperience(ctx, field)
+			case "draftYear":
+				return ec.fieldContext_Player_draftYear(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "status":
+				return ec.fieldContext_Player_status(ctx, field)
+			case "skill":
+				return ec.fieldContext_Player_skill(ctx, field)
+			case "scoutedSkill":
+				return ec.fieldContext_Player_scoutedSkill(ctx, field)
+			case "draftRound":
+				return ec.fieldContext_Player_draftRound(ctx, field)
+			case "draftPick":
+				return ec.fieldContext_Player_draftPick(ctx, field)
+			case "depthChartRank":
+				return ec.fieldContext_Player_depthChartRank(ctx, field)
+			case "yearlyStats":
+				return ec.fieldContext_Player_yearlyStats(ctx, field)
+			case "injuries":
+				return ec.fieldContext_Player_injuries(ctx, field)
+			case "injuryRisk":
+				return ec.fieldContext_Player_injuryRisk(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Team_depthChart(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Team_depthChart,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.resolvers.Team().DepthChart(ctx, obj, fc.Args["position"].(*model.Position))
+		},
+		nil,
+		ec.marshalNDepthChartEntry2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐDepthChartEntryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Team_depthChart(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Team",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "position":
+				return ec.fieldContext_DepthChartEntry_position(ctx, field)
+			case "rank":
+				return ec.fieldContext_DepthChartEntry_rank(ctx, field)
+			case "player":
+				return ec.fieldContext_DepthChartEntry_player(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DepthChartEntry", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Team_depthChart_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Trade_id(ctx context.Context, field graphql.CollectedField, obj *model.Trade) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Trade_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Trade_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Trade",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Trade_proposingTeam(ctx context.Context, field graphql.CollectedField, obj *model.Trade) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Trade_proposingTeam,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Trade().ProposingTeam(ctx, obj)
+		},
+		nil,
+		ec.marshalNFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Trade_proposingTeam(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Trade",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Trade_receivingTeam(ctx context.Context, field graphql.CollectedField, obj *model.Trade) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Trade_receivingTeam,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Trade().ReceivingTeam(ctx, obj)
+		},
+		nil,
+		ec.marshalNFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Trade_receivingTeam(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Trade",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Trade_status(ctx context.Context, field graphql.CollectedField, obj *model.Trade) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Trade_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNTradeStatus2fantasyᚑdraftᚋgraphᚋmodelᚐTradeStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Trade_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Trade",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type TradeStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Trade_players(ctx context.Context, field graphql.CollectedField, obj *model.Trade) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Trade_players,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Trade().Players(ctx, obj)
+		},
+		nil,
+		ec.marshalNTradePlayer2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐTradePlayerᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Trade_players(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Trade",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "player":
+				return ec.fieldContext_TradePlayer_player(ctx, field)
+			case "fromTeam":
+				return ec.fieldContext_TradePlayer_fromTeam(ctx, field)
+			case "toTeam":
+				return ec.fieldContext_TradePlayer_toTeam(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TradePlayer", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Trade_counterOf(ctx context.Context, field graphql.CollectedField, obj *model.Trade) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Trade_counterOf,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Trade().CounterOf(ctx, obj)
+		},
+		nil,
+		ec.marshalOTrade2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTrade,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Trade_counterOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Trade",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Trade_id(ctx, field)
+			case "proposingTeam":
+				return ec.fieldContext_Trade_proposingTeam(ctx, field)
+			case "receivingTeam":
+				return ec.fieldContext_Trade_receivingTeam(ctx, field)
+			case "status":
+				return ec.fieldContext_Trade_status(ctx, field)
+			case "players":
+				return ec.fieldContext_Trade_players(ctx, field)
+			case "counterOf":
+				return ec.fieldContext_Trade_counterOf(ctx, field)
+			case "vetoVotes":
+				return ec.fieldContext_Trade_vetoVotes(ctx, field)
+			case "vetoVotesRequired":
+				return ec.fieldContext_Trade_vetoVotesRequired(ctx, field)
+			case "reviewEndsAt":
+				return ec.fieldContext_Trade_reviewEndsAt(ctx, field)
+			case "failureReason":
+				return ec.fieldContext_Trade_failureReason(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Trade_createdAt(ctx, field)
+			case "resolvedAt":
+				return ec.fieldContext_Trade_resolvedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Trade", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Trade_vetoVotes(ctx context.Context, field graphql.CollectedField, obj *model.Trade) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Trade_vetoVotes,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Trade().VetoVotes(ctx, obj)
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Trade_vetoVotes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Trade",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Trade_vetoVotesRequired(ctx context.Context, field graphql.CollectedField, obj *model.Trade) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Trade_vetoVotesRequired,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.Trade().VetoVotesRequired(ctx, obj)
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Trade_vetoVotesRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Trade",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Trade_reviewEndsAt(ctx context.Context, field graphql.CollectedField, obj *model.Trade) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Trade_reviewEndsAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewEndsAt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Trade_reviewEndsAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Trade",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Trade_failureReason(ctx context.Context, field graphql.CollectedField, obj *model.Trade) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Trade_failureReason,
+		func(ctx context.Context) (any, error) {
+			return obj.FailureReason, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Trade_failureReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Trade",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Trade_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Trade) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Trade_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Trade_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Trade",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Trade_resolvedAt(ctx context.Context, field graphql.CollectedField, obj *model.Trade) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Trade_resolvedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ResolvedAt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Trade_resolvedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Trade",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TradePlayer_player(ctx context.Context, field graphql.CollectedField, obj *model.TradePlayer) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TradePlayer_player,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.TradePlayer().Player(ctx, obj)
+		},
+		nil,
+		ec.marshalNPlayer2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayer,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TradePlayer_player(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TradePlayer",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Player_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Player_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Player_lastName(ctx, field)
+			case "fullName":
+				return ec.fieldContext_Player_fullName(ctx, field)
+			case "position":
+				return ec.fieldContext_Player_position(ctx, field)
+			case "team":
+				return ec.fieldContext_Player_team(ctx, field)
+			case "height":
+				return ec.fieldContext_Player_height(ctx, field)
+			case "weight":
+				return ec.fieldContext_Player_weight(ctx, field)
+			case "age":
+				return ec.fieldContext_Player_age(ctx, field)
+			case "yearsOfExperience":
+				return ec.fieldContext_Player_yearsOfExperience(ctx, field)
+			case "draftYear":
+				return ec.fieldContext_Player_draftYear(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "status":
+				return ec.fieldContext_Player_status(ctx, field)
+			case "skill":
+				return ec.fieldContext_Player_skill(ctx, field)
+			case "scoutedSkill":
+				return ec.fieldContext_Player_scoutedSkill(ctx, field)
+			case "draftRound":
+				return ec.fieldContext_Player_draftRound(ctx, field)
+			case "draftPick":
+				return ec.fieldContext_Player_draftPick(ctx, field)
+			case "depthChartRank":
+				return ec.fieldContext_Player_depthChartRank(ctx, field)
+			case "yearlyStats":
+				return ec.fieldContext_Player_yearlyStats(ctx, field)
+			case "injuries":
+				return ec.fieldContext_Player_injuries(ctx, field)
+			case "injuryRisk":
+				return ec.fieldContext_Player_injuryRisk(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TradePlayer_fromTeam(ctx context.Context, field graphql.CollectedField, obj *model.TradePlayer) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TradePlayer_fromTeam,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.TradePlayer().FromTeam(ctx, obj)
+		},
+		nil,
+		ec.marshalNFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TradePlayer_fromTeam(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TradePlayer",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TradePlayer_toTeam(ctx context.Context, field graphql.CollectedField, obj *model.TradePlayer) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TradePlayer_toTeam,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.TradePlayer().ToTeam(ctx, obj)
+		},
+		nil,
+		ec.marshalNFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TradePlayer_toTeam(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TradePlayer",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TradeSettings_reviewHours(ctx context.Context, field graphql.CollectedField, obj *model.TradeSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TradeSettings_reviewHours,
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewHours, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_TradeSettings_reviewHours(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TradeSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TradeSettings_vetoVotes(ctx context.Context, field graphql.CollectedField, obj *model.TradeSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_TradeSettings_vetoVotes,
+		func(ctx context.Context) (any, error) {
+			return obj.VetoVotes, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_TradeSettings_vetoVotes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TradeSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Universe_id(ctx context.Context, field graphql.CollectedField, obj *model.Universe) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Universe_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Universe_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Universe",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Universe_name(ctx context.Context, field graphql.CollectedField, obj *model.Universe) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Universe_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Universe_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Universe",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaiverClaim_id(ctx context.Context, field graphql.CollectedField, obj *model.WaiverClaim) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaiverClaim_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaiverClaim_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaiverClaim",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaiverClaim_team(ctx context.Context, field graphql.CollectedField, obj *model.WaiverClaim) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaiverClaim_team,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.WaiverClaim().Team(ctx, obj)
+		},
+		nil,
+		ec.marshalNFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaiverClaim_team(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaiverClaim",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_FantasyTeam_id(ctx, field)
+			case "name":
+				return ec.fieldContext_FantasyTeam_name(ctx, field)
+			case "draftOrderNumber":
+				return ec.fieldContext_FantasyTeam_draftOrderNumber(ctx, field)
+			case "isBot":
+				return ec.fieldContext_FantasyTeam_isBot(ctx, field)
+			case "waiverPriority":
+				return ec.fieldContext_FantasyTeam_waiverPriority(ctx, field)
+			case "faabRemaining":
+				return ec.fieldContext_FantasyTeam_faabRemaining(ctx, field)
+			case "roster":
+				return ec.fieldContext_FantasyTeam_roster(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FantasyTeam", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaiverClaim_addPlayer(ctx context.Context, field graphql.CollectedField, obj *model.WaiverClaim) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaiverClaim_addPlayer,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.WaiverClaim().AddPlayer(ctx, obj)
+		},
+		nil,
+		ec.marshalNPlayer2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayer,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaiverClaim_addPlayer(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaiverClaim",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Player_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Player_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Player_lastName(ctx, field)
+			case "fullName":
+				return ec.fieldContext_Player_fullName(ctx, field)
+			case "position":
+				return ec.fieldContext_Player_position(ctx, field)
+			case "team":
+				return ec.fieldContext_Player_team(ctx, field)
+			case "height":
+				return ec.fieldContext_Player_height(ctx, field)
+			case "weight":
+				return ec.fieldContext_Player_weight(ctx, field)
+			case "age":
+				return ec.fieldContext_Player_age(ctx, field)
+			case "yearsOfExperience":
+				return ec.fieldContext_Player_yearsOfExperience(ctx, field)
+			case "draftYear":
+				return ec.fieldContext_Player_draftYear(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "status":
+				return ec.fieldContext_Player_status(ctx, field)
+			case "skill":
+				return ec.fieldContext_Player_skill(ctx, field)
+			case "scoutedSkill":
+				return ec.fieldContext_Player_scoutedSkill(ctx, field)
+			case "draftRound":
+				return ec.fieldContext_Player_draftRound(ctx, field)
+			case "draftPick":
+				return ec.fieldContext_Player_draftPick(ctx, field)
+			case "depthChartRank":
+				return ec.fieldContext_Player_depthChartRank(ctx, field)
+			case "yearlyStats":
+				return ec.fieldContext_Player_yearlyStats(ctx, field)
+			case "injuries":
+				return ec.fieldContext_Player_injuries(ctx, field)
+			case "injuryRisk":
+				return ec.fieldContext_Player_injuryRisk(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaiverClaim_dropPlayer(ctx context.Context, field graphql.CollectedField, obj *model.WaiverClaim) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaiverClaim_dropPlayer,
+		func(ctx context.Context) (any, error) {
+			return ec.resolvers.WaiverClaim().DropPlayer(ctx, obj)
+		},
+		nil,
+		ec.marshalOPlayer2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayer,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaiverClaim_dropPlayer(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaiverClaim",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Player_id(ctx, field)
+			case "firstName":
+				return ec.fieldContext_Player_firstName(ctx, field)
+			case "lastName":
+				return ec.fieldContext_Player_lastName(ctx, field)
+			case "fullName":
+				return ec.fieldContext_Player_fullName(ctx, field)
+			case "position":
+				return ec.fieldContext_Player_position(ctx, field)
+			case "team":
+				return ec.fieldContext_Player_team(ctx, field)
+			case "height":
+				return ec.fieldContext_Player_height(ctx, field)
+			case "weight":
+				return ec.fieldContext_Player_weight(ctx, field)
+			case "age":
+				return ec.fieldContext_Player_age(ctx, field)
+			case "yearsOfExperience":
+				return ec.fieldContext_Player_yearsOfExperience(ctx, field)
+			case "draftYear":
+				return ec.fieldContext_Player_draftYear(ctx, field)
+			case "jerseyNumber":
+				return ec.fieldContext_Player_jerseyNumber(ctx, field)
+			case "status":
+				return ec.fieldContext_Player_status(ctx, field)
+			case "skill":
+				return ec.fieldContext_Player_skill(ctx, field)
+			case "scoutedSkill":
+				return ec.fieldContext_Player_scoutedSkill(ctx, field)
+			case "draftRound":
+				return ec.fieldContext_Player_draftRound(ctx, field)
+			case "draftPick":
+				return ec.fieldContext_Player_draftPick(ctx, field)
+			case "depthChartRank":
+				return ec.fieldContext_Player_depthChartRank(ctx, field)
+			case "yearlyStats":
+				return ec.fieldContext_Player_yearlyStats(ctx, field)
+			case "injuries":
+				return ec.fieldContext_Player_injuries(ctx, field)
+			case "injuryRisk":
+				return ec.fieldContext_Player_injuryRisk(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaiverClaim_bid(ctx context.Context, field graphql.CollectedField, obj *model.WaiverClaim) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaiverClaim_bid,
+		func(ctx context.Context) (any, error) {
+			return obj.Bid, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaiverClaim_bid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaiverClaim",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaiverClaim_status(ctx context.Context, field graphql.CollectedField, obj *model.WaiverClaim) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaiverClaim_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNWaiverClaimStatus2fantasyᚑdraftᚋgraphᚋmodelᚐWaiverClaimStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaiverClaim_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaiverClaim",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type WaiverClaimStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaiverClaim_failureReason(ctx context.Context, field graphql.CollectedField, obj *model.WaiverClaim) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaiverClaim_failureReason,
+		func(ctx context.Context) (any, error) {
+			return obj.FailureReason, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaiverClaim_failureReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaiverClaim",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaiverClaim_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.WaiverClaim) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaiverClaim_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaiverClaim_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaiverClaim",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaiverClaim_processedAt(ctx context.Context, field graphql.CollectedField, obj *model.WaiverClaim) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaiverClaim_processedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ProcessedAt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaiverClaim_processedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaiverClaim",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaiverSettings_mode(ctx context.Context, field graphql.CollectedField, obj *model.WaiverSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaiverSettings_mode,
+		func(ctx context.Context) (any, error) {
+			return obj.Mode, nil
+		},
+		nil,
+		ec.marshalNWaiverMode2fantasyᚑdraftᚋgraphᚋmodelᚐWaiverMode,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaiverSettings_mode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaiverSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type WaiverMode does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaiverSettings_faabBudget(ctx context.Context, field graphql.CollectedField, obj *model.WaiverSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaiverSettings_faabBudget,
+		func(ctx context.Context) (any, error) {
+			return obj.FaabBudget, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaiverSettings_faabBudget(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaiverSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaiverSettings_processDay(ctx context.Context, field graphql.CollectedField, obj *model.WaiverSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaiverSettings_processDay,
+		func(ctx context.Context) (any, error) {
+			return obj.ProcessDay, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaiverSettings_processDay(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaiverSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaiverSettings_processHour(ctx context.Context, field graphql.CollectedField, obj *model.WaiverSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaiverSettings_processHour,
+		func(ctx context.Context) (any, error) {
+			return obj.ProcessHour, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaiverSettings_processHour(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaiverSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaiverSettings_lastProcessedAt(ctx context.Context, field graphql.CollectedField, obj *model.WaiverSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaiverSettings_lastProcessedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.LastProcessedAt, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaiverSettings_lastProcessedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaiverSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _WaiverSettings_nextProcessAt(ctx context.Context, field graphql.CollectedField, obj *model.WaiverSettings) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_WaiverSettings_nextProcessAt,
+		func(ctx context.Context) (any, error) {
+			return obj.NextProcessAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_WaiverSettings_nextProcessAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "WaiverSettings",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _YearlyStat_id(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_YearlyStat_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_YearlyStat_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "YearlyStat",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _YearlyStat_year(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_YearlyStat_year,
+		func(ctx context.Context) (any, error) {
+			return obj.Year, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_YearlyStat_year(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "YearlyStat",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _YearlyStat_sportType(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_YearlyStat_sportType,
+		func(ctx context.Context) (any, error) {
+			return obj.SportType, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_YearlyStat_sportType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "YearlyStat",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _YearlyStat_stats(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_YearlyStat_stats,
+		func(ctx context.Context) (any, error) {
+			return obj.Stats, nil
+		},
+		nil,
+		ec.marshalOPlayerStats2fantasyᚑdraftᚋgraphᚋmodelᚐPlayerStats,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_YearlyStat_stats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "YearlyStat",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PlayerStats does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _YearlyStat_fantasyPoints(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_YearlyStat_fantasyPoints,
+		func(ctx context.Context) (any, error) {
+			return obj.FantasyPoints, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_YearlyStat_fantasyPoints(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "YearlyStat",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _YearlyStat_gamesPlayed(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_YearlyStat_gamesPlayed,
+		func(ctx context.Context) (any, error) {
+			return obj.GamesPlayed, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_YearlyStat_gamesPlayed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "YearlyStat",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _YearlyStat_fantasyPointsPerGame(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_YearlyStat_fantasyPointsPerGame,
+		func(ctx context.Context) (any, error) {
+			return obj.FantasyPointsPerGame, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_YearlyStat_fantasyPointsPerGame(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "YearlyStat",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _YearlyStat_age(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_YearlyStat_age,
+		func(ctx context.Context) (any, error) {
+			return obj.Age, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_YearlyStat_age(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "YearlyStat",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _YearlyStat_skill(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_YearlyStat_skill,
+		func(ctx context.Context) (any, error) {
+			return obj.Skill, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_YearlyStat_skill(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "YearlyStat",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_isRepeatable,
+		func(ctx context.Context) (any, error) {
+			return obj.IsRepeatable, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_locations,
+		func(ctx context.Context) (any, error) {
+			return obj.Locations, nil
+		},
+		nil,
+		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Directive_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___EnumValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_args,
+		func(ctx context.Context) (any, error) {
+			return obj.Args, nil
+		},
+		nil,
+		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Field_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_type,
+		func(ctx context.Context) (any, error) {
+			return obj.Type, nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_defaultValue,
+		func(ctx context.Context) (any, error) {
+			return obj.DefaultValue, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_isDeprecated,
+		func(ctx context.Context) (any, error) {
+			return obj.IsDeprecated(), nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___InputValue_deprecationReason,
+		func(ctx context.Context) (any, error) {
+			return obj.DeprecationReason(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_types,
+		func(ctx context.Context) (any, error) {
+			return obj.Types(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_queryType,
+		func(ctx context.Context) (any, error) {
+			return obj.QueryType(), nil
+		},
+		nil,
+		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_mutationType,
+		func(ctx context.Context) (any, error) {
+			return obj.MutationType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_subscriptionType,
+		func(ctx context.Context) (any, error) {
+			return obj.SubscriptionType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Schema_directives,
+		func(ctx context.Context) (any, error) {
+			return obj.Directives(), nil
+		},
+		nil,
+		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind(), nil
+		},
+		nil,
+		ec.marshalN__TypeKind2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_specifiedByURL,
+		func(ctx context.Context) (any, error) {
+			return obj.SpecifiedByURL(), nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_fields,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_interfaces,
+		func(ctx context.Context) (any, error) {
+			return obj.Interfaces(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_possibleTypes,
+		func(ctx context.Context) (any, error) {
+			return obj.PossibleTypes(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_enumValues,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		},
+		nil,
+		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_searchPlayers_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
-	return fc, nil
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_inputFields,
+		func(ctx context.Context) (any, error) {
+			return obj.InputFields(), nil
+		},
+		nil,
+		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_ofType,
+		func(ctx context.Context) (any, error) {
+			return obj.OfType(), nil
+		},
+		nil,
+		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "isOneOf":
+				return ec.fieldContext___Type_isOneOf(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext___Type_isOneOf,
+		func(ctx context.Context) (any, error) {
+			return obj.IsOneOf(), nil
+		},
+		nil,
+		ec.marshalOBoolean2bool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputLineupMoveInput(ctx context.Context, obj any) (model.LineupMoveInput, error) {
+	var it model.LineupMoveInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"playerId", "rosterSpot"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "playerId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("playerId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PlayerID = data
+		case "rosterSpot":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("rosterSpot"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RosterSpot = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPlayoffSettingsInput(ctx context.Context, obj any) (model.PlayoffSettingsInput, error) {
+	var it model.PlayoffSettingsInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"teams", "byes", "weeksPerRound", "tiebreakers"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "teams":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("teams"))
+			data, err := ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Teams = data
+		case "byes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("byes"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Byes = data
+		case "weeksPerRound":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("weeksPerRound"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.WeeksPerRound = data
+		case "tiebreakers":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tiebreakers"))
+			data, err := ec.unmarshalOSeedingTiebreaker2ᚕfantasyᚑdraftᚋgraphᚋmodelᚐSeedingTiebreakerᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Tiebreakers = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputTradeSettingsInput(ctx context.Context, obj any) (model.TradeSettingsInput, error) {
+	var it model.TradeSettingsInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"reviewHours", "vetoVotes"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "reviewHours":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reviewHours"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReviewHours = data
+		case "vetoVotes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("vetoVotes"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.VetoVotes = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputWaiverSettingsInput(ctx context.Context, obj any) (model.WaiverSettingsInput, error) {
+	var it model.WaiverSettingsInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"mode", "faabBudget", "processDay", "processHour"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "mode":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("mode"))
+			data, err := ec.unmarshalNWaiverMode2fantasyᚑdraftᚋgraphᚋmodelᚐWaiverMode(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Mode = data
+		case "faabBudget":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("faabBudget"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FaabBudget = data
+		case "processDay":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("processDay"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ProcessDay = data
+		case "processHour":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("processHour"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ProcessHour = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+func (ec *executionContext) _PlayerStats(ctx context.Context, sel ast.SelectionSet, obj model.PlayerStats) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.FootballStats:
+		return ec._FootballStats(ctx, sel, &obj)
+	case *model.FootballStats:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._FootballStats(ctx, sel, obj)
+	case model.BasketballStats:
+		return ec._BasketballStats(ctx, sel, &obj)
+	case *model.BasketballStats:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._BasketballStats(ctx, sel, obj)
+	case model.BaseballStats:
+		return ec._BaseballStats(ctx, sel, &obj)
+	case *model.BaseballStats:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._BaseballStats(ctx, sel, obj)
+	default:
+		if obj, ok := obj.(graphql.Marshaler); ok {
+			return obj
+		} else {
+			panic(fmt.Errorf("unexpected type %T; non-generated variants of PlayerStats must implement graphql.Marshaler", obj))
+		}
+	}
+}
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var baseballStatsImplementors = []string{"BaseballStats", "PlayerStats"}
+
+func (ec *executionContext) _BaseballStats(ctx context.Context, sel ast.SelectionSet, obj *model.BaseballStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, baseballStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BaseballStats")
+		case "atBats":
+			out.Values[i] = ec._BaseballStats_atBats(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hits":
+			out.Values[i] = ec._BaseballStats_hits(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "homeRuns":
+			out.Values[i] = ec._BaseballStats_homeRuns(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "runsBattedIn":
+			out.Values[i] = ec._BaseballStats_runsBattedIn(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "stolenBases":
+			out.Values[i] = ec._BaseballStats_stolenBases(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "battingAverage":
+			out.Values[i] = ec._BaseballStats_battingAverage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "outsPitched":
+			out.Values[i] = ec._BaseballStats_outsPitched(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "inningsPitched":
+			out.Values[i] = ec._BaseballStats_inningsPitched(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "strikeouts":
+			out.Values[i] = ec._BaseballStats_strikeouts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "earnedRuns":
+			out.Values[i] = ec._BaseballStats_earnedRuns(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hitsAllowed":
+			out.Values[i] = ec._BaseballStats_hitsAllowed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "walksAllowed":
+			out.Values[i] = ec._BaseballStats_walksAllowed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "saves":
+			out.Values[i] = ec._BaseballStats_saves(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "era":
+			out.Values[i] = ec._BaseballStats_era(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "whip":
+			out.Values[i] = ec._BaseballStats_whip(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var basketballStatsImplementors = []string{"BasketballStats", "PlayerStats"}
+
+func (ec *executionContext) _BasketballStats(ctx context.Context, sel ast.SelectionSet, obj *model.BasketballStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, basketballStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("BasketballStats")
+		case "points":
+			out.Values[i] = ec._BasketballStats_points(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rebounds":
+			out.Values[i] = ec._BasketballStats_rebounds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "assists":
+			out.Values[i] = ec._BasketballStats_assists(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "steals":
+			out.Values[i] = ec._BasketballStats_steals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "blocks":
+			out.Values[i] = ec._BasketballStats_blocks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "threePointersMade":
+			out.Values[i] = ec._BasketballStats_threePointersMade(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "turnovers":
+			out.Values[i] = ec._BasketballStats_turnovers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fieldGoalsMade":
+			out.Values[i] = ec._BasketballStats_fieldGoalsMade(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fieldGoalsAttempted":
+			out.Values[i] = ec._BasketballStats_fieldGoalsAttempted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "freeThrowsMade":
+			out.Values[i] = ec._BasketballStats_freeThrowsMade(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "freeThrowsAttempted":
+			out.Values[i] = ec._BasketballStats_freeThrowsAttempted(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var conferenceImplementors = []string{"Conference"}
+
+func (ec *executionContext) _Conference(ctx context.Context, sel ast.SelectionSet, obj *model.Conference) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, conferenceImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Conference")
+		case "id":
+			out.Values[i] = ec._Conference_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._Conference_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "divisions":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Conference_divisions(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var depthChartEntryImplementors = []string{"DepthChartEntry"}
+
+func (ec *executionContext) _DepthChartEntry(ctx context.Context, sel ast.SelectionSet, obj *model.DepthChartEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, depthChartEntryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DepthChartEntry")
+		case "position":
+			out.Values[i] = ec._DepthChartEntry_position(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "rank":
+			out.Values[i] = ec._DepthChartEntry_rank(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "player":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._DepthChartEntry_player(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var divisionImplementors = []string{"Division"}
+
+func (ec *executionContext) _Division(ctx context.Context, sel ast.SelectionSet, obj *model.Division) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, divisionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Division")
+		case "id":
+			out.Values[i] = ec._Division_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._Division_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "conference":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Division_conference(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "teams":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Division_teams(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var fantasySeasonImplementors = []string{"FantasySeason"}
+
+func (ec *executionContext) _FantasySeason(ctx context.Context, sel ast.SelectionSet, obj *model.FantasySeason) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fantasySeasonImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FantasySeason")
+		case "id":
+			out.Values[i] = ec._FantasySeason_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "draftRoomId":
+			out.Values[i] = ec._FantasySeason_draftRoomId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "year":
+			out.Values[i] = ec._FantasySeason_year(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "regularSeasonWeeks":
+			out.Values[i] = ec._FantasySeason_regularSeasonWeeks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "currentWeek":
+			out.Values[i] = ec._FantasySeason_currentWeek(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "playoffSettings":
+			out.Values[i] = ec._FantasySeason_playoffSettings(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "waiverSettings":
+			out.Values[i] = ec._FantasySeason_waiverSettings(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "tradeSettings":
+			out.Values[i] = ec._FantasySeason_tradeSettings(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "champion":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FantasySeason_champion(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var fantasyTeamImplementors = []string{"FantasyTeam"}
+
+func (ec *executionContext) _FantasyTeam(ctx context.Context, sel ast.SelectionSet, obj *model.FantasyTeam) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fantasyTeamImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FantasyTeam")
+		case "id":
+			out.Values[i] = ec._FantasyTeam_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "name":
+			out.Values[i] = ec._FantasyTeam_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "draftOrderNumber":
+			out.Values[i] = ec._FantasyTeam_draftOrderNumber(ctx, field, obj)
+		case "isBot":
+			out.Values[i] = ec._FantasyTeam_isBot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "waiverPriority":
+			out.Values[i] = ec._FantasyTeam_waiverPriority(ctx, field, obj)
+		case "faabRemaining":
+			out.Values[i] = ec._FantasyTeam_faabRemaining(ctx, field, obj)
+		case "roster":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FantasyTeam_roster(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var fantasyTransactionImplementors = []string{"FantasyTransaction"}
+
+func (ec *executionContext) _FantasyTransaction(ctx context.Context, sel ast.SelectionSet, obj *model.FantasyTransaction) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fantasyTransactionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FantasyTransaction")
+		case "id":
+			out.Values[i] = ec._FantasyTransaction_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "type":
+			out.Values[i] = ec._FantasyTransaction_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "team":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FantasyTransaction_team(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "player":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FantasyTransaction_player(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "faabAmount":
+			out.Values[i] = ec._FantasyTransaction_faabAmount(ctx, field, obj)
+		case "trade":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._FantasyTransaction_trade(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "createdAt":
+			out.Values[i] = ec._FantasyTransaction_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var footballStatsImplementors = []string{"FootballStats", "PlayerStats"}
+
+func (ec *executionContext) _FootballStats(ctx context.Context, sel ast.SelectionSet, obj *model.FootballStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, footballStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FootballStats")
+		case "passingAttempts":
+			out.Values[i] = ec._FootballStats_passingAttempts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "passingCompletions":
+			out.Values[i] = ec._FootballStats_passingCompletions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "passingYards":
+			out.Values[i] = ec._FootballStats_passingYards(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "passingTDs":
+			out.Values[i] = ec._FootballStats_passingTDs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "passingInterceptions":
+			out.Values[i] = ec._FootballStats_passingInterceptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rushingAttempts":
+			out.Values[i] = ec._FootballStats_rushingAttempts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rushingYards":
+			out.Values[i] = ec._FootballStats_rushingYards(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rushingTDs":
+			out.Values[i] = ec._FootballStats_rushingTDs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "receivingTargets":
+			out.Values[i] = ec._FootballStats_receivingTargets(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "receivingReceptions":
+			out.Values[i] = ec._FootballStats_receivingReceptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "receivingYards":
+			out.Values[i] = ec._FootballStats_receivingYards(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "receivingTDs":
+			out.Values[i] = ec._FootballStats_receivingTDs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fumbles":
+			out.Values[i] = ec._FootballStats_fumbles(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fumblesLost":
+			out.Values[i] = ec._FootballStats_fumblesLost(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fieldGoals":
+			out.Values[i] = ec._FootballStats_fieldGoals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fieldGoalsMade":
+			out.Values[i] = ec._FootballStats_fieldGoalsMade(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fieldGoalsMissed":
+			out.Values[i] = ec._FootballStats_fieldGoalsMissed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fieldGoalsUnder40":
+			out.Values[i] = ec._FootballStats_fieldGoalsUnder40(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fieldGoalsMadeUnder40":
+			out.Values[i] = ec._FootballStats_fieldGoalsMadeUnder40(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fieldGoals40To49":
+			out.Values[i] = ec._FootballStats_fieldGoals40To49(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fieldGoalsMade40To49":
+			out.Values[i] = ec._FootballStats_fieldGoalsMade40To49(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fieldGoals50Plus":
+			out.Values[i] = ec._FootballStats_fieldGoals50Plus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fieldGoalsMade50Plus":
+			out.Values[i] = ec._FootballStats_fieldGoalsMade50Plus(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "extraPoints":
+			out.Values[i] = ec._FootballStats_extraPoints(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "extraPointsMade":
+			out.Values[i] = ec._FootballStats_extraPointsMade(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "extraPointsMissed":
+			out.Values[i] = ec._FootballStats_extraPointsMissed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "tackles":
+			out.Values[i] = ec._FootballStats_tackles(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "assistedTackles":
+			out.Values[i] = ec._FootballStats_assistedTackles(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sacks":
+			out.Values[i] = ec._FootballStats_sacks(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "interceptions":
+			out.Values[i] = ec._FootballStats_interceptions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "passesDefended":
+			out.Values[i] = ec._FootballStats_passesDefended(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "forcedFumbles":
+			out.Values[i] = ec._FootballStats_forcedFumbles(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fumbleRecoveries":
+			out.Values[i] = ec._FootballStats_fumbleRecoveries(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "defensiveTDs":
+			out.Values[i] = ec._FootballStats_defensiveTDs(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pointsAllowed":
+			out.Values[i] = ec._FootballStats_pointsAllowed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "yardsAllowed":
+			out.Values[i] = ec._FootballStats_yardsAllowed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var injuryImplementors = []string{"Injury"}
+
+func (ec *executionContext) _Injury(ctx context.Context, sel ast.SelectionSet, obj *model.Injury) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, injuryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Injury")
+		case "id":
+			out.Values[i] = ec._Injury_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "year":
+			out.Values[i] = ec._Injury_year(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "week":
+			out.Values[i] = ec._Injury_week(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "gamesMissed":
+			out.Values[i] = ec._Injury_gamesMissed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "injuryType":
+			out.Values[i] = ec._Injury_injuryType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "severity":
+			out.Values[i] = ec._Injury_severity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var matchupImplementors = []string{"Matchup"}
+
+func (ec *executionContext) _Matchup(ctx context.Context, sel ast.SelectionSet, obj *model.Matchup) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, matchupImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Matchup")
+		case "id":
+			out.Values[i] = ec._Matchup_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "week":
+			out.Values[i] = ec._Matchup_week(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "homeTeam":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Matchup_homeTeam(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "awayTeam":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Matchup_awayTeam(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "homeScore":
+			out.Values[i] = ec._Matchup_homeScore(ctx, field, obj)
+		case "awayScore":
+			out.Values[i] = ec._Matchup_awayScore(ctx, field, obj)
+		case "isComplete":
+			out.Values[i] = ec._Matchup_isComplete(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "winner":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Matchup_winner(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "generateSchedule":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_generateSchedule(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "simulateWeek":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_simulateWeek(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setLineup":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setLineup(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "optimizeLineup":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_optimizeLineup(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "submitWaiverClaim":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_submitWaiverClaim(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cancelWaiverClaim":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_cancelWaiverClaim(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "addFreeAgent":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addFreeAgent(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "dropPlayer":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_dropPlayer(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "processWaivers":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_processWaivers(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "proposeTrade":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_proposeTrade(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "counterTrade":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_counterTrade(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "acceptTrade":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_acceptTrade(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rejectTrade":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_rejectTrade(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cancelTrade":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_cancelTrade(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "voteToVetoTrade":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_voteToVetoTrade(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "approveTrade":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_approveTrade(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "vetoTrade":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_vetoTrade(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playerImplementors = []string{"Player"}
+
+func (ec *executionContext) _Player(ctx context.Context, sel ast.SelectionSet, obj *model.Player) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playerImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Player")
+		case "id":
+			out.Values[i] = ec._Player_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "firstName":
+			out.Values[i] = ec._Player_firstName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "lastName":
+			out.Values[i] = ec._Player_lastName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "fullName":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Player_fullName(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "position":
+			out.Values[i] = ec._Player_position(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "team":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Player_team(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "height":
+			out.Values[i] = ec._Player_height(ctx, field, obj)
+		case "weight":
+			out.Values[i] = ec._Player_weight(ctx, field, obj)
+		case "age":
+			out.Values[i] = ec._Player_age(ctx, field, obj)
+		case "yearsOfExperience":
+			out.Values[i] = ec._Player_yearsOfExperience(ctx, field, obj)
+		case "draftYear":
+			out.Values[i] = ec._Player_draftYear(ctx, field, obj)
+		case "jerseyNumber":
+			out.Values[i] = ec._Player_jerseyNumber(ctx, field, obj)
+		case "status":
+			out.Values[i] = ec._Player_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "skill":
+			out.Values[i] = ec._Player_skill(ctx, field, obj)
+		case "scoutedSkill":
+			out.Values[i] = ec._Player_scoutedSkill(ctx, field, obj)
+		case "draftRound":
+			out.Values[i] = ec._Player_draftRound(ctx, field, obj)
+		case "draftPick":
+			out.Values[i] = ec._Player_draftPick(ctx, field, obj)
+		case "depthChartRank":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Player_depthChartRank(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "yearlyStats":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Player_yearlyStats(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "injuries":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Player_injuries(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "injuryRisk":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Player_injuryRisk(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playoffBracketImplementors = []string{"PlayoffBracket"}
+
+func (ec *executionContext) _PlayoffBracket(ctx context.Context, sel ast.SelectionSet, obj *model.PlayoffBracket) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playoffBracketImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlayoffBracket")
+		case "rounds":
+			out.Values[i] = ec._PlayoffBracket_rounds(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "games":
+			out.Values[i] = ec._PlayoffBracket_games(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "final":
+			out.Values[i] = ec._PlayoffBracket_final(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "champion":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._PlayoffBracket_champion(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query___type,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.introspectType(fc.Args["name"].(string))
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
+var playoffGameImplementors = []string{"PlayoffGame"}
+
+func (ec *executionContext) _PlayoffGame(ctx context.Context, sel ast.SelectionSet, obj *model.PlayoffGame) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playoffGameImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlayoffGame")
+		case "id":
+			out.Values[i] = ec._PlayoffGame_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "round":
+			out.Values[i] = ec._PlayoffGame_round(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "bracketPosition":
+			out.Values[i] = ec._PlayoffGame_bracketPosition(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "homeSeed":
+			out.Values[i] = ec._PlayoffGame_homeSeed(ctx, field, obj)
+		case "awaySeed":
+			out.Values[i] = ec._PlayoffGame_awaySeed(ctx, field, obj)
+		case "homeTeam":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._PlayoffGame_homeTeam(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "awayTeam":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._PlayoffGame_awayTeam(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "homeScore":
+			out.Values[i] = ec._PlayoffGame_homeScore(ctx, field, obj)
+		case "awayScore":
+			out.Values[i] = ec._PlayoffGame_awayScore(ctx, field, obj)
+		case "isComplete":
+			out.Values[i] = ec._PlayoffGame_isComplete(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "winner":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._PlayoffGame_winner(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "homeSource":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._PlayoffGame_homeSource(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "awaySource":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._PlayoffGame_awaySource(ctx, field, obj)
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var playoffSettingsImplementors = []string{"PlayoffSettings"}
+
+func (ec *executionContext) _PlayoffSettings(ctx context.Context, sel ast.SelectionSet, obj *model.PlayoffSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, playoffSettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PlayoffSettings")
+		case "teams":
+			out.Values[i] = ec._PlayoffSettings_teams(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "byes":
+			out.Values[i] = ec._PlayoffSettings_byes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "weeksPerRound":
+			out.Values[i] = ec._PlayoffSettings_weeksPerRound(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "tiebreakers":
+			out.Values[i] = ec._PlayoffSettings_tiebreakers(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "universes":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_universes(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "conferences":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_conferences(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "conference":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_conference(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "divisions":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_divisions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "division":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_division(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "teams":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_teams(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "team":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_team(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "players":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_players(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "player":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_player(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "searchPlayers":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_searchPlayers(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Query___schema,
-		func(ctx context.Context) (any, error) {
-			return ec.introspectSchema()
-		},
-		nil,
-		ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema,
-		true,
-		false,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "fantasySeason":
+			field := field
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Query",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_fantasySeason(ctx, field)
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Team_id(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Team_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext_Team_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Team",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "standings":
+			field := field
 
-func (ec *executionContext) _Team_city(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Team_city,
-		func(ctx context.Context) (any, error) {
-			return obj.City, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_standings(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext_Team_city(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Team",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _Team_state(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Team_state,
-		func(ctx context.Context) (any, error) {
-			return obj.State, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "rotoStandings":
+			field := field
 
-func (ec *executionContext) fieldContext_Team_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Team",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_rotoStandings(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) _Team_name(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Team_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext_Team_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Team",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "matchups":
+			field := field
 
-func (ec *executionContext) _Team_abbreviation(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Team_abbreviation,
-		func(ctx context.Context) (any, error) {
-			return obj.Abbreviation, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_matchups(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext_Team_abbreviation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Team",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _Team_division(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Team_division,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Team().Division(ctx, obj)
-		},
-		nil,
-		ec.marshalNDivision2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐDivision,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "playoffBracket":
+			field := field
 
-func (ec *executionContext) fieldContext_Team_division(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Team",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Division_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Division_name(ctx, field)
-			case "conference":
-				return ec.fieldContext_Division_conference(ctx, field)
-			case "teams":
-				return ec.fieldContext_Division_teams(ctx, field)
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_playoffBracket(ctx, field)
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Division", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _Team_players(ctx context.Context, field graphql.CollectedField, obj *model.Team) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_Team_players,
-		func(ctx context.Context) (any, error) {
-			return ec.resolvers.Team().Players(ctx, obj)
-		},
-		nil,
-		ec.marshalNPlayer2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayerᚄ,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext_Team_players(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "Team",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Player_id(ctx, field)
-			case "firstName":
-				return ec.fieldContext_Player_firstName(ctx, field)
-			case "lastName":
-				return ec.fieldContext_Player_lastName(ctx, field)
-			case "fullName":
-				return ec.fieldContext_Player_fullName(ctx, field)
-			case "position":
-				return ec.fieldContext_Player_position(ctx, field)
-			case "team":
-				return ec.fieldContext_Player_team(ctx, field)
-			case "height":
-				return ec.fieldContext_Player_height(ctx, field)
-			case "weight":
-				return ec.fieldContext_Player_weight(ctx, field)
-			case "age":
-				return ec.fieldContext_Player_age(ctx, field)
-			case "yearsOfExperience":
-				return ec.fieldContext_Player_yearsOfExperience(ctx, field)
-			case "draftYear":
-				return ec.fieldContext_Player_draftYear(ctx, field)
-			case "jerseyNumber":
-				return ec.fieldContext_Player_jerseyNumber(ctx, field)
-			case "status":
-				return ec.fieldContext_Player_status(ctx, field)
-			case "skill":
-				return ec.fieldContext_Player_skill(ctx, field)
-			case "yearlyStats":
-				return ec.fieldContext_Player_yearlyStats(ctx, field)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "freeAgents":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_freeAgents(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Player", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) _YearlyStat_id(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_YearlyStat_id,
-		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
-		},
-		nil,
-		ec.marshalNID2string,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext_YearlyStat_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "YearlyStat",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "waiverClaims":
+			field := field
 
-func (ec *executionContext) _YearlyStat_year(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_YearlyStat_year,
-		func(ctx context.Context) (any, error) {
-			return obj.Year, nil
-		},
-		nil,
-		ec.marshalNInt2int,
-		true,
-		true,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_waiverClaims(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext_YearlyStat_year(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "YearlyStat",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _YearlyStat_sportType(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_YearlyStat_sportType,
-		func(ctx context.Context) (any, error) {
-			return obj.SportType, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "transactions":
+			field := field
 
-func (ec *executionContext) fieldContext_YearlyStat_sportType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "YearlyStat",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_transactions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) _YearlyStat_stats(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_YearlyStat_stats,
-		func(ctx context.Context) (any, error) {
-			return obj.Stats, nil
-		},
-		nil,
-		ec.marshalOFootballStats2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFootballStats,
-		true,
-		false,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "trades":
+			field := field
 
-func (ec *executionContext) fieldContext_YearlyStat_stats(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "YearlyStat",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "passingAttempts":
-				return ec.fieldContext_FootballStats_passingAttempts(ctx, field)
-			case "passingCompletions":
-				return ec.fieldContext_FootballStats_passingCompletions(ctx, field)
-			case "passingYards":
-				return ec.fieldContext_FootballStats_passingYards(ctx, field)
-			case "passingTDs":
-				return ec.fieldContext_FootballStats_passingTDs(ctx, field)
-			case "passingInterceptions":
-				return ec.fieldContext_FootballStats_passingInterceptions(ctx, field)
-			case "rushingAttempts":
-				return ec.fieldContext_FootballStats_rushingAttempts(ctx, field)
-			case "rushingYards":
-				return ec.fieldContext_FootballStats_rushingYards(ctx, field)
-			case "rushingTDs":
-				return ec.fieldContext_FootballStats_rushingTDs(ctx, field)
-			case "receivingTargets":
-				return ec.fieldContext_FootballStats_receivingTargets(ctx, field)
-			case "receivingReceptions":
-				return ec.fieldContext_FootballStats_receivingReceptions(ctx, field)
-			case "receivingYards":
-				return ec.fieldContext_FootballStats_receivingYards(ctx, field)
-			case "receivingTDs":
-				return ec.fieldContext_FootballStats_receivingTDs(ctx, field)
-			case "fumbles":
-				return ec.fieldContext_FootballStats_fumbles(ctx, field)
-			case "fumblesLost":
-				return ec.fieldContext_FootballStats_fumblesLost(ctx, field)
-			case "fieldGoals":
-				return ec.fieldContext_FootballStats_fieldGoals(ctx, field)
-			case "fieldGoalsMade":
-				return ec.fieldContext_FootballStats_fieldGoalsMade(ctx, field)
-			case "fieldGoalsMissed":
-				return ec.fieldContext_FootballStats_fieldGoalsMissed(ctx, field)
-			case "extraPoints":
-				return ec.fieldContext_FootballStats_extraPoints(ctx, field)
-			case "extraPointsMade":
-				return ec.fieldContext_FootballStats_extraPointsMade(ctx, field)
-			case "extraPointsMissed":
-				return ec.fieldContext_FootballStats_extraPointsMissed(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type FootballStats", field.Name)
-		},
-	}
-	return fc, nil
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_trades(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) _YearlyStat_fantasyPoints(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_YearlyStat_fantasyPoints,
-		func(ctx context.Context) (any, error) {
-			return obj.FantasyPoints, nil
-		},
-		nil,
-		ec.marshalNFloat2float64,
-		true,
-		true,
-	)
-}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) fieldContext_YearlyStat_fantasyPoints(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "YearlyStat",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "trade":
+			field := field
 
-func (ec *executionContext) _YearlyStat_gamesPlayed(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_YearlyStat_gamesPlayed,
-		func(ctx context.Context) (any, error) {
-			return obj.GamesPlayed, nil
-		},
-		nil,
-		ec.marshalOInt2ᚖint,
-		true,
-		false,
-	)
-}
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_trade(ctx, field)
+				return res
+			}
 
-func (ec *executionContext) fieldContext_YearlyStat_gamesPlayed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "YearlyStat",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "__type":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+		case "__schema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) _YearlyStat_fantasyPointsPerGame(ctx context.Context, field graphql.CollectedField, obj *model.YearlyStat) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_YearlyStat_fantasyPointsPerGame,
-		func(ctx context.Context) (any, error) {
-			return obj.FantasyPointsPerGame, nil
-		},
-		nil,
-		ec.marshalOFloat2ᚖfloat64,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext_YearlyStat_fantasyPointsPerGame(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "YearlyStat",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var rosterEntryImplementors = []string{"RosterEntry"}
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+func (ec *executionContext) _RosterEntry(ctx context.Context, sel ast.SelectionSet, obj *model.RosterEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, rosterEntryImplementors)
 
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RosterEntry")
+		case "player":
+			field := field
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_isRepeatable,
-		func(ctx context.Context) (any, error) {
-			return obj.IsRepeatable, nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RosterEntry_player(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "rosterSpot":
+			out.Values[i] = ec._RosterEntry_rosterSpot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_locations,
-		func(ctx context.Context) (any, error) {
-			return obj.Locations, nil
-		},
-		nil,
-		ec.marshalN__DirectiveLocation2ᚕstringᚄ,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Directive_args,
-		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
-		},
-		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Directive_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Directive",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+var rotoCategoryScoreImplementors = []string{"RotoCategoryScore"}
+
+func (ec *executionContext) _RotoCategoryScore(ctx context.Context, sel ast.SelectionSet, obj *model.RotoCategoryScore) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, rotoCategoryScoreImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RotoCategoryScore")
+		case "category":
+			out.Values[i] = ec._RotoCategoryScore_category(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+		case "value":
+			out.Values[i] = ec._RotoCategoryScore_value(ctx, field, obj)
+		case "points":
+			out.Values[i] = ec._RotoCategoryScore_points(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Directive_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var rotoStandingImplementors = []string{"RotoStanding"}
 
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_isDeprecated,
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
-}
+func (ec *executionContext) _RotoStanding(ctx context.Context, sel ast.SelectionSet, obj *model.RotoStanding) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, rotoStandingImplementors)
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RotoStanding")
+		case "rank":
+			out.Values[i] = ec._RotoStanding_rank(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "team":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._RotoStanding_team(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "points":
+			out.Values[i] = ec._RotoStanding_points(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "categories":
+			out.Values[i] = ec._RotoStanding_categories(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___EnumValue_deprecationReason,
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var standingImplementors = []string{"Standing"}
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+func (ec *executionContext) _Standing(ctx context.Context, sel ast.SelectionSet, obj *model.Standing) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, standingImplementors)
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Standing")
+		case "rank":
+			out.Values[i] = ec._Standing_rank(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "team":
+			field := field
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_args,
-		func(ctx context.Context) (any, error) {
-			return obj.Args, nil
-		},
-		nil,
-		ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		true,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Standing_team(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext___Field_args(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "wins":
+			out.Values[i] = ec._Standing_wins(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "losses":
+			out.Values[i] = ec._Standing_losses(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "ties":
+			out.Values[i] = ec._Standing_ties(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "pointsFor":
+			out.Values[i] = ec._Standing_pointsFor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "pointsAgainst":
+			out.Values[i] = ec._Standing_pointsAgainst(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Field_args_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
 	}
-	return fc, nil
-}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_type,
-		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
-		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		true,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_isDeprecated,
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var teamImplementors = []string{"Team"}
 
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Field_deprecationReason,
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+func (ec *executionContext) _Team(ctx context.Context, sel ast.SelectionSet, obj *model.Team) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, teamImplementors)
 
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Team")
+		case "id":
+			out.Values[i] = ec._Team_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "city":
+			out.Values[i] = ec._Team_city(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "state":
+			out.Values[i] = ec._Team_state(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._Team_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "abbreviation":
+			out.Values[i] = ec._Team_abbreviation(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "sportType":
+			out.Values[i] = ec._Team_sportType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "division":
+			field := field
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
-		},
-		nil,
-		ec.marshalNString2string,
-		true,
-		true,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Team_division(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "players":
+			field := field
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_type,
-		func(ctx context.Context) (any, error) {
-			return obj.Type, nil
-		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		true,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Team_players(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "depthChart":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Team_depthChart(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_defaultValue,
-		func(ctx context.Context) (any, error) {
-			return obj.DefaultValue, nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___InputValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_isDeprecated,
-		func(ctx context.Context) (any, error) {
-			return obj.IsDeprecated(), nil
-		},
-		nil,
-		ec.marshalNBoolean2bool,
-		true,
-		true,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___InputValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var tradeImplementors = []string{"Trade"}
 
-func (ec *executionContext) ___InputValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___InputValue_deprecationReason,
-		func(ctx context.Context) (any, error) {
-			return obj.DeprecationReason(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+func (ec *executionContext) _Trade(ctx context.Context, sel ast.SelectionSet, obj *model.Trade) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, tradeImplementors)
 
-func (ec *executionContext) fieldContext___InputValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Trade")
+		case "id":
+			out.Values[i] = ec._Trade_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "proposingTeam":
+			field := field
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Trade_proposingTeam(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "receivingTeam":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Trade_receivingTeam(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "status":
+			out.Values[i] = ec._Trade_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "players":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Trade_players(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_types,
-		func(ctx context.Context) (any, error) {
-			return obj.Types(), nil
-		},
-		nil,
-		ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		true,
-	)
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_queryType,
-		func(ctx context.Context) (any, error) {
-			return obj.QueryType(), nil
-		},
-		nil,
-		ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "counterOf":
+			field := field
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Trade_counterOf(ctx, field, obj)
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_mutationType,
-		func(ctx context.Context) (any, error) {
-			return obj.MutationType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_subscriptionType,
-		func(ctx context.Context) (any, error) {
-			return obj.SubscriptionType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "vetoVotes":
+			field := field
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Trade_vetoVotes(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Schema_directives,
-		func(ctx context.Context) (any, error) {
-			return obj.Directives(), nil
-		},
-		nil,
-		ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ,
-		true,
-		true,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "vetoVotesRequired":
+			field := field
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Schema",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Trade_vetoVotesRequired(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_kind,
-		func(ctx context.Context) (any, error) {
-			return obj.Kind(), nil
-		},
-		nil,
-		ec.marshalN__TypeKind2string,
-		true,
-		true,
-	)
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
-		},
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "reviewEndsAt":
+			out.Values[i] = ec._Trade_reviewEndsAt(ctx, field, obj)
+		case "failureReason":
+			out.Values[i] = ec._Trade_failureReason(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._Trade_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "resolvedAt":
+			out.Values[i] = ec._Trade_resolvedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_name,
-		func(ctx context.Context) (any, error) {
-			return obj.Name(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_description,
-		func(ctx context.Context) (any, error) {
-			return obj.Description(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+var tradePlayerImplementors = []string{"TradePlayer"}
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_specifiedByURL,
-		func(ctx context.Context) (any, error) {
-			return obj.SpecifiedByURL(), nil
-		},
-		nil,
-		ec.marshalOString2ᚖstring,
-		true,
-		false,
-	)
-}
+func (ec *executionContext) _TradePlayer(ctx context.Context, sel ast.SelectionSet, obj *model.TradePlayer) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, tradePlayerImplementors)
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TradePlayer")
+		case "player":
+			field := field
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_fields,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
-		},
-		nil,
-		ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ,
-		true,
-		false,
-	)
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._TradePlayer_player(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_interfaces,
-		func(ctx context.Context) (any, error) {
-			return obj.Interfaces(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		false,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "fromTeam":
+			field := field
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._TradePlayer_fromTeam(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_possibleTypes,
-		func(ctx context.Context) (any, error) {
-			return obj.PossibleTypes(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ,
-		true,
-		false,
-	)
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_enumValues,
-		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
-		},
-		nil,
-		ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ,
-		true,
-		false,
-	)
-}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "toTeam":
+			field := field
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._TradePlayer_toTeam(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
-		},
-	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_inputFields,
-		func(ctx context.Context) (any, error) {
-			return obj.InputFields(), nil
-		},
-		nil,
-		ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ,
-		true,
-		false,
-	)
-}
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
 
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___InputValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___InputValue_deprecationReason(ctx, field)
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
-		},
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_ofType,
-		func(ctx context.Context) (any, error) {
-			return obj.OfType(), nil
-		},
-		nil,
-		ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType,
-		true,
-		false,
-	)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "isOneOf":
-				return ec.fieldContext___Type_isOneOf(ctx, field)
+var tradeSettingsImplementors = []string{"TradeSettings"}
+
+func (ec *executionContext) _TradeSettings(ctx context.Context, sel ast.SelectionSet, obj *model.TradeSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, tradeSettingsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TradeSettings")
+		case "reviewHours":
+			out.Values[i] = ec._TradeSettings_reviewHours(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
-		},
+		case "vetoVotes":
+			out.Values[i] = ec._TradeSettings_vetoVotes(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
-	return fc, nil
-}
 
-func (ec *executionContext) ___Type_isOneOf(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext___Type_isOneOf,
-		func(ctx context.Context) (any, error) {
-			return obj.IsOneOf(), nil
-		},
-		nil,
-		ec.marshalOBoolean2bool,
-		true,
-		false,
-	)
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) fieldContext___Type_isOneOf(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Type",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-	return fc, nil
+
+	return out
 }
 
-// endregion **************************** field.gotpl *****************************
+var universeImplementors = []string{"Universe"}
 
-// region    **************************** input.gotpl *****************************
+func (ec *executionContext) _Universe(ctx context.Context, sel ast.SelectionSet, obj *model.Universe) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, universeImplementors)
 
-// endregion **************************** input.gotpl *****************************
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Universe")
+		case "id":
+			out.Values[i] = ec._Universe_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._Universe_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-// region    ************************** interface.gotpl ***************************
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-// endregion ************************** interface.gotpl ***************************
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-// region    **************************** object.gotpl ****************************
+	return out
+}
 
-var conferenceImplementors = []string{"Conference"}
+var waiverClaimImplementors = []string{"WaiverClaim"}
 
-func (ec *executionContext) _Conference(ctx context.Context, sel ast.SelectionSet, obj *model.Conference) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, conferenceImplementors)
+func (ec *executionContext) _WaiverClaim(ctx context.Context, sel ast.SelectionSet, obj *model.WaiverClaim) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, waiverClaimImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Conference")
+			out.Values[i] = graphql.MarshalString("WaiverClaim")
 		case "id":
-			out.Values[i] = ec._Conference_id(ctx, field, obj)
+			out.Values[i] = ec._WaiverClaim_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&out.Invalids, 1)
 			}
-		case "name":
-			out.Values[i] = ec._Conference_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+		case "team":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._WaiverClaim_team(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "addPlayer":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._WaiverClaim_addPlayer(ctx, field, obj)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			if field.Deferrable != nil {
+				dfs, ok := deferred[field.Deferrable.Label]
+				di := 0
+				if ok {
+					dfs.AddField(field)
+					di = len(dfs.Values) - 1
+				} else {
+					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
+					deferred[field.Deferrable.Label] = dfs
+				}
+				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
+					return innerFunc(ctx, dfs)
+				})
+
+				// don't run the out.Concurrently() call below
+				out.Values[i] = graphql.Null
+				continue
 			}
-		case "divisions":
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "dropPlayer":
 			field := field
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
 				defer func() {
 					if r := recover(); r != nil {
 						ec.Error(ctx, ec.Recover(ctx, r))
 					}
 				}()
-				res = ec._Conference_divisions(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
+				res = ec._WaiverClaim_dropPlayer(ctx, field, obj)
 				return res
 			}
 
@@ -4760,6 +17969,25 @@ func (ec *executionContext) _Conference(ctx context.Context, sel ast.SelectionSe
 			}
 
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "bid":
+			out.Values[i] = ec._WaiverClaim_bid(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "status":
+			out.Values[i] = ec._WaiverClaim_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "failureReason":
+			out.Values[i] = ec._WaiverClaim_failureReason(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._WaiverClaim_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&out.Invalids, 1)
+			}
+		case "processedAt":
+			out.Values[i] = ec._WaiverClaim_processedAt(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4783,99 +18011,212 @@ func (ec *executionContext) _Conference(ctx context.Context, sel ast.SelectionSe
 	return out
 }
 
-var divisionImplementors = []string{"Division"}
+var waiverSettingsImplementors = []string{"WaiverSettings"}
 
-func (ec *executionContext) _Division(ctx context.Context, sel ast.SelectionSet, obj *model.Division) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, divisionImplementors)
+func (ec *executionContext) _WaiverSettings(ctx context.Context, sel ast.SelectionSet, obj *model.WaiverSettings) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, waiverSettingsImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Division")
-		case "id":
-			out.Values[i] = ec._Division_id(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("WaiverSettings")
+		case "mode":
+			out.Values[i] = ec._WaiverSettings_mode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "name":
-			out.Values[i] = ec._Division_name(ctx, field, obj)
+		case "faabBudget":
+			out.Values[i] = ec._WaiverSettings_faabBudget(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "conference":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Division_conference(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+		case "processDay":
+			out.Values[i] = ec._WaiverSettings_processDay(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "processHour":
+			out.Values[i] = ec._WaiverSettings_processHour(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lastProcessedAt":
+			out.Values[i] = ec._WaiverSettings_lastProcessedAt(ctx, field, obj)
+		case "nextProcessAt":
+			out.Values[i] = ec._WaiverSettings_nextProcessAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var yearlyStatImplementors = []string{"YearlyStat"}
+
+func (ec *executionContext) _YearlyStat(ctx context.Context, sel ast.SelectionSet, obj *model.YearlyStat) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, yearlyStatImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("YearlyStat")
+		case "id":
+			out.Values[i] = ec._YearlyStat_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "year":
+			out.Values[i] = ec._YearlyStat_year(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sportType":
+			out.Values[i] = ec._YearlyStat_sportType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		case "stats":
+			out.Values[i] = ec._YearlyStat_stats(ctx, field, obj)
+		case "fantasyPoints":
+			out.Values[i] = ec._YearlyStat_fantasyPoints(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "gamesPlayed":
+			out.Values[i] = ec._YearlyStat_gamesPlayed(ctx, field, obj)
+		case "fantasyPointsPerGame":
+			out.Values[i] = ec._YearlyStat_fantasyPointsPerGame(ctx, field, obj)
+		case "age":
+			out.Values[i] = ec._YearlyStat_age(ctx, field, obj)
+		case "skill":
+			out.Values[i] = ec._YearlyStat_skill(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "teams":
-			field := field
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Division_teams(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __DirectiveImplementors = []string{"__Directive"}
+
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "isRepeatable":
+			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __EnumValueImplementors = []string{"__EnumValue"}
+
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -4899,117 +18240,41 @@ func (ec *executionContext) _Division(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var footballStatsImplementors = []string{"FootballStats"}
+var __FieldImplementors = []string{"__Field"}
 
-func (ec *executionContext) _FootballStats(ctx context.Context, sel ast.SelectionSet, obj *model.FootballStats) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, footballStatsImplementors)
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("FootballStats")
-		case "passingAttempts":
-			out.Values[i] = ec._FootballStats_passingAttempts(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "passingCompletions":
-			out.Values[i] = ec._FootballStats_passingCompletions(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "passingYards":
-			out.Values[i] = ec._FootballStats_passingYards(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "passingTDs":
-			out.Values[i] = ec._FootballStats_passingTDs(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "passingInterceptions":
-			out.Values[i] = ec._FootballStats_passingInterceptions(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "rushingAttempts":
-			out.Values[i] = ec._FootballStats_rushingAttempts(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "rushingYards":
-			out.Values[i] = ec._FootballStats_rushingYards(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "rushingTDs":
-			out.Values[i] = ec._FootballStats_rushingTDs(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "receivingTargets":
-			out.Values[i] = ec._FootballStats_receivingTargets(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "receivingReceptions":
-			out.Values[i] = ec._FootballStats_receivingReceptions(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "receivingYards":
-			out.Values[i] = ec._FootballStats_receivingYards(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "receivingTDs":
-			out.Values[i] = ec._FootballStats_receivingTDs(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "fumbles":
-			out.Values[i] = ec._FootballStats_fumbles(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "fumblesLost":
-			out.Values[i] = ec._FootballStats_fumblesLost(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "fieldGoals":
-			out.Values[i] = ec._FootballStats_fieldGoals(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "fieldGoalsMade":
-			out.Values[i] = ec._FootballStats_fieldGoalsMade(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "fieldGoalsMissed":
-			out.Values[i] = ec._FootballStats_fieldGoalsMissed(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "extraPoints":
-			out.Values[i] = ec._FootballStats_extraPoints(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "extraPointsMade":
-			out.Values[i] = ec._FootballStats_extraPointsMade(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "extraPointsMissed":
-			out.Values[i] = ec._FootballStats_extraPointsMissed(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5033,164 +18298,93 @@ func (ec *executionContext) _FootballStats(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var playerImplementors = []string{"Player"}
+var __InputValueImplementors = []string{"__InputValue"}
 
-func (ec *executionContext) _Player(ctx context.Context, sel ast.SelectionSet, obj *model.Player) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, playerImplementors)
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Player")
-		case "id":
-			out.Values[i] = ec._Player_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "firstName":
-			out.Values[i] = ec._Player_firstName(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__InputValue")
+		case "name":
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
+				out.Invalids++
 			}
-		case "lastName":
-			out.Values[i] = ec._Player_lastName(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
+		case "type":
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "fullName":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Player_fullName(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "position":
-			out.Values[i] = ec._Player_position(ctx, field, obj)
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "team":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Player_team(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+				out.Invalids++
 			}
+		case "deprecationReason":
+			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "height":
-			out.Values[i] = ec._Player_height(ctx, field, obj)
-		case "weight":
-			out.Values[i] = ec._Player_weight(ctx, field, obj)
-		case "age":
-			out.Values[i] = ec._Player_age(ctx, field, obj)
-		case "yearsOfExperience":
-			out.Values[i] = ec._Player_yearsOfExperience(ctx, field, obj)
-		case "draftYear":
-			out.Values[i] = ec._Player_draftYear(ctx, field, obj)
-		case "jerseyNumber":
-			out.Values[i] = ec._Player_jerseyNumber(ctx, field, obj)
-		case "status":
-			out.Values[i] = ec._Player_status(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "skill":
-			out.Values[i] = ec._Player_skill(ctx, field, obj)
-		case "yearlyStats":
-			field := field
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Player_yearlyStats(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	return out
+}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+var __SchemaImplementors = []string{"__Schema"}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "description":
+			out.Values[i] = ec.___Schema_description(ctx, field, obj)
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5214,786 +18408,1073 @@ func (ec *executionContext) _Player(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
-var queryImplementors = []string{"Query"}
+var __TypeImplementors = []string{"__Type"}
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "conferences":
-			field := field
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "specifiedByURL":
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		case "isOneOf":
+			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_conferences(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) marshalNConference2fantasyᚑdraftᚋgraphᚋmodelᚐConference(ctx context.Context, sel ast.SelectionSet, v model.Conference) graphql.Marshaler {
+	return ec._Conference(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNConference2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐConferenceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Conference) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNConference2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐConference(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNConference2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐConference(ctx context.Context, sel ast.SelectionSet, v *model.Conference) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Conference(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNDepthChartEntry2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐDepthChartEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DepthChartEntry) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNDepthChartEntry2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐDepthChartEntry(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+func (ec *executionContext) marshalNDepthChartEntry2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐDepthChartEntry(ctx context.Context, sel ast.SelectionSet, v *model.DepthChartEntry) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DepthChartEntry(ctx, sel, v)
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "conference":
-			field := field
+func (ec *executionContext) marshalNDivision2fantasyᚑdraftᚋgraphᚋmodelᚐDivision(ctx context.Context, sel ast.SelectionSet, v model.Division) graphql.Marshaler {
+	return ec._Division(ctx, sel, &v)
+}
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_conference(ctx, field)
-				return res
+func (ec *executionContext) marshalNDivision2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐDivisionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Division) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNDivision2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐDivision(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	}
+	wg.Wait()
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "divisions":
-			field := field
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_divisions(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	return ret
+}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+func (ec *executionContext) marshalNDivision2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐDivision(ctx context.Context, sel ast.SelectionSet, v *model.Division) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Division(ctx, sel, v)
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "division":
-			field := field
+func (ec *executionContext) marshalNFantasySeason2fantasyᚑdraftᚋgraphᚋmodelᚐFantasySeason(ctx context.Context, sel ast.SelectionSet, v model.FantasySeason) graphql.Marshaler {
+	return ec._FantasySeason(ctx, sel, &v)
+}
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_division(ctx, field)
-				return res
-			}
+func (ec *executionContext) marshalNFantasySeason2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasySeason(ctx context.Context, sel ast.SelectionSet, v *model.FantasySeason) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._FantasySeason(ctx, sel, v)
+}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+func (ec *executionContext) marshalNFantasyTeam2fantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam(ctx context.Context, sel ast.SelectionSet, v model.FantasyTeam) graphql.Marshaler {
+	return ec._FantasyTeam(ctx, sel, &v)
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "teams":
-			field := field
+func (ec *executionContext) marshalNFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam(ctx context.Context, sel ast.SelectionSet, v *model.FantasyTeam) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._FantasyTeam(ctx, sel, v)
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_teams(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+func (ec *executionContext) marshalNFantasyTransaction2fantasyᚑdraftᚋgraphᚋmodelᚐFantasyTransaction(ctx context.Context, sel ast.SelectionSet, v model.FantasyTransaction) graphql.Marshaler {
+	return ec._FantasyTransaction(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNFantasyTransaction2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTransactionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.FantasyTransaction) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNFantasyTransaction2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTransaction(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	}
+	wg.Wait()
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "team":
-			field := field
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_team(ctx, field)
-				return res
-			}
+	return ret
+}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+func (ec *executionContext) marshalNFantasyTransaction2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTransaction(ctx context.Context, sel ast.SelectionSet, v *model.FantasyTransaction) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._FantasyTransaction(ctx, sel, v)
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "players":
-			field := field
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_players(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return graphql.WrapContextMarshaler(ctx, res)
+}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "player":
-			field := field
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_player(ctx, field)
-				return res
-			}
+func (ec *executionContext) unmarshalNID2ᚕstringᚄ(ctx context.Context, v any) ([]string, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNID2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+func (ec *executionContext) marshalNID2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNID2string(ctx, sel, v[i])
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "searchPlayers":
-			field := field
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_searchPlayers(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
+	return ret
+}
+
+func (ec *executionContext) marshalNInjury2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐInjuryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Injury) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				return res
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNInjury2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐInjury(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	}
+	wg.Wait()
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "__type":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
-		case "__schema":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNInjury2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐInjury(ctx context.Context, sel ast.SelectionSet, v *model.Injury) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._Injury(ctx, sel, v)
 }
 
-var teamImplementors = []string{"Team"}
-
-func (ec *executionContext) _Team(ctx context.Context, sel ast.SelectionSet, obj *model.Team) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, teamImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Team")
-		case "id":
-			out.Values[i] = ec._Team_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "city":
-			out.Values[i] = ec._Team_city(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "state":
-			out.Values[i] = ec._Team_state(ctx, field, obj)
-		case "name":
-			out.Values[i] = ec._Team_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "abbreviation":
-			out.Values[i] = ec._Team_abbreviation(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				atomic.AddUint32(&out.Invalids, 1)
-			}
-		case "division":
-			field := field
+func (ec *executionContext) unmarshalNInjurySeverity2fantasyᚑdraftᚋgraphᚋmodelᚐInjurySeverity(ctx context.Context, v any) (model.InjurySeverity, error) {
+	var res model.InjurySeverity
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Team_division(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+func (ec *executionContext) marshalNInjurySeverity2fantasyᚑdraftᚋgraphᚋmodelᚐInjurySeverity(ctx context.Context, sel ast.SelectionSet, v model.InjurySeverity) graphql.Marshaler {
+	return v
+}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
-				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
-			}
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		case "players":
-			field := field
+func (ec *executionContext) unmarshalNLineupMoveInput2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐLineupMoveInputᚄ(ctx context.Context, v any) ([]*model.LineupMoveInput, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.LineupMoveInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNLineupMoveInput2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐLineupMoveInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Team_players(ctx, field, obj)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+func (ec *executionContext) unmarshalNLineupMoveInput2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐLineupMoveInput(ctx context.Context, v any) (*model.LineupMoveInput, error) {
+	res, err := ec.unmarshalInputLineupMoveInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-			if field.Deferrable != nil {
-				dfs, ok := deferred[field.Deferrable.Label]
-				di := 0
-				if ok {
-					dfs.AddField(field)
-					di = len(dfs.Values) - 1
-				} else {
-					dfs = graphql.NewFieldSet([]graphql.CollectedField{field})
-					deferred[field.Deferrable.Label] = dfs
+func (ec *executionContext) marshalNMatchup2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐMatchupᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Matchup) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
 				}
-				dfs.Concurrently(di, func(ctx context.Context) graphql.Marshaler {
-					return innerFunc(ctx, dfs)
-				})
-
-				// don't run the out.Concurrently() call below
-				out.Values[i] = graphql.Null
-				continue
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
+			ret[i] = ec.marshalNMatchup2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐMatchup(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNMatchup2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐMatchup(ctx context.Context, sel ast.SelectionSet, v *model.Matchup) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._Matchup(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNPlayer2fantasyᚑdraftᚋgraphᚋmodelᚐPlayer(ctx context.Context, sel ast.SelectionSet, v model.Player) graphql.Marshaler {
+	return ec._Player(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPlayer2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayerᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Player) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPlayer2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayer(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
 	}
+	wg.Wait()
 
-	return out
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-var yearlyStatImplementors = []string{"YearlyStat"}
+func (ec *executionContext) marshalNPlayer2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayer(ctx context.Context, sel ast.SelectionSet, v *model.Player) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Player(ctx, sel, v)
+}
 
-func (ec *executionContext) _YearlyStat(ctx context.Context, sel ast.SelectionSet, obj *model.YearlyStat) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, yearlyStatImplementors)
+func (ec *executionContext) unmarshalNPlayerStatus2fantasyᚑdraftᚋgraphᚋmodelᚐPlayerStatus(ctx context.Context, v any) (model.PlayerStatus, error) {
+	var res model.PlayerStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("YearlyStat")
-		case "id":
-			out.Values[i] = ec._YearlyStat_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "year":
-			out.Values[i] = ec._YearlyStat_year(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "sportType":
-			out.Values[i] = ec._YearlyStat_sportType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "stats":
-			out.Values[i] = ec._YearlyStat_stats(ctx, field, obj)
-		case "fantasyPoints":
-			out.Values[i] = ec._YearlyStat_fantasyPoints(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNPlayerStatus2fantasyᚑdraftᚋgraphᚋmodelᚐPlayerStatus(ctx context.Context, sel ast.SelectionSet, v model.PlayerStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNPlayoffGame2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayoffGameᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PlayoffGame) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "gamesPlayed":
-			out.Values[i] = ec._YearlyStat_gamesPlayed(ctx, field, obj)
-		case "fantasyPointsPerGame":
-			out.Values[i] = ec._YearlyStat_fantasyPointsPerGame(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNPlayoffGame2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayoffGame(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNPlayoffGame2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayoffGame(ctx context.Context, sel ast.SelectionSet, v *model.PlayoffGame) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._PlayoffGame(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) marshalNPlayoffSettings2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayoffSettings(ctx context.Context, sel ast.SelectionSet, v *model.PlayoffSettings) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PlayoffSettings(ctx, sel, v)
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
+func (ec *executionContext) unmarshalNPosition2fantasyᚑdraftᚋgraphᚋmodelᚐPosition(ctx context.Context, v any) (model.Position, error) {
+	var res model.Position
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+func (ec *executionContext) marshalNPosition2fantasyᚑdraftᚋgraphᚋmodelᚐPosition(ctx context.Context, sel ast.SelectionSet, v model.Position) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "isRepeatable":
-			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNRosterEntry2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐRosterEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RosterEntry) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNRosterEntry2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐRosterEntry(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
-
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNRosterEntry2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐRosterEntry(ctx context.Context, sel ast.SelectionSet, v *model.RosterEntry) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._RosterEntry(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNRotoCategoryScore2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐRotoCategoryScoreᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RotoCategoryScore) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRotoCategoryScore2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐRotoCategoryScore(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
 	}
+	wg.Wait()
 
-	return out
-}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-var __FieldImplementors = []string{"__Field"}
+	return ret
+}
 
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) marshalNRotoCategoryScore2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐRotoCategoryScore(ctx context.Context, sel ast.SelectionSet, v *model.RotoCategoryScore) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RotoCategoryScore(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNRotoStanding2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐRotoStandingᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RotoStanding) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNRotoStanding2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐRotoStanding(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNRotoStanding2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐRotoStanding(ctx context.Context, sel ast.SelectionSet, v *model.RotoStanding) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._RotoStanding(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNSeedingTiebreaker2fantasyᚑdraftᚋgraphᚋmodelᚐSeedingTiebreaker(ctx context.Context, v any) (model.SeedingTiebreaker, error) {
+	var res model.SeedingTiebreaker
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
+func (ec *executionContext) marshalNSeedingTiebreaker2fantasyᚑdraftᚋgraphᚋmodelᚐSeedingTiebreaker(ctx context.Context, sel ast.SelectionSet, v model.SeedingTiebreaker) graphql.Marshaler {
+	return v
+}
 
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+func (ec *executionContext) unmarshalNSeedingTiebreaker2ᚕfantasyᚑdraftᚋgraphᚋmodelᚐSeedingTiebreakerᚄ(ctx context.Context, v any) ([]model.SeedingTiebreaker, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.SeedingTiebreaker, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNSeedingTiebreaker2fantasyᚑdraftᚋgraphᚋmodelᚐSeedingTiebreaker(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___InputValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNSeedingTiebreaker2ᚕfantasyᚑdraftᚋgraphᚋmodelᚐSeedingTiebreakerᚄ(ctx context.Context, sel ast.SelectionSet, v []model.SeedingTiebreaker) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "deprecationReason":
-			out.Values[i] = ec.___InputValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNSeedingTiebreaker2fantasyᚑdraftᚋgraphᚋmodelᚐSeedingTiebreaker(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __SchemaImplementors = []string{"__Schema"}
+func (ec *executionContext) unmarshalNSportType2fantasyᚑdraftᚋgraphᚋmodelᚐSportType(ctx context.Context, v any) (model.SportType, error) {
+	var res model.SportType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+func (ec *executionContext) marshalNSportType2fantasyᚑdraftᚋgraphᚋmodelᚐSportType(ctx context.Context, sel ast.SelectionSet, v model.SportType) graphql.Marshaler {
+	return v
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "description":
-			out.Values[i] = ec.___Schema_description(ctx, field, obj)
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNStanding2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐStandingᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Standing) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNStanding2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐStanding(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var __TypeImplementors = []string{"__Type"}
-
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "specifiedByURL":
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		case "isOneOf":
-			out.Values[i] = ec.___Type_isOneOf(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNStanding2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐStanding(ctx context.Context, sel ast.SelectionSet, v *model.Standing) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._Standing(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
 	}
+	return res
+}
 
-	return out
+func (ec *executionContext) marshalNTeam2fantasyᚑdraftᚋgraphᚋmodelᚐTeam(ctx context.Context, sel ast.SelectionSet, v model.Team) graphql.Marshaler {
+	return ec._Team(ctx, sel, &v)
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) marshalNTeam2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐTeamᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Team) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTeam(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-// region    ***************************** type.gotpl *****************************
+	}
+	wg.Wait()
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v any) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTeam(ctx context.Context, sel ast.SelectionSet, v *model.Team) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._Team(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNConference2fantasyᚑdraftᚋgraphᚋmodelᚐConference(ctx context.Context, sel ast.SelectionSet, v model.Conference) graphql.Marshaler {
-	return ec._Conference(ctx, sel, &v)
+func (ec *executionContext) marshalNTrade2fantasyᚑdraftᚋgraphᚋmodelᚐTrade(ctx context.Context, sel ast.SelectionSet, v model.Trade) graphql.Marshaler {
+	return ec._Trade(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNConference2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐConferenceᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Conference) graphql.Marshaler {
+func (ec *executionContext) marshalNTrade2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐTradeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Trade) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -6017,7 +19498,7 @@ func (ec *executionContext) marshalNConference2ᚕᚖfantasyᚑdraftᚋgraphᚋm
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNConference2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐConference(ctx, sel, v[i])
+			ret[i] = ec.marshalNTrade2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTrade(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -6037,21 +19518,17 @@ func (ec *executionContext) marshalNConference2ᚕᚖfantasyᚑdraftᚋgraphᚋm
 	return ret
 }
 
-func (ec *executionContext) marshalNConference2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐConference(ctx context.Context, sel ast.SelectionSet, v *model.Conference) graphql.Marshaler {
+func (ec *executionContext) marshalNTrade2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTrade(ctx context.Context, sel ast.SelectionSet, v *model.Trade) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Conference(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNDivision2fantasyᚑdraftᚋgraphᚋmodelᚐDivision(ctx context.Context, sel ast.SelectionSet, v model.Division) graphql.Marshaler {
-	return ec._Division(ctx, sel, &v)
+	return ec._Trade(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNDivision2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐDivisionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Division) graphql.Marshaler {
+func (ec *executionContext) marshalNTradePlayer2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐTradePlayerᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.TradePlayer) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -6075,7 +19552,7 @@ func (ec *executionContext) marshalNDivision2ᚕᚖfantasyᚑdraftᚋgraphᚋmod
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNDivision2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐDivision(ctx, sel, v[i])
+			ret[i] = ec.marshalNTradePlayer2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTradePlayer(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -6095,65 +19572,47 @@ func (ec *executionContext) marshalNDivision2ᚕᚖfantasyᚑdraftᚋgraphᚋmod
 	return ret
 }
 
-func (ec *executionContext) marshalNDivision2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐDivision(ctx context.Context, sel ast.SelectionSet, v *model.Division) graphql.Marshaler {
+func (ec *executionContext) marshalNTradePlayer2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTradePlayer(ctx context.Context, sel ast.SelectionSet, v *model.TradePlayer) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Division(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v any) (float64, error) {
-	res, err := graphql.UnmarshalFloatContext(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+	return ec._TradePlayer(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalFloatContext(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNTradeSettings2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTradeSettings(ctx context.Context, sel ast.SelectionSet, v *model.TradeSettings) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return graphql.WrapContextMarshaler(ctx, res)
+	return ec._TradeSettings(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalID(v)
+func (ec *executionContext) unmarshalNTradeStatus2fantasyᚑdraftᚋgraphᚋmodelᚐTradeStatus(ctx context.Context, v any) (model.TradeStatus, error) {
+	var res model.TradeStatus
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalID(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
+func (ec *executionContext) marshalNTradeStatus2fantasyᚑdraftᚋgraphᚋmodelᚐTradeStatus(ctx context.Context, sel ast.SelectionSet, v model.TradeStatus) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
-	res, err := graphql.UnmarshalInt(v)
+func (ec *executionContext) unmarshalNTransactionType2fantasyᚑdraftᚋgraphᚋmodelᚐTransactionType(ctx context.Context, v any) (model.TransactionType, error) {
+	var res model.TransactionType
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalInt(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
+func (ec *executionContext) marshalNTransactionType2fantasyᚑdraftᚋgraphᚋmodelᚐTransactionType(ctx context.Context, sel ast.SelectionSet, v model.TransactionType) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) marshalNPlayer2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayerᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Player) graphql.Marshaler {
+func (ec *executionContext) marshalNUniverse2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐUniverseᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Universe) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -6177,7 +19636,7 @@ func (ec *executionContext) marshalNPlayer2ᚕᚖfantasyᚑdraftᚋgraphᚋmodel
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNPlayer2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayer(ctx, sel, v[i])
+			ret[i] = ec.marshalNUniverse2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐUniverse(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -6197,57 +19656,21 @@ func (ec *executionContext) marshalNPlayer2ᚕᚖfantasyᚑdraftᚋgraphᚋmodel
 	return ret
 }
 
-func (ec *executionContext) marshalNPlayer2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayer(ctx context.Context, sel ast.SelectionSet, v *model.Player) graphql.Marshaler {
+func (ec *executionContext) marshalNUniverse2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐUniverse(ctx context.Context, sel ast.SelectionSet, v *model.Universe) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Player(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNPlayerStatus2fantasyᚑdraftᚋgraphᚋmodelᚐPlayerStatus(ctx context.Context, v any) (model.PlayerStatus, error) {
-	var res model.PlayerStatus
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNPlayerStatus2fantasyᚑdraftᚋgraphᚋmodelᚐPlayerStatus(ctx context.Context, sel ast.SelectionSet, v model.PlayerStatus) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) unmarshalNPosition2fantasyᚑdraftᚋgraphᚋmodelᚐPosition(ctx context.Context, v any) (model.Position, error) {
-	var res model.Position
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNPosition2fantasyᚑdraftᚋgraphᚋmodelᚐPosition(ctx context.Context, sel ast.SelectionSet, v model.Position) graphql.Marshaler {
-	return v
-}
-
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	_ = sel
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
+	return ec._Universe(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNTeam2fantasyᚑdraftᚋgraphᚋmodelᚐTeam(ctx context.Context, sel ast.SelectionSet, v model.Team) graphql.Marshaler {
-	return ec._Team(ctx, sel, &v)
+func (ec *executionContext) marshalNWaiverClaim2fantasyᚑdraftᚋgraphᚋmodelᚐWaiverClaim(ctx context.Context, sel ast.SelectionSet, v model.WaiverClaim) graphql.Marshaler {
+	return ec._WaiverClaim(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNTeam2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐTeamᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Team) graphql.Marshaler {
+func (ec *executionContext) marshalNWaiverClaim2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐWaiverClaimᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.WaiverClaim) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -6271,7 +19694,7 @@ func (ec *executionContext) marshalNTeam2ᚕᚖfantasyᚑdraftᚋgraphᚋmodel
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTeam(ctx, sel, v[i])
+			ret[i] = ec.marshalNWaiverClaim2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐWaiverClaim(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -6291,14 +19714,44 @@ func (ec *executionContext) marshalNTeam2ᚕᚖfantasyᚑdraftᚋgraphᚋmodel
 	return ret
 }
 
-func (ec *executionContext) marshalNTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTeam(ctx context.Context, sel ast.SelectionSet, v *model.Team) graphql.Marshaler {
+func (ec *executionContext) marshalNWaiverClaim2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐWaiverClaim(ctx context.Context, sel ast.SelectionSet, v *model.WaiverClaim) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Team(ctx, sel, v)
+	return ec._WaiverClaim(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNWaiverClaimStatus2fantasyᚑdraftᚋgraphᚋmodelᚐWaiverClaimStatus(ctx context.Context, v any) (model.WaiverClaimStatus, error) {
+	var res model.WaiverClaimStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNWaiverClaimStatus2fantasyᚑdraftᚋgraphᚋmodelᚐWaiverClaimStatus(ctx context.Context, sel ast.SelectionSet, v model.WaiverClaimStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNWaiverMode2fantasyᚑdraftᚋgraphᚋmodelᚐWaiverMode(ctx context.Context, v any) (model.WaiverMode, error) {
+	var res model.WaiverMode
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNWaiverMode2fantasyᚑdraftᚋgraphᚋmodelᚐWaiverMode(ctx context.Context, sel ast.SelectionSet, v model.WaiverMode) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNWaiverSettings2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐWaiverSettings(ctx context.Context, sel ast.SelectionSet, v *model.WaiverSettings) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._WaiverSettings(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalNYearlyStat2ᚕᚖfantasyᚑdraftᚋgraphᚋmodelᚐYearlyStatᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.YearlyStat) graphql.Marshaler {
@@ -6652,6 +20105,20 @@ func (ec *executionContext) marshalODivision2ᚖfantasyᚑdraftᚋgraphᚋmodel
 	return ec._Division(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOFantasySeason2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasySeason(ctx context.Context, sel ast.SelectionSet, v *model.FantasySeason) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._FantasySeason(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOFantasyTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFantasyTeam(ctx context.Context, sel ast.SelectionSet, v *model.FantasyTeam) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._FantasyTeam(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v any) (*float64, error) {
 	if v == nil {
 		return nil, nil
@@ -6669,13 +20136,6 @@ func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel as
 	return graphql.WrapContextMarshaler(ctx, res)
 }
 
-func (ec *executionContext) marshalOFootballStats2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐFootballStats(ctx context.Context, sel ast.SelectionSet, v *model.FootballStats) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	return ec._FootballStats(ctx, sel, v)
-}
-
 func (ec *executionContext) unmarshalOID2ᚖstring(ctx context.Context, v any) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -6719,6 +20179,35 @@ func (ec *executionContext) marshalOPlayer2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐ
 	return ec._Player(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOPlayerStats2fantasyᚑdraftᚋgraphᚋmodelᚐPlayerStats(ctx context.Context, sel ast.SelectionSet, v model.PlayerStats) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._PlayerStats(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOPlayoffBracket2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayoffBracket(ctx context.Context, sel ast.SelectionSet, v *model.PlayoffBracket) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._PlayoffBracket(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOPlayoffGame2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayoffGame(ctx context.Context, sel ast.SelectionSet, v *model.PlayoffGame) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._PlayoffGame(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOPlayoffSettingsInput2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPlayoffSettingsInput(ctx context.Context, v any) (*model.PlayoffSettingsInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputPlayoffSettingsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalOPosition2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐPosition(ctx context.Context, v any) (*model.Position, error) {
 	if v == nil {
 		return nil, nil
@@ -6735,6 +20224,71 @@ func (ec *executionContext) marshalOPosition2ᚖfantasyᚑdraftᚋgraphᚋmodel
 	return v
 }
 
+func (ec *executionContext) unmarshalOSeedingTiebreaker2ᚕfantasyᚑdraftᚋgraphᚋmodelᚐSeedingTiebreakerᚄ(ctx context.Context, v any) ([]model.SeedingTiebreaker, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]model.SeedingTiebreaker, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNSeedingTiebreaker2fantasyᚑdraftᚋgraphᚋmodelᚐSeedingTiebreaker(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOSeedingTiebreaker2ᚕfantasyᚑdraftᚋgraphᚋmodelᚐSeedingTiebreakerᚄ(ctx context.Context, sel ast.SelectionSet, v []model.SeedingTiebreaker) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSeedingTiebreaker2fantasyᚑdraftᚋgraphᚋmodelᚐSeedingTiebreaker(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2ᚖstring(ctx context.Context, v any) (*string, error) {
 	if v == nil {
 		return nil, nil
@@ -6760,6 +20314,61 @@ func (ec *executionContext) marshalOTeam2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTe
 	return ec._Team(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOTrade2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTrade(ctx context.Context, sel ast.SelectionSet, v *model.Trade) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Trade(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOTradeSettingsInput2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTradeSettingsInput(ctx context.Context, v any) (*model.TradeSettingsInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputTradeSettingsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOTradeStatus2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTradeStatus(ctx context.Context, v any) (*model.TradeStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.TradeStatus)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOTradeStatus2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐTradeStatus(ctx context.Context, sel ast.SelectionSet, v *model.TradeStatus) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOWaiverClaimStatus2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐWaiverClaimStatus(ctx context.Context, v any) (*model.WaiverClaimStatus, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.WaiverClaimStatus)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOWaiverClaimStatus2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐWaiverClaimStatus(ctx context.Context, sel ast.SelectionSet, v *model.WaiverClaimStatus) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalOWaiverSettingsInput2ᚖfantasyᚑdraftᚋgraphᚋmodelᚐWaiverSettingsInput(ctx context.Context, v any) (*model.WaiverSettingsInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputWaiverSettingsInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -1,9 +1,36 @@
 package graph
 
 import (
+	"context"
+
 	"fantasy-draft/graph/model"
 )
 
+// baseballBasePositions maps the database names of the bases, which aren't valid GraphQL enum values
+var baseballBasePositions = map[string]model.Position{
+	"1B": model.PositionFirstBase,
+	"2B": model.PositionSecondBase,
+	"3B": model.PositionThirdBase,
+}
+
+// positionFromDB converts a position_enum value to its GraphQL enum
+func positionFromDB(position string) model.Position {
+	if p, ok := baseballBasePositions[position]; ok {
+		return p
+	}
+	return model.Position(position)
+}
+
+// positionToDB converts a GraphQL position to its position_enum value
+func positionToDB(position model.Position) string {
+	for dbName, p := range baseballBasePositions {
+		if p == position {
+			return dbName
+		}
+	}
+	return position.String()
+}
+
 // playerRows interface for scanning player rows from database
 type playerRows interface {
 	Next() bool
@@ -22,15 +49,45 @@ func scanPlayers(rows playerRows) ([]*model.Player, error) {
 			&p.ID, &p.FirstName, &p.LastName, &pos, &p.TeamID,
 			&p.Height, &p.Weight, &p.Age, &p.YearsOfExperience,
 			&p.DraftYear, &p.JerseyNumber, &status, &p.Skill,
+			&p.ScoutedSkill, &p.DraftRound, &p.DraftPick,
 		); err != nil {
 			return nil, err
 		}
 
+		// A rookie's true skill stays hidden until they've played a season
+		if p.ScoutedSkill != nil && p.YearsOfExperience != nil && *p.YearsOfExperience == 0 {
+			p.Skill = p.ScoutedSkill
+		}
+
 		// Convert string to enum
-		p.Position = model.Position(pos)
+		p.Position = positionFromDB(pos)
 		p.Status = model.PlayerStatus(status)
 
 		players = append(players, &p)
 	}
 	return players, nil
 }
+
+// loadPlayer returns a single player by ID, or nil if there is no such player
+func loadPlayer(ctx context.Context, q querier, id string) (*model.Player, error) {
+	rows, err := q.Query(ctx, `
+		SELECT id, first_name, last_name, position, team_id, height, weight, age,
+		       years_of_experience, draft_year, jersey_number, status, skill,
+		       scouted_skill, draft_round, draft_pick
+		FROM players
+		WHERE id = $1
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	players, err := scanPlayers(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(players) == 0 {
+		return nil, nil
+	}
+	return players[0], nil
+}
@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"context"
+
+	"fantasy-draft/fantasy"
+	"fantasy-draft/graph/model"
+)
+
+// loadInjuries returns a player's injury history, most recent first
+func loadInjuries(ctx context.Context, q querier, playerID string) ([]*model.Injury, error) {
+	rows, err := q.Query(ctx, `
+		SELECT id, year, week, games_missed, injury_type, severity
+		FROM injuries
+		WHERE player_id = $1
+		ORDER BY year DESC, week DESC
+	`, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	injuries := []*model.Injury{}
+	for rows.Next() {
+		var i model.Injury
+		var severity string
+		if err := rows.Scan(&i.ID, &i.Year, &i.Week, &i.GamesMissed, &i.InjuryType, &severity); err != nil {
+			return nil, err
+		}
+		i.Severity = model.InjurySeverity(severity)
+		injuries = append(injuries, &i)
+	}
+	return injuries, rows.Err()
+}
+
+// loadInjuryRisk scores a player's chance of injury next season from their age, position
+// and the injuries they suffered in their most recent seasons
+func loadInjuryRisk(ctx context.Context, q querier, player *model.Player) (float64, error) {
+	rows, err := q.Query(ctx, `
+		SELECT i.severity
+		FROM injuries i
+		WHERE i.player_id = $1
+		  AND i.year > (SELECT MAX(ys.year) FROM yearly_stats ys WHERE ys.player_id = $1) - $2
+	`, player.ID, fantasy.RecentInjurySeasons)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var severities []string
+	for rows.Next() {
+		var severity string
+		if err := rows.Scan(&severity); err != nil {
+			return 0, err
+		}
+		severities = append(severities, severity)
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	// Each sport's players get hurt at their own rates over a season of their own length
+	sportType := "FOOTBALL"
+	if player.TeamID != "" {
+		if err := q.QueryRow(ctx, "SELECT sport_type FROM pro_teams WHERE id = $1", player.TeamID).Scan(&sportType); err != nil {
+			return 0, err
+		}
+	}
+
+	age := 0
+	if player.Age != nil {
+		age = *player.Age
+	}
+	return fantasy.InjuryRisk(fantasy.GameInjuryRateFor(sportType), age, positionToDB(player.Position), severities, fantasy.GamesPerSeasonFor(sportType)), nil
+}
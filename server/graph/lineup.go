@@ -0,0 +1,216 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"fantasy-draft/fantasy"
+	"fantasy-draft/graph/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// loadRosterEntries returns a team's roster with starters first, then the bench and injured reserve
+func loadRosterEntries(ctx context.Context, q querier, teamID string) ([]*model.RosterEntry, error) {
+	rows, err := q.Query(ctx, `
+		SELECT player_id, roster_spot
+		FROM fantasy_rosters
+		WHERE fantasy_team_id = $1
+		ORDER BY roster_spot = 'IR', roster_spot = 'BN', roster_spot, player_id
+	`, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []*model.RosterEntry{}
+	for rows.Next() {
+		var e model.RosterEntry
+		if err := rows.Scan(&e.PlayerID, &e.RosterSpot); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &e)
+	}
+	return entries, rows.Err()
+}
+
+// loadLineupPlayers returns every player on a team's roster with their position, status and
+// whether their pro team is on bye in the given week
+func loadLineupPlayers(ctx context.Context, q querier, teamID string, week int) (map[string]fantasy.LineupPlayer, error) {
+	rows, err := q.Query(ctx, `
+		SELECT p.id, p.position, p.status, COALESCE(pt.bye_week = $2, FALSE)
+		FROM fantasy_rosters fr
+		JOIN players p ON p.id = fr.player_id
+		JOIN pro_teams pt ON pt.id = p.team_id
+		WHERE fr.fantasy_team_id = $1
+	`, teamID, week)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	players := make(map[string]fantasy.LineupPlayer)
+	for rows.Next() {
+		var p fantasy.LineupPlayer
+		if err := rows.Scan(&p.ID, &p.Position, &p.Status, &p.OnBye); err != nil {
+			return nil, err
+		}
+		players[p.ID] = p
+	}
+	return players, rows.Err()
+}
+
+// loadProjections estimates each rostered player's fantasy points for a week of the given pro season.
+// A player's projection is their average over the season's earlier weeks, or over the previous
+// season if they haven't played yet. Players with no recent games are left out.
+func loadProjections(ctx context.Context, q querier, teamID string, year, week int) (map[string]float64, error) {
+	rows, err := q.Query(ctx, `
+		SELECT ws.player_id, p.position, ws.year, ws.sport_type, ws.stats
+		FROM fantasy_rosters fr
+		JOIN players p ON p.id = fr.player_id
+		JOIN weekly_stats ws ON ws.player_id = fr.player_id
+		WHERE fr.fantasy_team_id = $1
+		  AND ((ws.year = $2 AND ws.week < $3) OR ws.year = $2 - 1)
+	`, teamID, year, week)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type average struct {
+		points float64
+		games  int
+	}
+	current := make(map[string]average)
+	previous := make(map[string]average)
+	for rows.Next() {
+		var playerID, position, sportType string
+		var statsYear int
+		var statsJSON []byte
+		if err := rows.Scan(&playerID, &position, &statsYear, &sportType, &statsJSON); err != nil {
+			return nil, err
+		}
+
+		points, err := scoreWeeklyStats(sportType, position, statsJSON)
+		if err != nil {
+			return nil, err
+		}
+
+		averages := current
+		if statsYear != year {
+			averages = previous
+		}
+		a := averages[playerID]
+		a.points += points
+		a.games++
+		averages[playerID] = a
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	projections := make(map[string]float64, len(current)+len(previous))
+	for playerID, a := range previous {
+		projections[playerID] = a.points / float64(a.games)
+	}
+	for playerID, a := range current {
+		projections[playerID] = a.points / float64(a.games)
+	}
+	return projections, nil
+}
+
+// saveLineup writes the roster spot of every player whose spot changed
+func saveLineup(ctx context.Context, tx pgx.Tx, teamID string, current, lineup map[string]string) error {
+	for playerID, spot := range lineup {
+		if current[playerID] == spot {
+			continue
+		}
+		_, err := tx.Exec(ctx, `
+			UPDATE fantasy_rosters
+			SET roster_spot = $3
+			WHERE fantasy_team_id = $1 AND player_id = $2
+		`, teamID, playerID, spot)
+		if err != nil {
+			return fmt.Errorf("failed to move player %s to %s: %w", playerID, spot, err)
+		}
+	}
+	return nil
+}
+
+// setLineup applies a set of lineup moves together and rejects the result if it breaks the league's slots
+func setLineup(ctx context.Context, tx pgx.Tx, teamID string, moves []*model.LineupMoveInput) ([]*model.RosterEntry, error) {
+	season, err := lockTeamSeason(ctx, tx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	rules, err := loadRosterRules(ctx, tx, season.DraftRoomID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := loadRoster(ctx, tx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	players, err := loadLineupPlayers(ctx, tx, teamID, season.CurrentWeek+1)
+	if err != nil {
+		return nil, err
+	}
+
+	lineup := maps.Clone(current)
+	for _, move := range moves {
+		if _, ok := current[move.PlayerID]; !ok {
+			return nil, fmt.Errorf("player %s is not on team %s's roster", move.PlayerID, teamID)
+		}
+		lineup[move.PlayerID] = move.RosterSpot
+	}
+
+	if err := rules.CheckLineup(lineup, players); err != nil {
+		return nil, err
+	}
+	if err := saveLineup(ctx, tx, teamID, current, lineup); err != nil {
+		return nil, err
+	}
+	return loadRosterEntries(ctx, tx, teamID)
+}
+
+// optimizeLineup starts the available players with the best projections for an upcoming week
+func optimizeLineup(ctx context.Context, tx pgx.Tx, teamID string, week int) ([]*model.RosterEntry, error) {
+	season, err := lockTeamSeason(ctx, tx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	lastWeek := season.RegularSeasonWeeks + playoffSettings(season).TotalWeeks()
+	if week <= season.CurrentWeek || week > lastWeek {
+		return nil, fmt.Errorf("week %d is not an upcoming week of the season, expected %d to %d", week, season.CurrentWeek+1, lastWeek)
+	}
+
+	rules, err := loadRosterRules(ctx, tx, season.DraftRoomID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := loadRoster(ctx, tx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	players, err := loadLineupPlayers(ctx, tx, teamID, week)
+	if err != nil {
+		return nil, err
+	}
+	projections, err := loadProjections(ctx, tx, teamID, season.Year, week)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]fantasy.LineupPlayer, 0, len(players))
+	for _, p := range players {
+		p.Projection = projections[p.ID]
+		candidates = append(candidates, p)
+	}
+
+	lineup := fantasy.OptimizeLineup(rules, candidates, current)
+	if err := saveLineup(ctx, tx, teamID, current, lineup); err != nil {
+		return nil, err
+	}
+	return loadRosterEntries(ctx, tx, teamID)
+}
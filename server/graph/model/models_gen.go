@@ -9,6 +9,49 @@ import (
 	"strconv"
 )
 
+// A season or week of statistics in one sport's stat line
+type PlayerStats interface {
+	IsPlayerStats()
+}
+
+// Baseball-specific statistics. Hitters leave the pitching stats at zero and pitchers the hitting ones.
+type BaseballStats struct {
+	AtBats         int     `json:"atBats"`
+	Hits           int     `json:"hits"`
+	HomeRuns       int     `json:"homeRuns"`
+	RunsBattedIn   int     `json:"runsBattedIn"`
+	StolenBases    int     `json:"stolenBases"`
+	BattingAverage float64 `json:"battingAverage"`
+	OutsPitched    int     `json:"outsPitched"`
+	InningsPitched float64 `json:"inningsPitched"`
+	Strikeouts     int     `json:"strikeouts"`
+	EarnedRuns     int     `json:"earnedRuns"`
+	HitsAllowed    int     `json:"hitsAllowed"`
+	WalksAllowed   int     `json:"walksAllowed"`
+	Saves          int     `json:"saves"`
+	Era            float64 `json:"era"`
+	Whip           float64 `json:"whip"`
+}
+
+func (BaseballStats) IsPlayerStats() {}
+
+// Basketball-specific statistics
+type BasketballStats struct {
+	Points              int `json:"points"`
+	Rebounds            int `json:"rebounds"`
+	Assists             int `json:"assists"`
+	Steals              int `json:"steals"`
+	Blocks              int `json:"blocks"`
+	ThreePointersMade   int `json:"threePointersMade"`
+	Turnovers           int `json:"turnovers"`
+	FieldGoalsMade      int `json:"fieldGoalsMade"`
+	FieldGoalsAttempted int `json:"fieldGoalsAttempted"`
+	FreeThrowsMade      int `json:"freeThrowsMade"`
+	FreeThrowsAttempted int `json:"freeThrowsAttempted"`
+}
+
+func (BasketballStats) IsPlayerStats() {}
+
 // A professional sports conference (e.g., AFC, NFC)
 type Conference struct {
 	ID        string      `json:"id"`
@@ -16,6 +59,14 @@ type Conference struct {
 	Divisions []*Division `json:"divisions"`
 }
 
+// A player's place on their pro team's depth chart. Rank 1 is the starter.
+type DepthChartEntry struct {
+	Position Position `json:"position"`
+	Rank     int      `json:"rank"`
+	Player   *Player  `json:"player"`
+	PlayerID string   `json:"-"`
+}
+
 // A division within a conference (e.g., AFC East, NFC West)
 type Division struct {
 	ID         string      `json:"id"`
@@ -24,53 +75,240 @@ type Division struct {
 	Teams      []*Team     `json:"teams"`
 }
 
+// A simulated fantasy season for a completed draft room
+type FantasySeason struct {
+	ID                 string           `json:"id"`
+	DraftRoomID        string           `json:"draftRoomId"`
+	Year               int              `json:"year"`
+	RegularSeasonWeeks int              `json:"regularSeasonWeeks"`
+	CurrentWeek        int              `json:"currentWeek"`
+	PlayoffSettings    *PlayoffSettings `json:"playoffSettings"`
+	WaiverSettings     *WaiverSettings  `json:"waiverSettings"`
+	TradeSettings      *TradeSettings   `json:"tradeSettings"`
+	Champion           *FantasyTeam     `json:"champion,omitempty"`
+	ChampionTeamID     string           `json:"-"`
+}
+
+// A manager's team inside a draft room
+type FantasyTeam struct {
+	ID               string         `json:"id"`
+	Name             string         `json:"name"`
+	DraftOrderNumber *int           `json:"draftOrderNumber,omitempty"`
+	IsBot            bool           `json:"isBot"`
+	WaiverPriority   *int           `json:"waiverPriority,omitempty"`
+	FaabRemaining    *int           `json:"faabRemaining,omitempty"`
+	Roster           []*RosterEntry `json:"roster"`
+}
+
+// A single roster move in a draft room's transaction history
+type FantasyTransaction struct {
+	ID         string          `json:"id"`
+	Type       TransactionType `json:"type"`
+	Team       *FantasyTeam    `json:"team"`
+	Player     *Player         `json:"player"`
+	FaabAmount *int            `json:"faabAmount,omitempty"`
+	Trade      *Trade          `json:"trade,omitempty"`
+	CreatedAt  string          `json:"createdAt"`
+	PlayerID   string          `json:"-"`
+	TeamID     string          `json:"-"`
+	TradeID    string          `json:"-"`
+}
+
 // Football-specific statistics
 type FootballStats struct {
-	PassingAttempts      int `json:"passingAttempts"`
-	PassingCompletions   int `json:"passingCompletions"`
-	PassingYards         int `json:"passingYards"`
-	PassingTDs           int `json:"passingTDs"`
-	PassingInterceptions int `json:"passingInterceptions"`
-	RushingAttempts      int `json:"rushingAttempts"`
-	RushingYards         int `json:"rushingYards"`
-	RushingTDs           int `json:"rushingTDs"`
-	ReceivingTargets     int `json:"receivingTargets"`
-	ReceivingReceptions  int `json:"receivingReceptions"`
-	ReceivingYards       int `json:"receivingYards"`
-	ReceivingTDs         int `json:"receivingTDs"`
-	Fumbles              int `json:"fumbles"`
-	FumblesLost          int `json:"fumblesLost"`
-	FieldGoals           int `json:"fieldGoals"`
-	FieldGoalsMade       int `json:"fieldGoalsMade"`
-	FieldGoalsMissed     int `json:"fieldGoalsMissed"`
-	ExtraPoints          int `json:"extraPoints"`
-	ExtraPointsMade      int `json:"extraPointsMade"`
-	ExtraPointsMissed    int `json:"extraPointsMissed"`
+	PassingAttempts       int `json:"passingAttempts"`
+	PassingCompletions    int `json:"passingCompletions"`
+	PassingYards          int `json:"passingYards"`
+	PassingTDs            int `json:"passingTDs"`
+	PassingInterceptions  int `json:"passingInterceptions"`
+	RushingAttempts       int `json:"rushingAttempts"`
+	RushingYards          int `json:"rushingYards"`
+	RushingTDs            int `json:"rushingTDs"`
+	ReceivingTargets      int `json:"receivingTargets"`
+	ReceivingReceptions   int `json:"receivingReceptions"`
+	ReceivingYards        int `json:"receivingYards"`
+	ReceivingTDs          int `json:"receivingTDs"`
+	Fumbles               int `json:"fumbles"`
+	FumblesLost           int `json:"fumblesLost"`
+	FieldGoals            int `json:"fieldGoals"`
+	FieldGoalsMade        int `json:"fieldGoalsMade"`
+	FieldGoalsMissed      int `json:"fieldGoalsMissed"`
+	FieldGoalsUnder40     int `json:"fieldGoalsUnder40"`
+	FieldGoalsMadeUnder40 int `json:"fieldGoalsMadeUnder40"`
+	FieldGoals40To49      int `json:"fieldGoals40To49"`
+	FieldGoalsMade40To49  int `json:"fieldGoalsMade40To49"`
+	FieldGoals50Plus      int `json:"fieldGoals50Plus"`
+	FieldGoalsMade50Plus  int `json:"fieldGoalsMade50Plus"`
+	ExtraPoints           int `json:"extraPoints"`
+	ExtraPointsMade       int `json:"extraPointsMade"`
+	ExtraPointsMissed     int `json:"extraPointsMissed"`
+	Tackles               int `json:"tackles"`
+	AssistedTackles       int `json:"assistedTackles"`
+	Sacks                 int `json:"sacks"`
+	Interceptions         int `json:"interceptions"`
+	PassesDefended        int `json:"passesDefended"`
+	ForcedFumbles         int `json:"forcedFumbles"`
+	FumbleRecoveries      int `json:"fumbleRecoveries"`
+	DefensiveTDs          int `json:"defensiveTDs"`
+	PointsAllowed         int `json:"pointsAllowed"`
+	YardsAllowed          int `json:"yardsAllowed"`
+}
+
+func (FootballStats) IsPlayerStats() {}
+
+// An injury suffered by a pro player
+type Injury struct {
+	ID   string `json:"id"`
+	Year int    `json:"year"`
+	// Week of the game the injury happened in
+	Week        int            `json:"week"`
+	GamesMissed int            `json:"gamesMissed"`
+	InjuryType  string         `json:"injuryType"`
+	Severity    InjurySeverity `json:"severity"`
+}
+
+// Move a rostered player to a starting slot, the bench ("BN") or injured reserve ("IR")
+type LineupMoveInput struct {
+	PlayerID   string `json:"playerId"`
+	RosterSpot string `json:"rosterSpot"`
+}
+
+// A head-to-head game between two fantasy teams in a given week
+type Matchup struct {
+	ID         string       `json:"id"`
+	Week       int          `json:"week"`
+	HomeTeam   *FantasyTeam `json:"homeTeam"`
+	AwayTeam   *FantasyTeam `json:"awayTeam"`
+	HomeScore  *float64     `json:"homeScore,omitempty"`
+	AwayScore  *float64     `json:"awayScore,omitempty"`
+	IsComplete bool         `json:"isComplete"`
+	Winner     *FantasyTeam `json:"winner,omitempty"`
+	AwayTeamID string       `json:"-"`
+	HomeTeamID string       `json:"-"`
+}
+
+type Mutation struct {
 }
 
 // A professional player
 type Player struct {
-	ID                string        `json:"id"`
-	FirstName         string        `json:"firstName"`
-	LastName          string        `json:"lastName"`
-	FullName          string        `json:"fullName"`
-	Position          Position      `json:"position"`
-	Team              *Team         `json:"team"`
-	Height            *int          `json:"height,omitempty"`
-	Weight            *int          `json:"weight,omitempty"`
-	Age               *int          `json:"age,omitempty"`
-	YearsOfExperience *int          `json:"yearsOfExperience,omitempty"`
-	DraftYear         *int          `json:"draftYear,omitempty"`
-	JerseyNumber      *int          `json:"jerseyNumber,omitempty"`
-	Status            PlayerStatus  `json:"status"`
-	Skill             *float64      `json:"skill,omitempty"`
-	YearlyStats       []*YearlyStat `json:"yearlyStats"`
-	TeamID            string        `json:"-"`
+	ID                string       `json:"id"`
+	FirstName         string       `json:"firstName"`
+	LastName          string       `json:"lastName"`
+	FullName          string       `json:"fullName"`
+	Position          Position     `json:"position"`
+	Team              *Team        `json:"team"`
+	Height            *int         `json:"height,omitempty"`
+	Weight            *int         `json:"weight,omitempty"`
+	Age               *int         `json:"age,omitempty"`
+	YearsOfExperience *int         `json:"yearsOfExperience,omitempty"`
+	DraftYear         *int         `json:"draftYear,omitempty"`
+	JerseyNumber      *int         `json:"jerseyNumber,omitempty"`
+	Status            PlayerStatus `json:"status"`
+	// How good the player is. Until a rookie plays their first season this is their scoutedSkill,
+	// since how good they really are only shows once they take the field.
+	Skill *float64 `json:"skill,omitempty"`
+	// The skill scouts saw in the player before the rookie draft
+	ScoutedSkill *float64 `json:"scoutedSkill,omitempty"`
+	// The round the player was taken in the rookie draft, null for players not drafted in a simulated draft
+	DraftRound *int `json:"draftRound,omitempty"`
+	// The pick within the round the player was taken with
+	DraftPick *int `json:"draftPick,omitempty"`
+	// The player's rank on their team's depth chart at their position, 1 being the starter
+	DepthChartRank *int          `json:"depthChartRank,omitempty"`
+	YearlyStats    []*YearlyStat `json:"yearlyStats"`
+	// Every injury the player has suffered, most recent first
+	Injuries []*Injury `json:"injuries"`
+	// Estimated chance (0 to 1) the player is injured at some point next season, based on age, position and recent injuries
+	InjuryRisk float64 `json:"injuryRisk"`
+	TeamID     string  `json:"-"`
+}
+
+// The playoff bracket for a fantasy season. Render it from `final` down through each game's sources.
+type PlayoffBracket struct {
+	Rounds         int            `json:"rounds"`
+	Games          []*PlayoffGame `json:"games"`
+	Final          *PlayoffGame   `json:"final"`
+	Champion       *FantasyTeam   `json:"champion,omitempty"`
+	ChampionTeamID string         `json:"-"`
+}
+
+// A single game in the playoff bracket. Teams are null until the feeding game is decided.
+type PlayoffGame struct {
+	ID              string       `json:"id"`
+	Round           int          `json:"round"`
+	BracketPosition int          `json:"bracketPosition"`
+	HomeSeed        *int         `json:"homeSeed,omitempty"`
+	AwaySeed        *int         `json:"awaySeed,omitempty"`
+	HomeTeam        *FantasyTeam `json:"homeTeam,omitempty"`
+	AwayTeam        *FantasyTeam `json:"awayTeam,omitempty"`
+	HomeScore       *float64     `json:"homeScore,omitempty"`
+	AwayScore       *float64     `json:"awayScore,omitempty"`
+	IsComplete      bool         `json:"isComplete"`
+	Winner          *FantasyTeam `json:"winner,omitempty"`
+	HomeSource      *PlayoffGame `json:"homeSource,omitempty"`
+	AwaySource      *PlayoffGame `json:"awaySource,omitempty"`
+	AwayTeamID      string       `json:"-"`
+	HomeTeamID      string       `json:"-"`
+	WinnerTeamID    string       `json:"-"`
+}
+
+// How the post-season bracket is built and decided
+type PlayoffSettings struct {
+	Teams         int                 `json:"teams"`
+	Byes          int                 `json:"byes"`
+	WeeksPerRound int                 `json:"weeksPerRound"`
+	Tiebreakers   []SeedingTiebreaker `json:"tiebreakers"`
+}
+
+// Playoff configuration. Omitted fields fall back to the league defaults.
+type PlayoffSettingsInput struct {
+	Teams         int                 `json:"teams"`
+	Byes          *int                `json:"byes,omitempty"`
+	WeeksPerRound *int                `json:"weeksPerRound,omitempty"`
+	Tiebreakers   []SeedingTiebreaker `json:"tiebreakers,omitempty"`
 }
 
 type Query struct {
 }
 
+// A player on a fantasy team and the lineup slot they fill: a starting slot such as "QB" or "FLEX", "BN" or "IR"
+type RosterEntry struct {
+	Player     *Player `json:"player"`
+	RosterSpot string  `json:"rosterSpot"`
+	PlayerID   string  `json:"-"`
+}
+
+// A team's total in one rotisserie category and the points it earned
+type RotoCategoryScore struct {
+	Category string `json:"category"`
+	// The team's total, or null when it has none (e.g. no innings pitched for ERA)
+	Value  *float64 `json:"value,omitempty"`
+	Points float64  `json:"points"`
+}
+
+// A fantasy team's place in a rotisserie league, where teams are ranked in each stat category
+// and earn a point for every team they beat
+type RotoStanding struct {
+	Rank       int                  `json:"rank"`
+	Team       *FantasyTeam         `json:"team"`
+	Points     float64              `json:"points"`
+	Categories []*RotoCategoryScore `json:"categories"`
+	TeamID     string               `json:"-"`
+}
+
+// A fantasy team's regular season record
+type Standing struct {
+	Rank          int          `json:"rank"`
+	Team          *FantasyTeam `json:"team"`
+	Wins          int          `json:"wins"`
+	Losses        int          `json:"losses"`
+	Ties          int          `json:"ties"`
+	PointsFor     float64      `json:"pointsFor"`
+	PointsAgainst float64      `json:"pointsAgainst"`
+	TeamID        string       `json:"-"`
+}
+
 // A professional sports team
 type Team struct {
 	ID           string    `json:"id"`
@@ -78,19 +316,166 @@ type Team struct {
 	State        *string   `json:"state,omitempty"`
 	Name         string    `json:"name"`
 	Abbreviation string    `json:"abbreviation"`
+	SportType    SportType `json:"sportType"`
 	Division     *Division `json:"division"`
 	Players      []*Player `json:"players"`
+	// The team's depth chart, starters first at each position. Optionally limited to one position.
+	DepthChart []*DepthChartEntry `json:"depthChart"`
+}
+
+// A player-for-player trade between two fantasy teams in the same draft room
+type Trade struct {
+	ID                string         `json:"id"`
+	ProposingTeam     *FantasyTeam   `json:"proposingTeam"`
+	ReceivingTeam     *FantasyTeam   `json:"receivingTeam"`
+	Status            TradeStatus    `json:"status"`
+	Players           []*TradePlayer `json:"players"`
+	CounterOf         *Trade         `json:"counterOf,omitempty"`
+	VetoVotes         int            `json:"vetoVotes"`
+	VetoVotesRequired int            `json:"vetoVotesRequired"`
+	ReviewEndsAt      *string        `json:"reviewEndsAt,omitempty"`
+	FailureReason     *string        `json:"failureReason,omitempty"`
+	CreatedAt         string         `json:"createdAt"`
+	ResolvedAt        *string        `json:"resolvedAt,omitempty"`
+	CounterOfTradeID  string         `json:"-"`
+	DraftRoomID       string         `json:"-"`
+	ProposingTeamID   string         `json:"-"`
+	ReceivingTeamID   string         `json:"-"`
+}
+
+// A player changing teams as part of a trade
+type TradePlayer struct {
+	Player     *Player      `json:"player"`
+	FromTeam   *FantasyTeam `json:"fromTeam"`
+	ToTeam     *FantasyTeam `json:"toTeam"`
+	FromTeamID string       `json:"-"`
+	PlayerID   string       `json:"-"`
+	ToTeamID   string       `json:"-"`
+}
+
+// How long accepted trades wait for vetoes before they execute
+type TradeSettings struct {
+	ReviewHours int  `json:"reviewHours"`
+	VetoVotes   *int `json:"vetoVotes,omitempty"`
+}
+
+// Trade review configuration. Omitted fields fall back to the league defaults.
+type TradeSettingsInput struct {
+	ReviewHours *int `json:"reviewHours,omitempty"`
+	VetoVotes   *int `json:"vetoVotes,omitempty"`
+}
+
+// A synthetic league of pro teams and players. Each draft room drafts from one universe.
+type Universe struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// A request to add a player through waivers, processed with the next batch
+type WaiverClaim struct {
+	ID            string            `json:"id"`
+	Team          *FantasyTeam      `json:"team"`
+	AddPlayer     *Player           `json:"addPlayer"`
+	DropPlayer    *Player           `json:"dropPlayer,omitempty"`
+	Bid           int               `json:"bid"`
+	Status        WaiverClaimStatus `json:"status"`
+	FailureReason *string           `json:"failureReason,omitempty"`
+	CreatedAt     string            `json:"createdAt"`
+	ProcessedAt   *string           `json:"processedAt,omitempty"`
+	AddPlayerID   string            `json:"-"`
+	DropPlayerID  string            `json:"-"`
+	TeamID        string            `json:"-"`
+}
+
+// How waiver claims are awarded and when each weekly batch runs
+type WaiverSettings struct {
+	Mode            WaiverMode `json:"mode"`
+	FaabBudget      int        `json:"faabBudget"`
+	ProcessDay      int        `json:"processDay"`
+	ProcessHour     int        `json:"processHour"`
+	LastProcessedAt *string    `json:"lastProcessedAt,omitempty"`
+	NextProcessAt   string     `json:"nextProcessAt"`
+}
+
+// Waiver configuration. Omitted fields fall back to the league defaults.
+type WaiverSettingsInput struct {
+	Mode        WaiverMode `json:"mode"`
+	FaabBudget  *int       `json:"faabBudget,omitempty"`
+	ProcessDay  *int       `json:"processDay,omitempty"`
+	ProcessHour *int       `json:"processHour,omitempty"`
 }
 
 // Yearly statistics for a player
 type YearlyStat struct {
-	ID                   string         `json:"id"`
-	Year                 int            `json:"year"`
-	SportType            string         `json:"sportType"`
-	Stats                *FootballStats `json:"stats,omitempty"`
-	FantasyPoints        float64        `json:"fantasyPoints"`
-	GamesPlayed          *int           `json:"gamesPlayed,omitempty"`
-	FantasyPointsPerGame *float64       `json:"fantasyPointsPerGame,omitempty"`
+	ID        string `json:"id"`
+	Year      int    `json:"year"`
+	SportType string `json:"sportType"`
+	// The season's totals, shaped by the sport the player plays
+	Stats                PlayerStats `json:"stats,omitempty"`
+	FantasyPoints        float64     `json:"fantasyPoints"`
+	GamesPlayed          *int        `json:"gamesPlayed,omitempty"`
+	FantasyPointsPerGame *float64    `json:"fantasyPointsPerGame,omitempty"`
+	// The player's age that season
+	Age *int `json:"age,omitempty"`
+	// The player's skill that season, following their position's aging curve
+	Skill *float64 `json:"skill,omitempty"`
+}
+
+type InjurySeverity string
+
+const (
+	InjurySeverityMinor    InjurySeverity = "MINOR"
+	InjurySeverityModerate InjurySeverity = "MODERATE"
+	InjurySeveritySevere   InjurySeverity = "SEVERE"
+)
+
+var AllInjurySeverity = []InjurySeverity{
+	InjurySeverityMinor,
+	InjurySeverityModerate,
+	InjurySeveritySevere,
+}
+
+func (e InjurySeverity) IsValid() bool {
+	switch e {
+	case InjurySeverityMinor, InjurySeverityModerate, InjurySeveritySevere:
+		return true
+	}
+	return false
+}
+
+func (e InjurySeverity) String() string {
+	return string(e)
+}
+
+func (e *InjurySeverity) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = InjurySeverity(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid InjurySeverity", str)
+	}
+	return nil
+}
+
+func (e InjurySeverity) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *InjurySeverity) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e InjurySeverity) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
 }
 
 type PlayerStatus string
@@ -157,11 +542,31 @@ func (e PlayerStatus) MarshalJSON() ([]byte, error) {
 type Position string
 
 const (
-	PositionQb Position = "QB"
-	PositionRb Position = "RB"
-	PositionWr Position = "WR"
-	PositionTe Position = "TE"
-	PositionPk Position = "PK"
+	PositionQb         Position = "QB"
+	PositionRb         Position = "RB"
+	PositionWr         Position = "WR"
+	PositionTe         Position = "TE"
+	PositionPk         Position = "PK"
+	PositionDst        Position = "DST"
+	PositionDl         Position = "DL"
+	PositionLb         Position = "LB"
+	PositionDb         Position = "DB"
+	PositionPg         Position = "PG"
+	PositionSg         Position = "SG"
+	PositionSf         Position = "SF"
+	PositionPf         Position = "PF"
+	PositionC          Position = "C"
+	PositionSp         Position = "SP"
+	PositionRp         Position = "RP"
+	PositionCatcher    Position = "CATCHER"
+	PositionFirstBase  Position = "FIRST_BASE"
+	PositionSecondBase Position = "SECOND_BASE"
+	PositionThirdBase  Position = "THIRD_BASE"
+	PositionSs         Position = "SS"
+	PositionLf         Position = "LF"
+	PositionCf         Position = "CF"
+	PositionRf         Position = "RF"
+	PositionDh         Position = "DH"
 )
 
 var AllPosition = []Position{
@@ -170,11 +575,31 @@ var AllPosition = []Position{
 	PositionWr,
 	PositionTe,
 	PositionPk,
+	PositionDst,
+	PositionDl,
+	PositionLb,
+	PositionDb,
+	PositionPg,
+	PositionSg,
+	PositionSf,
+	PositionPf,
+	PositionC,
+	PositionSp,
+	PositionRp,
+	PositionCatcher,
+	PositionFirstBase,
+	PositionSecondBase,
+	PositionThirdBase,
+	PositionSs,
+	PositionLf,
+	PositionCf,
+	PositionRf,
+	PositionDh,
 }
 
 func (e Position) IsValid() bool {
 	switch e {
-	case PositionQb, PositionRb, PositionWr, PositionTe, PositionPk:
+	case PositionQb, PositionRb, PositionWr, PositionTe, PositionPk, PositionDst, PositionDl, PositionLb, PositionDb, PositionPg, PositionSg, PositionSf, PositionPf, PositionC, PositionSp, PositionRp, PositionCatcher, PositionFirstBase, PositionSecondBase, PositionThirdBase, PositionSs, PositionLf, PositionCf, PositionRf, PositionDh:
 		return true
 	}
 	return false
@@ -214,3 +639,355 @@ func (e Position) MarshalJSON() ([]byte, error) {
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
+
+type SeedingTiebreaker string
+
+const (
+	SeedingTiebreakerRecord     SeedingTiebreaker = "RECORD"
+	SeedingTiebreakerPointsFor  SeedingTiebreaker = "POINTS_FOR"
+	SeedingTiebreakerHeadToHead SeedingTiebreaker = "HEAD_TO_HEAD"
+)
+
+var AllSeedingTiebreaker = []SeedingTiebreaker{
+	SeedingTiebreakerRecord,
+	SeedingTiebreakerPointsFor,
+	SeedingTiebreakerHeadToHead,
+}
+
+func (e SeedingTiebreaker) IsValid() bool {
+	switch e {
+	case SeedingTiebreakerRecord, SeedingTiebreakerPointsFor, SeedingTiebreakerHeadToHead:
+		return true
+	}
+	return false
+}
+
+func (e SeedingTiebreaker) String() string {
+	return string(e)
+}
+
+func (e *SeedingTiebreaker) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SeedingTiebreaker(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SeedingTiebreaker", str)
+	}
+	return nil
+}
+
+func (e SeedingTiebreaker) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SeedingTiebreaker) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SeedingTiebreaker) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SportType string
+
+const (
+	SportTypeFootball   SportType = "FOOTBALL"
+	SportTypeBasketball SportType = "BASKETBALL"
+	SportTypeBaseball   SportType = "BASEBALL"
+)
+
+var AllSportType = []SportType{
+	SportTypeFootball,
+	SportTypeBasketball,
+	SportTypeBaseball,
+}
+
+func (e SportType) IsValid() bool {
+	switch e {
+	case SportTypeFootball, SportTypeBasketball, SportTypeBaseball:
+		return true
+	}
+	return false
+}
+
+func (e SportType) String() string {
+	return string(e)
+}
+
+func (e *SportType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SportType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SportType", str)
+	}
+	return nil
+}
+
+func (e SportType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SportType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SportType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TradeStatus string
+
+const (
+	TradeStatusProposed  TradeStatus = "PROPOSED"
+	TradeStatusCountered TradeStatus = "COUNTERED"
+	TradeStatusRejected  TradeStatus = "REJECTED"
+	TradeStatusCancelled TradeStatus = "CANCELLED"
+	TradeStatusInReview  TradeStatus = "IN_REVIEW"
+	TradeStatusVetoed    TradeStatus = "VETOED"
+	TradeStatusCompleted TradeStatus = "COMPLETED"
+	TradeStatusFailed    TradeStatus = "FAILED"
+)
+
+var AllTradeStatus = []TradeStatus{
+	TradeStatusProposed,
+	TradeStatusCountered,
+	TradeStatusRejected,
+	TradeStatusCancelled,
+	TradeStatusInReview,
+	TradeStatusVetoed,
+	TradeStatusCompleted,
+	TradeStatusFailed,
+}
+
+func (e TradeStatus) IsValid() bool {
+	switch e {
+	case TradeStatusProposed, TradeStatusCountered, TradeStatusRejected, TradeStatusCancelled, TradeStatusInReview, TradeStatusVetoed, TradeStatusCompleted, TradeStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e TradeStatus) String() string {
+	return string(e)
+}
+
+func (e *TradeStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TradeStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TradeStatus", str)
+	}
+	return nil
+}
+
+func (e TradeStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TradeStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TradeStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TransactionType string
+
+const (
+	TransactionTypeAdd   TransactionType = "ADD"
+	TransactionTypeDrop  TransactionType = "DROP"
+	TransactionTypeTrade TransactionType = "TRADE"
+)
+
+var AllTransactionType = []TransactionType{
+	TransactionTypeAdd,
+	TransactionTypeDrop,
+	TransactionTypeTrade,
+}
+
+func (e TransactionType) IsValid() bool {
+	switch e {
+	case TransactionTypeAdd, TransactionTypeDrop, TransactionTypeTrade:
+		return true
+	}
+	return false
+}
+
+func (e TransactionType) String() string {
+	return string(e)
+}
+
+func (e *TransactionType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TransactionType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TransactionType", str)
+	}
+	return nil
+}
+
+func (e TransactionType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TransactionType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TransactionType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type WaiverClaimStatus string
+
+const (
+	WaiverClaimStatusPending    WaiverClaimStatus = "PENDING"
+	WaiverClaimStatusSuccessful WaiverClaimStatus = "SUCCESSFUL"
+	WaiverClaimStatusFailed     WaiverClaimStatus = "FAILED"
+	WaiverClaimStatusCancelled  WaiverClaimStatus = "CANCELLED"
+)
+
+var AllWaiverClaimStatus = []WaiverClaimStatus{
+	WaiverClaimStatusPending,
+	WaiverClaimStatusSuccessful,
+	WaiverClaimStatusFailed,
+	WaiverClaimStatusCancelled,
+}
+
+func (e WaiverClaimStatus) IsValid() bool {
+	switch e {
+	case WaiverClaimStatusPending, WaiverClaimStatusSuccessful, WaiverClaimStatusFailed, WaiverClaimStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func (e WaiverClaimStatus) String() string {
+	return string(e)
+}
+
+func (e *WaiverClaimStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WaiverClaimStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WaiverClaimStatus", str)
+	}
+	return nil
+}
+
+func (e WaiverClaimStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WaiverClaimStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WaiverClaimStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type WaiverMode string
+
+const (
+	WaiverModeRolling WaiverMode = "ROLLING"
+	WaiverModeFaab    WaiverMode = "FAAB"
+)
+
+var AllWaiverMode = []WaiverMode{
+	WaiverModeRolling,
+	WaiverModeFaab,
+}
+
+func (e WaiverMode) IsValid() bool {
+	switch e {
+	case WaiverModeRolling, WaiverModeFaab:
+		return true
+	}
+	return false
+}
+
+func (e WaiverMode) String() string {
+	return string(e)
+}
+
+func (e *WaiverMode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WaiverMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WaiverMode", str)
+	}
+	return nil
+}
+
+func (e WaiverMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WaiverMode) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WaiverMode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
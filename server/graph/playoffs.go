@@ -0,0 +1,243 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"fantasy-draft/fantasy"
+	"fantasy-draft/graph/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// playoffGameColumns is the column list scanned by scanPlayoffGame
+const playoffGameColumns = `
+	id, round, bracket_position, home_seed, away_seed,
+	home_team_id, away_team_id, home_score, away_score, winner_team_id
+`
+
+// scanPlayoffGame scans a row selected with playoffGameColumns
+func scanPlayoffGame(row pgx.Row) (*model.PlayoffGame, error) {
+	var g model.PlayoffGame
+	var homeTeamID, awayTeamID, winnerTeamID *string
+
+	err := row.Scan(
+		&g.ID, &g.Round, &g.BracketPosition, &g.HomeSeed, &g.AwaySeed,
+		&homeTeamID, &awayTeamID, &g.HomeScore, &g.AwayScore, &winnerTeamID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if homeTeamID != nil {
+		g.HomeTeamID = *homeTeamID
+	}
+	if awayTeamID != nil {
+		g.AwayTeamID = *awayTeamID
+	}
+	if winnerTeamID != nil {
+		g.WinnerTeamID = *winnerTeamID
+		g.IsComplete = true
+	}
+	return &g, nil
+}
+
+// loadOptionalFantasyTeam loads a team for a nullable reference, returning nil when it is unset
+func loadOptionalFantasyTeam(ctx context.Context, q querier, id string) (*model.FantasyTeam, error) {
+	if id == "" {
+		return nil, nil
+	}
+	return loadFantasyTeam(ctx, q, id)
+}
+
+// loadPlayoffGames returns every game in a draft room's bracket, round by round
+func loadPlayoffGames(ctx context.Context, q querier, draftRoomID string) ([]*model.PlayoffGame, error) {
+	rows, err := q.Query(ctx,
+		"SELECT "+playoffGameColumns+" FROM fantasy_playoff_games WHERE draft_room_id = $1 ORDER BY round, bracket_position",
+		draftRoomID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var games []*model.PlayoffGame
+	for rows.Next() {
+		g, err := scanPlayoffGame(rows)
+		if err != nil {
+			return nil, err
+		}
+		games = append(games, g)
+	}
+	return games, rows.Err()
+}
+
+// loadPlayoffSource returns the game whose winner fills the given slot, or nil for a seeded slot
+func loadPlayoffSource(ctx context.Context, q querier, gameID string, slot string) (*model.PlayoffGame, error) {
+	game, err := scanPlayoffGame(q.QueryRow(ctx,
+		"SELECT "+playoffGameColumns+" FROM fantasy_playoff_games WHERE next_game_id = $1 AND next_slot = $2",
+		gameID, slot))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return game, err
+}
+
+// createPlayoffBracket seeds the final regular season standings into a new bracket.
+// Games are inserted final-first so each game's next_game_id already exists.
+func createPlayoffBracket(ctx context.Context, tx pgx.Tx, season *model.FantasySeason, settings fantasy.PlayoffSettings) error {
+	teamIDs, err := loadFantasyTeamIDs(ctx, tx, season.DraftRoomID)
+	if err != nil {
+		return err
+	}
+	results, err := loadRegularSeasonResults(ctx, tx, season)
+	if err != nil {
+		return err
+	}
+
+	seeded := fantasy.SeedTeams(fantasy.ComputeStandings(teamIDs, results), results, settings.Tiebreakers)
+	if len(seeded) < settings.Teams {
+		return fmt.Errorf("only %d teams available for a %d team bracket", len(seeded), settings.Teams)
+	}
+
+	teamForSeed := func(seed int) *string {
+		if seed == 0 {
+			return nil
+		}
+		return &seeded[seed-1].TeamID
+	}
+	seedOrNil := func(seed int) *int {
+		if seed == 0 {
+			return nil
+		}
+		return &seed
+	}
+
+	bracket := fantasy.BuildBracket(settings)
+	ids := make([]string, len(bracket))
+	for i := len(bracket) - 1; i >= 0; i-- {
+		game := bracket[i]
+
+		var nextGameID, nextSlot *string
+		if game.NextGame >= 0 {
+			nextGameID = &ids[game.NextGame]
+			nextSlot = &game.NextSlot
+		}
+
+		err := tx.QueryRow(ctx, `
+			INSERT INTO fantasy_playoff_games (
+				draft_room_id, round, bracket_position, home_seed, away_seed,
+				home_team_id, away_team_id, next_game_id, next_slot
+			)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+			RETURNING id
+		`, season.DraftRoomID, game.Round, game.Position, seedOrNil(game.HomeSeed), seedOrNil(game.AwaySeed),
+			teamForSeed(game.HomeSeed), teamForSeed(game.AwaySeed), nextGameID, nextSlot).Scan(&ids[i])
+		if err != nil {
+			return fmt.Errorf("failed to insert round %d playoff game: %w", game.Round, err)
+		}
+	}
+	return nil
+}
+
+// schedulePlayoffWeek creates this week's matchups for every game in the round
+func schedulePlayoffWeek(ctx context.Context, tx pgx.Tx, draftRoomID string, round, week int) error {
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO fantasy_matchups (draft_room_id, week, home_team_id, away_team_id, playoff_game_id)
+		SELECT draft_room_id, $3, home_team_id, away_team_id, id
+		FROM fantasy_playoff_games
+		WHERE draft_room_id = $1 AND round = $2
+	`, draftRoomID, round, week)
+	if err != nil {
+		return fmt.Errorf("failed to schedule playoff week %d: %w", week, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("no playoff games found for round %d", round)
+	}
+	return nil
+}
+
+// settlePlayoffWeek rolls the week's matchup scores into each game's running total.
+// On the last week of a round it decides each game, advances the winners and,
+// after the final, records the draft room's champion.
+func settlePlayoffWeek(ctx context.Context, tx pgx.Tx, draftRoomID string, round int, lastWeekOfRound bool) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE fantasy_playoff_games g
+		SET home_score = totals.home_score, away_score = totals.away_score
+		FROM (
+			SELECT playoff_game_id, SUM(home_score) AS home_score, SUM(away_score) AS away_score
+			FROM fantasy_matchups
+			WHERE draft_room_id = $1 AND playoff_game_id IS NOT NULL AND is_complete
+			GROUP BY playoff_game_id
+		) totals
+		WHERE g.id = totals.playoff_game_id AND g.round = $2
+	`, draftRoomID, round)
+	if err != nil {
+		return fmt.Errorf("failed to total playoff scores: %w", err)
+	}
+	if !lastWeekOfRound {
+		return nil
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT id, home_team_id, away_team_id, home_seed, away_seed, home_score, away_score, next_game_id, next_slot
+		FROM fantasy_playoff_games
+		WHERE draft_room_id = $1 AND round = $2
+	`, draftRoomID, round)
+	if err != nil {
+		return err
+	}
+
+	type decidedGame struct {
+		id, winnerID         string
+		winnerSeed           int
+		nextGameID, nextSlot *string
+	}
+	var decided []decidedGame
+	for rows.Next() {
+		var id, homeTeamID, awayTeamID string
+		var homeSeed, awaySeed int
+		var homeScore, awayScore float64
+		var g decidedGame
+		if err := rows.Scan(&id, &homeTeamID, &awayTeamID, &homeSeed, &awaySeed, &homeScore, &awayScore, &g.nextGameID, &g.nextSlot); err != nil {
+			rows.Close()
+			return err
+		}
+
+		g.id = id
+		g.winnerID, g.winnerSeed = awayTeamID, awaySeed
+		if fantasy.HomeAdvances(homeScore, awayScore, homeSeed, awaySeed) {
+			g.winnerID, g.winnerSeed = homeTeamID, homeSeed
+		}
+		decided = append(decided, g)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, g := range decided {
+		if _, err := tx.Exec(ctx, "UPDATE fantasy_playoff_games SET winner_team_id = $2 WHERE id = $1", g.id, g.winnerID); err != nil {
+			return fmt.Errorf("failed to record playoff winner: %w", err)
+		}
+
+		switch {
+		case g.nextGameID == nil:
+			_, err = tx.Exec(ctx, `
+				UPDATE fantasy_seasons
+				SET champion_team_id = $2, updated_at = NOW()
+				WHERE draft_room_id = $1
+			`, draftRoomID, g.winnerID)
+		case *g.nextSlot == fantasy.SlotHome:
+			_, err = tx.Exec(ctx, "UPDATE fantasy_playoff_games SET home_team_id = $2, home_seed = $3 WHERE id = $1",
+				*g.nextGameID, g.winnerID, g.winnerSeed)
+		default:
+			_, err = tx.Exec(ctx, "UPDATE fantasy_playoff_games SET away_team_id = $2, away_seed = $3 WHERE id = $1",
+				*g.nextGameID, g.winnerID, g.winnerSeed)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to advance playoff winner: %w", err)
+		}
+	}
+	return nil
+}
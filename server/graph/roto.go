@@ -0,0 +1,111 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"fantasy-draft/fantasy"
+	"fantasy-draft/graph/model"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// loadRotoStandings ranks a baseball draft room's teams in the standard rotisserie categories
+// on the totals of the players in their starting lineups for one pro season. Without a year
+// the latest baseball season anyone in the room's universe played in is used.
+func loadRotoStandings(ctx context.Context, q querier, draftRoomID string, year *int) ([]*model.RotoStanding, error) {
+	var sportType string
+	err := q.QueryRow(ctx, "SELECT sport_type FROM draft_rooms WHERE id = $1", draftRoomID).Scan(&sportType)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("draft room %s not found", draftRoomID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if model.SportType(sportType) != model.SportTypeBaseball {
+		return nil, fmt.Errorf("rotisserie standings are only kept for baseball draft rooms, this one is %s", sportType)
+	}
+
+	if year == nil {
+		var latest *int
+		err := q.QueryRow(ctx, `
+			SELECT MAX(ys.year)
+			FROM yearly_stats ys
+			JOIN players p ON p.id = ys.player_id
+			JOIN pro_teams pt ON pt.id = p.team_id
+			WHERE ys.sport_type = 'BASEBALL' AND ys.games_played > 0
+			  AND pt.universe_id = (SELECT universe_id FROM draft_rooms WHERE id = $1)
+		`, draftRoomID).Scan(&latest)
+		if err != nil {
+			return nil, err
+		}
+		if latest == nil {
+			return []*model.RotoStanding{}, nil
+		}
+		year = latest
+	}
+
+	teamIDs, err := loadFantasyTeamIDs(ctx, q, draftRoomID)
+	if err != nil {
+		return nil, err
+	}
+	totals, err := loadStarterBaseballTotals(ctx, q, draftRoomID, *year)
+	if err != nil {
+		return nil, err
+	}
+
+	var standings []*model.RotoStanding
+	for i, s := range fantasy.ComputeRotoStandings(teamIDs, totals, fantasy.StandardBaseballCategories) {
+		standing := &model.RotoStanding{
+			Rank:   i + 1,
+			TeamID: s.TeamID,
+			Points: s.Points,
+		}
+		for _, c := range s.Categories {
+			score := &model.RotoCategoryScore{Category: c.Category, Points: c.Points}
+			if c.HasValue {
+				value := c.Value
+				score.Value = &value
+			}
+			standing.Categories = append(standing.Categories, score)
+		}
+		standings = append(standings, standing)
+	}
+	return standings, nil
+}
+
+// loadStarterBaseballTotals sums one season's yearly_stats of each team's starting lineup
+func loadStarterBaseballTotals(ctx context.Context, q querier, draftRoomID string, year int) (map[string]model.BaseballStats, error) {
+	rows, err := q.Query(ctx, `
+		SELECT fr.fantasy_team_id, fr.roster_spot, ys.stats
+		FROM fantasy_rosters fr
+		JOIN fantasy_teams ft ON ft.id = fr.fantasy_team_id
+		JOIN yearly_stats ys ON ys.player_id = fr.player_id AND ys.year = $2 AND ys.sport_type = 'BASEBALL'
+		WHERE ft.draft_room_id = $1
+	`, draftRoomID, year)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	totals := make(map[string]model.BaseballStats)
+	for rows.Next() {
+		var teamID, spot string
+		var statsJSON []byte
+		if err := rows.Scan(&teamID, &spot, &statsJSON); err != nil {
+			return nil, err
+		}
+		if !fantasy.IsStartingSpot(spot) || statsJSON == nil {
+			continue
+		}
+
+		var stats model.BaseballStats
+		if err := json.Unmarshal(statsJSON, &stats); err != nil {
+			return nil, fmt.Errorf("failed to decode yearly stats: %w", err)
+		}
+		totals[teamID] = fantasy.AddBaseballStats(totals[teamID], stats)
+	}
+	return totals, rows.Err()
+}
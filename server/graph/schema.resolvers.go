@@ -7,7 +7,7 @@ package graph
 
 import (
 	"context"
-	"encoding/json"
+	"fantasy-draft/fantasy"
 	"fantasy-draft/graph/model"
 )
 
@@ -30,6 +30,11 @@ func (r *conferenceResolver) Divisions(ctx context.Context, obj *model.Conferenc
 	return divisions, nil
 }
 
+// Player is the resolver for the player field.
+func (r *depthChartEntryResolver) Player(ctx context.Context, obj *model.DepthChartEntry) (*model.Player, error) {
+	return loadPlayer(ctx, r.DB, obj.PlayerID)
+}
+
 // Conference is the resolver for the conference field.
 func (r *divisionResolver) Conference(ctx context.Context, obj *model.Division) (*model.Conference, error) {
 	var c model.Conference
@@ -48,7 +53,7 @@ func (r *divisionResolver) Conference(ctx context.Context, obj *model.Division)
 // Teams is the resolver for the teams field.
 func (r *divisionResolver) Teams(ctx context.Context, obj *model.Division) ([]*model.Team, error) {
 	rows, err := r.DB.Query(ctx, `
-		SELECT id, city, state, name, abbreviation 
+		SELECT id, city, state, name, abbreviation, sport_type
 		FROM pro_teams 
 		WHERE division_id = $1 
 		ORDER BY city, name
@@ -61,7 +66,7 @@ func (r *divisionResolver) Teams(ctx context.Context, obj *model.Division) ([]*m
 	var teams []*model.Team
 	for rows.Next() {
 		var t model.Team
-		if err := rows.Scan(&t.ID, &t.City, &t.State, &t.Name, &t.Abbreviation); err != nil {
+		if err := rows.Scan(&t.ID, &t.City, &t.State, &t.Name, &t.Abbreviation, &t.SportType); err != nil {
 			return nil, err
 		}
 		teams = append(teams, &t)
@@ -69,6 +74,388 @@ func (r *divisionResolver) Teams(ctx context.Context, obj *model.Division) ([]*m
 	return teams, nil
 }
 
+// Champion is the resolver for the champion field.
+func (r *fantasySeasonResolver) Champion(ctx context.Context, obj *model.FantasySeason) (*model.FantasyTeam, error) {
+	return loadOptionalFantasyTeam(ctx, r.DB, obj.ChampionTeamID)
+}
+
+// Roster is the resolver for the roster field.
+func (r *fantasyTeamResolver) Roster(ctx context.Context, obj *model.FantasyTeam) ([]*model.RosterEntry, error) {
+	return loadRosterEntries(ctx, r.DB, obj.ID)
+}
+
+// Team is the resolver for the team field.
+func (r *fantasyTransactionResolver) Team(ctx context.Context, obj *model.FantasyTransaction) (*model.FantasyTeam, error) {
+	return loadFantasyTeam(ctx, r.DB, obj.TeamID)
+}
+
+// Player is the resolver for the player field.
+func (r *fantasyTransactionResolver) Player(ctx context.Context, obj *model.FantasyTransaction) (*model.Player, error) {
+	return loadPlayer(ctx, r.DB, obj.PlayerID)
+}
+
+// Trade is the resolver for the trade field.
+func (r *fantasyTransactionResolver) Trade(ctx context.Context, obj *model.FantasyTransaction) (*model.Trade, error) {
+	if obj.TradeID == "" {
+		return nil, nil
+	}
+	return loadTrade(ctx, r.DB, obj.TradeID)
+}
+
+// HomeTeam is the resolver for the homeTeam field.
+func (r *matchupResolver) HomeTeam(ctx context.Context, obj *model.Matchup) (*model.FantasyTeam, error) {
+	return loadFantasyTeam(ctx, r.DB, obj.HomeTeamID)
+}
+
+// AwayTeam is the resolver for the awayTeam field.
+func (r *matchupResolver) AwayTeam(ctx context.Context, obj *model.Matchup) (*model.FantasyTeam, error) {
+	return loadFantasyTeam(ctx, r.DB, obj.AwayTeamID)
+}
+
+// Winner is the resolver for the winner field.
+func (r *matchupResolver) Winner(ctx context.Context, obj *model.Matchup) (*model.FantasyTeam, error) {
+	if !obj.IsComplete || obj.HomeScore == nil || obj.AwayScore == nil {
+		return nil, nil
+	}
+	switch {
+	case *obj.HomeScore > *obj.AwayScore:
+		return loadFantasyTeam(ctx, r.DB, obj.HomeTeamID)
+	case *obj.AwayScore > *obj.HomeScore:
+		return loadFantasyTeam(ctx, r.DB, obj.AwayTeamID)
+	default:
+		// Ties have no winner
+		return nil, nil
+	}
+}
+
+// GenerateSchedule is the resolver for the generateSchedule field.
+func (r *mutationResolver) GenerateSchedule(ctx context.Context, draftRoomID string, regularSeasonWeeks *int, playoffs *model.PlayoffSettingsInput, waivers *model.WaiverSettingsInput, trades *model.TradeSettingsInput) (*model.FantasySeason, error) {
+	weeks := fantasy.DefaultRegularSeasonWeeks
+	if regularSeasonWeeks != nil {
+		weeks = *regularSeasonWeeks
+	}
+
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	season, err := createFantasySeason(ctx, tx, draftRoomID, weeks, playoffs, waivers, trades)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return season, nil
+}
+
+// SimulateWeek is the resolver for the simulateWeek field.
+func (r *mutationResolver) SimulateWeek(ctx context.Context, draftRoomID string) ([]*model.Matchup, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	matchups, err := simulateFantasyWeek(ctx, tx, draftRoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return matchups, nil
+}
+
+// SetLineup is the resolver for the setLineup field.
+func (r *mutationResolver) SetLineup(ctx context.Context, teamID string, moves []*model.LineupMoveInput) ([]*model.RosterEntry, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	entries, err := setLineup(ctx, tx, teamID, moves)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// OptimizeLineup is the resolver for the optimizeLineup field.
+func (r *mutationResolver) OptimizeLineup(ctx context.Context, teamID string, week int) ([]*model.RosterEntry, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	entries, err := optimizeLineup(ctx, tx, teamID, week)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// SubmitWaiverClaim is the resolver for the submitWaiverClaim field.
+func (r *mutationResolver) SubmitWaiverClaim(ctx context.Context, teamID string, addPlayerID string, dropPlayerID *string, bid *int) (*model.WaiverClaim, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	claim, err := submitWaiverClaim(ctx, tx, teamID, addPlayerID, dropPlayerID, bid)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return claim, nil
+}
+
+// CancelWaiverClaim is the resolver for the cancelWaiverClaim field.
+func (r *mutationResolver) CancelWaiverClaim(ctx context.Context, claimID string) (*model.WaiverClaim, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	claim, err := cancelWaiverClaim(ctx, tx, claimID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return claim, nil
+}
+
+// AddFreeAgent is the resolver for the addFreeAgent field.
+func (r *mutationResolver) AddFreeAgent(ctx context.Context, teamID string, playerID string, dropPlayerID *string) ([]*model.FantasyTransaction, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	transactions, err := addFreeAgent(ctx, tx, teamID, playerID, dropPlayerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return transactions, nil
+}
+
+// DropPlayer is the resolver for the dropPlayer field.
+func (r *mutationResolver) DropPlayer(ctx context.Context, teamID string, playerID string) (*model.FantasyTransaction, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	transaction, err := dropPlayer(ctx, tx, teamID, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// ProcessWaivers is the resolver for the processWaivers field.
+func (r *mutationResolver) ProcessWaivers(ctx context.Context, draftRoomID string) ([]*model.WaiverClaim, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	claims, err := processWaiverClaims(ctx, tx, draftRoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// ProposeTrade is the resolver for the proposeTrade field.
+func (r *mutationResolver) ProposeTrade(ctx context.Context, proposingTeamID string, receivingTeamID string, offeredPlayerIds []string, requestedPlayerIds []string) (*model.Trade, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	trade, err := proposeTrade(ctx, tx, proposingTeamID, receivingTeamID, offeredPlayerIds, requestedPlayerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
+
+// CounterTrade is the resolver for the counterTrade field.
+func (r *mutationResolver) CounterTrade(ctx context.Context, tradeID string, offeredPlayerIds []string, requestedPlayerIds []string) (*model.Trade, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	trade, err := counterTrade(ctx, tx, tradeID, offeredPlayerIds, requestedPlayerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
+
+// AcceptTrade is the resolver for the acceptTrade field.
+func (r *mutationResolver) AcceptTrade(ctx context.Context, tradeID string) (*model.Trade, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	trade, err := acceptTrade(ctx, tx, tradeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
+
+// RejectTrade is the resolver for the rejectTrade field.
+func (r *mutationResolver) RejectTrade(ctx context.Context, tradeID string) (*model.Trade, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	trade, err := closeProposal(ctx, tx, tradeID, model.TradeStatusRejected)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
+
+// CancelTrade is the resolver for the cancelTrade field.
+func (r *mutationResolver) CancelTrade(ctx context.Context, tradeID string) (*model.Trade, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	trade, err := closeProposal(ctx, tx, tradeID, model.TradeStatusCancelled)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
+
+// VoteToVetoTrade is the resolver for the voteToVetoTrade field.
+func (r *mutationResolver) VoteToVetoTrade(ctx context.Context, tradeID string, teamID string) (*model.Trade, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	trade, err := voteToVetoTrade(ctx, tx, tradeID, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
+
+// ApproveTrade is the resolver for the approveTrade field.
+func (r *mutationResolver) ApproveTrade(ctx context.Context, tradeID string) (*model.Trade, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	trade, err := reviewTrade(ctx, tx, tradeID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
+
+// VetoTrade is the resolver for the vetoTrade field.
+func (r *mutationResolver) VetoTrade(ctx context.Context, tradeID string) (*model.Trade, error) {
+	tx, err := r.DB.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	trade, err := reviewTrade(ctx, tx, tradeID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return trade, nil
+}
+
 // FullName resolves the fullName field on Player
 func (r *playerResolver) FullName(ctx context.Context, obj *model.Player) (string, error) {
 	return obj.FirstName + " " + obj.LastName, nil
@@ -78,20 +465,25 @@ func (r *playerResolver) FullName(ctx context.Context, obj *model.Player) (strin
 func (r *playerResolver) Team(ctx context.Context, obj *model.Player) (*model.Team, error) {
 	var t model.Team
 	err := r.DB.QueryRow(ctx, `
-		SELECT id, city, state, name, abbreviation 
+		SELECT id, city, state, name, abbreviation, sport_type
 		FROM pro_teams 
 		WHERE id = $1
-	`, obj.TeamID).Scan(&t.ID, &t.City, &t.State, &t.Name, &t.Abbreviation)
+	`, obj.TeamID).Scan(&t.ID, &t.City, &t.State, &t.Name, &t.Abbreviation, &t.SportType)
 	if err != nil {
 		return nil, err
 	}
 	return &t, nil
 }
 
+// DepthChartRank is the resolver for the depthChartRank field.
+func (r *playerResolver) DepthChartRank(ctx context.Context, obj *model.Player) (*int, error) {
+	return loadDepthChartRank(ctx, r.DB, obj.ID)
+}
+
 // YearlyStats resolves the yearlyStats field on Player
 func (r *playerResolver) YearlyStats(ctx context.Context, obj *model.Player) ([]*model.YearlyStat, error) {
 	rows, err := r.DB.Query(ctx, `
-		SELECT id, year, sport_type, stats, fantasy_points, games_played, fantasy_points_per_game
+		SELECT id, year, sport_type, stats, fantasy_points, games_played, fantasy_points_per_game, age, skill
 		FROM yearly_stats 
 		WHERE player_id = $1 
 		ORDER BY year DESC
@@ -108,19 +500,25 @@ func (r *playerResolver) YearlyStats(ctx context.Context, obj *model.Player) ([]
 		var gamesPlayed *int
 		var fpPerGame *float64
 
-		if err := rows.Scan(&s.ID, &s.Year, &s.SportType, &statsJSON, &s.FantasyPoints, &gamesPlayed, &fpPerGame); err != nil {
+		if err := rows.Scan(&s.ID, &s.Year, &s.SportType, &statsJSON, &s.FantasyPoints, &gamesPlayed, &fpPerGame, &s.Age, &s.Skill); err != nil {
 			return nil, err
 		}
 
 		s.GamesPlayed = gamesPlayed
 		s.FantasyPointsPerGame = fpPerGame
 
-		// Parse the JSON stats
+		// A rookie's true skill stays hidden until they've played a season
+		if obj.ScoutedSkill != nil && obj.YearsOfExperience != nil && *obj.YearsOfExperience == 0 {
+			s.Skill = obj.ScoutedSkill
+		}
+
+		// Parse the JSON stats into the player's sport
 		if statsJSON != nil {
-			var footballStats model.FootballStats
-			if err := json.Unmarshal(statsJSON, &footballStats); err == nil {
-				s.Stats = &footballStats
+			playerStats, err := decodeStats(s.SportType, statsJSON)
+			if err != nil {
+				return nil, err
 			}
+			s.Stats = playerStats
 		}
 
 		stats = append(stats, &s)
@@ -128,9 +526,68 @@ func (r *playerResolver) YearlyStats(ctx context.Context, obj *model.Player) ([]
 	return stats, nil
 }
 
+// Injuries is the resolver for the injuries field.
+func (r *playerResolver) Injuries(ctx context.Context, obj *model.Player) ([]*model.Injury, error) {
+	return loadInjuries(ctx, r.DB, obj.ID)
+}
+
+// InjuryRisk is the resolver for the injuryRisk field.
+func (r *playerResolver) InjuryRisk(ctx context.Context, obj *model.Player) (float64, error) {
+	return loadInjuryRisk(ctx, r.DB, obj)
+}
+
+// Champion is the resolver for the champion field.
+func (r *playoffBracketResolver) Champion(ctx context.Context, obj *model.PlayoffBracket) (*model.FantasyTeam, error) {
+	return loadOptionalFantasyTeam(ctx, r.DB, obj.ChampionTeamID)
+}
+
+// HomeTeam is the resolver for the homeTeam field.
+func (r *playoffGameResolver) HomeTeam(ctx context.Context, obj *model.PlayoffGame) (*model.FantasyTeam, error) {
+	return loadOptionalFantasyTeam(ctx, r.DB, obj.HomeTeamID)
+}
+
+// AwayTeam is the resolver for the awayTeam field.
+func (r *playoffGameResolver) AwayTeam(ctx context.Context, obj *model.PlayoffGame) (*model.FantasyTeam, error) {
+	return loadOptionalFantasyTeam(ctx, r.DB, obj.AwayTeamID)
+}
+
+// Winner is the resolver for the winner field.
+func (r *playoffGameResolver) Winner(ctx context.Context, obj *model.PlayoffGame) (*model.FantasyTeam, error) {
+	return loadOptionalFantasyTeam(ctx, r.DB, obj.WinnerTeamID)
+}
+
+// HomeSource is the resolver for the homeSource field.
+func (r *playoffGameResolver) HomeSource(ctx context.Context, obj *model.PlayoffGame) (*model.PlayoffGame, error) {
+	return loadPlayoffSource(ctx, r.DB, obj.ID, fantasy.SlotHome)
+}
+
+// AwaySource is the resolver for the awaySource field.
+func (r *playoffGameResolver) AwaySource(ctx context.Context, obj *model.PlayoffGame) (*model.PlayoffGame, error) {
+	return loadPlayoffSource(ctx, r.DB, obj.ID, fantasy.SlotAway)
+}
+
+// Universes is the resolver for the universes field.
+func (r *queryResolver) Universes(ctx context.Context) ([]*model.Universe, error) {
+	rows, err := r.DB.Query(ctx, "SELECT id, name FROM universes ORDER BY name")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var universes []*model.Universe
+	for rows.Next() {
+		var u model.Universe
+		if err := rows.Scan(&u.ID, &u.Name); err != nil {
+			return nil, err
+		}
+		universes = append(universes, &u)
+	}
+	return universes, nil
+}
+
 // Conferences is the resolver for the conferences field.
-func (r *queryResolver) Conferences(ctx context.Context) ([]*model.Conference, error) {
-	rows, err := r.DB.Query(ctx, "SELECT id, name FROM conferences ORDER BY name")
+func (r *queryResolver) Conferences(ctx context.Context, universeID *string) ([]*model.Conference, error) {
+	rows, err := r.DB.Query(ctx, "SELECT id, name FROM conferences WHERE $1::uuid IS NULL OR universe_id = $1 ORDER BY name", universeID)
 	if err != nil {
 		return nil, err
 	}
@@ -189,12 +646,13 @@ func (r *queryResolver) Division(ctx context.Context, id string) (*model.Divisio
 }
 
 // Teams is the resolver for the teams field.
-func (r *queryResolver) Teams(ctx context.Context) ([]*model.Team, error) {
+func (r *queryResolver) Teams(ctx context.Context, universeID *string) ([]*model.Team, error) {
 	rows, err := r.DB.Query(ctx, `
-		SELECT id, city, state, name, abbreviation, division_id 
+		SELECT id, city, state, name, abbreviation, sport_type, division_id 
 		FROM pro_teams 
+		WHERE $1::uuid IS NULL OR universe_id = $1
 		ORDER BY city, name
-	`)
+	`, universeID)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +662,7 @@ func (r *queryResolver) Teams(ctx context.Context) ([]*model.Team, error) {
 	for rows.Next() {
 		var t model.Team
 		var divID string
-		if err := rows.Scan(&t.ID, &t.City, &t.State, &t.Name, &t.Abbreviation, &divID); err != nil {
+		if err := rows.Scan(&t.ID, &t.City, &t.State, &t.Name, &t.Abbreviation, &t.SportType, &divID); err != nil {
 			return nil, err
 		}
 		teams = append(teams, &t)
@@ -217,10 +675,10 @@ func (r *queryResolver) Team(ctx context.Context, id string) (*model.Team, error
 	var t model.Team
 	var divID string
 	err := r.DB.QueryRow(ctx, `
-		SELECT id, city, state, name, abbreviation, division_id 
+		SELECT id, city, state, name, abbreviation, sport_type, division_id 
 		FROM pro_teams 
 		WHERE id = $1
-	`, id).Scan(&t.ID, &t.City, &t.State, &t.Name, &t.Abbreviation, &divID)
+	`, id).Scan(&t.ID, &t.City, &t.State, &t.Name, &t.Abbreviation, &t.SportType, &divID)
 	if err != nil {
 		return nil, err
 	}
@@ -228,11 +686,12 @@ func (r *queryResolver) Team(ctx context.Context, id string) (*model.Team, error
 }
 
 // Players is the resolver for the players field.
-func (r *queryResolver) Players(ctx context.Context, position *model.Position, teamID *string, limit *int, offset *int) ([]*model.Player, error) {
+func (r *queryResolver) Players(ctx context.Context, position *model.Position, teamID *string, universeID *string, limit *int, offset *int) ([]*model.Player, error) {
 	// Build dynamic query based on filters
 	query := `
 		SELECT id, first_name, last_name, position, team_id, height, weight, age, 
-		       years_of_experience, draft_year, jersey_number, status, skill 
+		       years_of_experience, draft_year, jersey_number, status, skill,
+		       scouted_skill, draft_round, draft_pick
 		FROM players 
 		WHERE 1=1
 	`
@@ -241,7 +700,7 @@ func (r *queryResolver) Players(ctx context.Context, position *model.Position, t
 
 	if position != nil {
 		query += " AND position = $" + string(rune('0'+argNum))
-		args = append(args, position.String())
+		args = append(args, positionToDB(*position))
 		argNum++
 	}
 
@@ -251,6 +710,12 @@ func (r *queryResolver) Players(ctx context.Context, position *model.Position, t
 		argNum++
 	}
 
+	if universeID != nil {
+		query += " AND team_id IN (SELECT id FROM pro_teams WHERE universe_id = $" + string(rune('0'+argNum)) + ")"
+		args = append(args, *universeID)
+		argNum++
+	}
+
 	query += " ORDER BY last_name, first_name"
 
 	if limit != nil {
@@ -275,42 +740,145 @@ func (r *queryResolver) Players(ctx context.Context, position *model.Position, t
 
 // Player is the resolver for the player field.
 func (r *queryResolver) Player(ctx context.Context, id string) (*model.Player, error) {
+	return loadPlayer(ctx, r.DB, id)
+}
+
+// SearchPlayers is the resolver for the searchPlayers field.
+func (r *queryResolver) SearchPlayers(ctx context.Context, query string, limit *int) ([]*model.Player, error) {
+	queryLimit := 20
+	if limit != nil {
+		queryLimit = *limit
+	}
+
 	rows, err := r.DB.Query(ctx, `
 		SELECT id, first_name, last_name, position, team_id, height, weight, age, 
-		       years_of_experience, draft_year, jersey_number, status, skill 
+		       years_of_experience, draft_year, jersey_number, status, skill,
+		       scouted_skill, draft_round, draft_pick
 		FROM players 
-		WHERE id = $1
-	`, id)
+		WHERE first_name ILIKE $1 OR last_name ILIKE $1 OR (first_name || ' ' || last_name) ILIKE $1
+		ORDER BY last_name, first_name
+		LIMIT $2
+	`, "%"+query+"%", queryLimit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	players, err := scanPlayers(rows)
+	return scanPlayers(rows)
+}
+
+// FantasySeason is the resolver for the fantasySeason field.
+func (r *queryResolver) FantasySeason(ctx context.Context, draftRoomID string) (*model.FantasySeason, error) {
+	return loadFantasySeason(ctx, r.DB, draftRoomID)
+}
+
+// Standings is the resolver for the standings field.
+func (r *queryResolver) Standings(ctx context.Context, draftRoomID string) ([]*model.Standing, error) {
+	season, err := loadFantasySeason(ctx, r.DB, draftRoomID)
+	if err != nil {
+		return nil, err
+	}
+	if season == nil {
+		return []*model.Standing{}, nil
+	}
+
+	teamIDs, err := loadFantasyTeamIDs(ctx, r.DB, draftRoomID)
+	if err != nil {
+		return nil, err
+	}
+	results, err := loadRegularSeasonResults(ctx, r.DB, season)
+	if err != nil {
+		return nil, err
+	}
+
+	var standings []*model.Standing
+	for i, s := range fantasy.ComputeStandings(teamIDs, results) {
+		standings = append(standings, &model.Standing{
+			Rank:          i + 1,
+			TeamID:        s.TeamID,
+			Wins:          s.Wins,
+			Losses:        s.Losses,
+			Ties:          s.Ties,
+			PointsFor:     s.PointsFor,
+			PointsAgainst: s.PointsAgainst,
+		})
+	}
+	return standings, nil
+}
+
+// RotoStandings is the resolver for the rotoStandings field.
+func (r *queryResolver) RotoStandings(ctx context.Context, draftRoomID string, year *int) ([]*model.RotoStanding, error) {
+	return loadRotoStandings(ctx, r.DB, draftRoomID, year)
+}
+
+// Matchups is the resolver for the matchups field.
+func (r *queryResolver) Matchups(ctx context.Context, draftRoomID string, week int) ([]*model.Matchup, error) {
+	return loadMatchups(ctx, r.DB, draftRoomID, week)
+}
+
+// PlayoffBracket is the resolver for the playoffBracket field.
+func (r *queryResolver) PlayoffBracket(ctx context.Context, draftRoomID string) (*model.PlayoffBracket, error) {
+	season, err := loadFantasySeason(ctx, r.DB, draftRoomID)
 	if err != nil {
 		return nil, err
 	}
-	if len(players) == 0 {
+	if season == nil {
 		return nil, nil
 	}
-	return players[0], nil
+
+	games, err := loadPlayoffGames(ctx, r.DB, draftRoomID)
+	if err != nil {
+		return nil, err
+	}
+	if len(games) == 0 {
+		// The bracket is created once the regular season finishes
+		return nil, nil
+	}
+
+	final := games[len(games)-1]
+	return &model.PlayoffBracket{
+		Rounds:         final.Round,
+		Games:          games,
+		Final:          final,
+		ChampionTeamID: season.ChampionTeamID,
+	}, nil
 }
 
-// SearchPlayers is the resolver for the searchPlayers field.
-func (r *queryResolver) SearchPlayers(ctx context.Context, query string, limit *int) ([]*model.Player, error) {
-	queryLimit := 20
+// FreeAgents is the resolver for the freeAgents field.
+func (r *queryResolver) FreeAgents(ctx context.Context, draftRoomID string, position *model.Position, limit *int, offset *int) ([]*model.Player, error) {
+	queryLimit := 50
 	if limit != nil {
 		queryLimit = *limit
 	}
+	queryOffset := 0
+	if offset != nil {
+		queryOffset = *offset
+	}
+	var positionFilter *string
+	if position != nil {
+		value := positionToDB(*position)
+		positionFilter = &value
+	}
 
 	rows, err := r.DB.Query(ctx, `
-		SELECT id, first_name, last_name, position, team_id, height, weight, age, 
-		       years_of_experience, draft_year, jersey_number, status, skill 
-		FROM players 
-		WHERE first_name ILIKE $1 OR last_name ILIKE $1 OR (first_name || ' ' || last_name) ILIKE $1
-		ORDER BY last_name, first_name
-		LIMIT $2
-	`, "%"+query+"%", queryLimit)
+		SELECT p.id, p.first_name, p.last_name, p.position, p.team_id, p.height, p.weight, p.age,
+		       p.years_of_experience, p.draft_year, p.jersey_number, p.status, p.skill,
+		       p.scouted_skill, p.draft_round, p.draft_pick
+		FROM players p
+		JOIN pro_teams pt ON pt.id = p.team_id
+		WHERE p.status <> 'RETIRED'
+		  AND (pt.sport_type, pt.universe_id) = (SELECT sport_type, universe_id FROM draft_rooms WHERE id = $1)
+		  AND ($2::position_enum IS NULL OR p.position = $2)
+		  AND NOT EXISTS (
+			SELECT 1
+			FROM fantasy_rosters fr
+			JOIN fantasy_teams ft ON ft.id = fr.fantasy_team_id
+			WHERE ft.draft_room_id = $1 AND fr.player_id = p.id
+		  )
+		ORDER BY CASE WHEN p.years_of_experience = 0 AND p.scouted_skill IS NOT NULL THEN p.scouted_skill ELSE p.skill END DESC,
+		         p.last_name, p.first_name
+		LIMIT $3 OFFSET $4
+	`, draftRoomID, positionFilter, queryLimit, queryOffset)
 	if err != nil {
 		return nil, err
 	}
@@ -319,6 +887,45 @@ func (r *queryResolver) SearchPlayers(ctx context.Context, query string, limit *
 	return scanPlayers(rows)
 }
 
+// WaiverClaims is the resolver for the waiverClaims field.
+func (r *queryResolver) WaiverClaims(ctx context.Context, draftRoomID string, teamID *string, status *model.WaiverClaimStatus) ([]*model.WaiverClaim, error) {
+	return loadWaiverClaims(ctx, r.DB, draftRoomID, teamID, status)
+}
+
+// Transactions is the resolver for the transactions field.
+func (r *queryResolver) Transactions(ctx context.Context, draftRoomID string, teamID *string, limit *int) ([]*model.FantasyTransaction, error) {
+	queryLimit := 50
+	if limit != nil {
+		queryLimit = *limit
+	}
+	return loadTransactions(ctx, r.DB, draftRoomID, teamID, queryLimit)
+}
+
+// Trades is the resolver for the trades field.
+func (r *queryResolver) Trades(ctx context.Context, draftRoomID string, teamID *string, status *model.TradeStatus) ([]*model.Trade, error) {
+	return loadTrades(ctx, r.DB, draftRoomID, teamID, status)
+}
+
+// Trade is the resolver for the trade field.
+func (r *queryResolver) Trade(ctx context.Context, id string) (*model.Trade, error) {
+	return loadTrade(ctx, r.DB, id)
+}
+
+// Player is the resolver for the player field.
+func (r *rosterEntryResolver) Player(ctx context.Context, obj *model.RosterEntry) (*model.Player, error) {
+	return loadPlayer(ctx, r.DB, obj.PlayerID)
+}
+
+// Team is the resolver for the team field.
+func (r *rotoStandingResolver) Team(ctx context.Context, obj *model.RotoStanding) (*model.FantasyTeam, error) {
+	return loadFantasyTeam(ctx, r.DB, obj.TeamID)
+}
+
+// Team is the resolver for the team field.
+func (r *standingResolver) Team(ctx context.Context, obj *model.Standing) (*model.FantasyTeam, error) {
+	return loadFantasyTeam(ctx, r.DB, obj.TeamID)
+}
+
 // Division is the resolver for the division field.
 func (r *teamResolver) Division(ctx context.Context, obj *model.Team) (*model.Division, error) {
 	var d model.Division
@@ -338,7 +945,8 @@ func (r *teamResolver) Division(ctx context.Context, obj *model.Team) (*model.Di
 func (r *teamResolver) Players(ctx context.Context, obj *model.Team) ([]*model.Player, error) {
 	rows, err := r.DB.Query(ctx, `
 		SELECT id, first_name, last_name, position, team_id, height, weight, age, 
-		       years_of_experience, draft_year, jersey_number, status, skill 
+		       years_of_experience, draft_year, jersey_number, status, skill,
+		       scouted_skill, draft_round, draft_pick
 		FROM players 
 		WHERE team_id = $1 
 		ORDER BY position, last_name, first_name
@@ -351,23 +959,152 @@ func (r *teamResolver) Players(ctx context.Context, obj *model.Team) ([]*model.P
 	return scanPlayers(rows)
 }
 
+// DepthChart is the resolver for the depthChart field.
+func (r *teamResolver) DepthChart(ctx context.Context, obj *model.Team, position *model.Position) ([]*model.DepthChartEntry, error) {
+	return loadDepthChart(ctx, r.DB, obj.ID, position)
+}
+
+// ProposingTeam is the resolver for the proposingTeam field.
+func (r *tradeResolver) ProposingTeam(ctx context.Context, obj *model.Trade) (*model.FantasyTeam, error) {
+	return loadFantasyTeam(ctx, r.DB, obj.ProposingTeamID)
+}
+
+// ReceivingTeam is the resolver for the receivingTeam field.
+func (r *tradeResolver) ReceivingTeam(ctx context.Context, obj *model.Trade) (*model.FantasyTeam, error) {
+	return loadFantasyTeam(ctx, r.DB, obj.ReceivingTeamID)
+}
+
+// Players is the resolver for the players field.
+func (r *tradeResolver) Players(ctx context.Context, obj *model.Trade) ([]*model.TradePlayer, error) {
+	return loadTradePlayers(ctx, r.DB, obj.ID)
+}
+
+// CounterOf is the resolver for the counterOf field.
+func (r *tradeResolver) CounterOf(ctx context.Context, obj *model.Trade) (*model.Trade, error) {
+	if obj.CounterOfTradeID == "" {
+		return nil, nil
+	}
+	return loadTrade(ctx, r.DB, obj.CounterOfTradeID)
+}
+
+// VetoVotes is the resolver for the vetoVotes field.
+func (r *tradeResolver) VetoVotes(ctx context.Context, obj *model.Trade) (int, error) {
+	return countVetoVotes(ctx, r.DB, obj.ID)
+}
+
+// VetoVotesRequired is the resolver for the vetoVotesRequired field.
+func (r *tradeResolver) VetoVotesRequired(ctx context.Context, obj *model.Trade) (int, error) {
+	return vetoVotesRequired(ctx, r.DB, obj.DraftRoomID)
+}
+
+// Player is the resolver for the player field.
+func (r *tradePlayerResolver) Player(ctx context.Context, obj *model.TradePlayer) (*model.Player, error) {
+	return loadPlayer(ctx, r.DB, obj.PlayerID)
+}
+
+// FromTeam is the resolver for the fromTeam field.
+func (r *tradePlayerResolver) FromTeam(ctx context.Context, obj *model.TradePlayer) (*model.FantasyTeam, error) {
+	return loadFantasyTeam(ctx, r.DB, obj.FromTeamID)
+}
+
+// ToTeam is the resolver for the toTeam field.
+func (r *tradePlayerResolver) ToTeam(ctx context.Context, obj *model.TradePlayer) (*model.FantasyTeam, error) {
+	return loadFantasyTeam(ctx, r.DB, obj.ToTeamID)
+}
+
+// Team is the resolver for the team field.
+func (r *waiverClaimResolver) Team(ctx context.Context, obj *model.WaiverClaim) (*model.FantasyTeam, error) {
+	return loadFantasyTeam(ctx, r.DB, obj.TeamID)
+}
+
+// AddPlayer is the resolver for the addPlayer field.
+func (r *waiverClaimResolver) AddPlayer(ctx context.Context, obj *model.WaiverClaim) (*model.Player, error) {
+	return loadPlayer(ctx, r.DB, obj.AddPlayerID)
+}
+
+// DropPlayer is the resolver for the dropPlayer field.
+func (r *waiverClaimResolver) DropPlayer(ctx context.Context, obj *model.WaiverClaim) (*model.Player, error) {
+	if obj.DropPlayerID == "" {
+		return nil, nil
+	}
+	return loadPlayer(ctx, r.DB, obj.DropPlayerID)
+}
+
 // Conference returns ConferenceResolver implementation.
 func (r *Resolver) Conference() ConferenceResolver { return &conferenceResolver{r} }
 
+// DepthChartEntry returns DepthChartEntryResolver implementation.
+func (r *Resolver) DepthChartEntry() DepthChartEntryResolver { return &depthChartEntryResolver{r} }
+
 // Division returns DivisionResolver implementation.
 func (r *Resolver) Division() DivisionResolver { return &divisionResolver{r} }
 
+// FantasySeason returns FantasySeasonResolver implementation.
+func (r *Resolver) FantasySeason() FantasySeasonResolver { return &fantasySeasonResolver{r} }
+
+// FantasyTeam returns FantasyTeamResolver implementation.
+func (r *Resolver) FantasyTeam() FantasyTeamResolver { return &fantasyTeamResolver{r} }
+
+// FantasyTransaction returns FantasyTransactionResolver implementation.
+func (r *Resolver) FantasyTransaction() FantasyTransactionResolver {
+	return &fantasyTransactionResolver{r}
+}
+
+// Matchup returns MatchupResolver implementation.
+func (r *Resolver) Matchup() MatchupResolver { return &matchupResolver{r} }
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
 // Player returns PlayerResolver implementation.
 func (r *Resolver) Player() PlayerResolver { return &playerResolver{r} }
 
+// PlayoffBracket returns PlayoffBracketResolver implementation.
+func (r *Resolver) PlayoffBracket() PlayoffBracketResolver { return &playoffBracketResolver{r} }
+
+// PlayoffGame returns PlayoffGameResolver implementation.
+func (r *Resolver) PlayoffGame() PlayoffGameResolver { return &playoffGameResolver{r} }
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// RosterEntry returns RosterEntryResolver implementation.
+func (r *Resolver) RosterEntry() RosterEntryResolver { return &rosterEntryResolver{r} }
+
+// RotoStanding returns RotoStandingResolver implementation.
+func (r *Resolver) RotoStanding() RotoStandingResolver { return &rotoStandingResolver{r} }
+
+// Standing returns StandingResolver implementation.
+func (r *Resolver) Standing() StandingResolver { return &standingResolver{r} }
+
 // Team returns TeamResolver implementation.
 func (r *Resolver) Team() TeamResolver { return &teamResolver{r} }
 
+// Trade returns TradeResolver implementation.
+func (r *Resolver) Trade() TradeResolver { return &tradeResolver{r} }
+
+// TradePlayer returns TradePlayerResolver implementation.
+func (r *Resolver) TradePlayer() TradePlayerResolver { return &tradePlayerResolver{r} }
+
+// WaiverClaim returns WaiverClaimResolver implementation.
+func (r *Resolver) WaiverClaim() WaiverClaimResolver { return &waiverClaimResolver{r} }
+
 type conferenceResolver struct{ *Resolver }
+type depthChartEntryResolver struct{ *Resolver }
 type divisionResolver struct{ *Resolver }
+type fantasySeasonResolver struct{ *Resolver }
+type fantasyTeamResolver struct{ *Resolver }
+type fantasyTransactionResolver struct{ *Resolver }
+type matchupResolver struct{ *Resolver }
+type mutationResolver struct{ *Resolver }
 type playerResolver struct{ *Resolver }
+type playoffBracketResolver struct{ *Resolver }
+type playoffGameResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type rosterEntryResolver struct{ *Resolver }
+type rotoStandingResolver struct{ *Resolver }
+type standingResolver struct{ *Resolver }
 type teamResolver struct{ *Resolver }
+type tradeResolver struct{ *Resolver }
+type tradePlayerResolver struct{ *Resolver }
+type waiverClaimResolver struct{ *Resolver }
@@ -0,0 +1,467 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"fantasy-draft/fantasy"
+	"fantasy-draft/graph/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// querier is satisfied by both the connection pool and a transaction,
+// so the same helpers work inside and outside of a mutation's transaction
+type querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// fantasySeasonColumns is the column list scanned by scanFantasySeason
+const fantasySeasonColumns = `
+	id, draft_room_id, year, regular_season_weeks, current_week,
+	playoff_teams, playoff_byes, playoff_weeks_per_round, playoff_tiebreakers, champion_team_id,
+	waiver_mode, faab_budget, waiver_process_day, waiver_process_hour, waivers_processed_at,
+	trade_review_hours, trade_veto_votes, created_at
+`
+
+// scanFantasySeason scans a row selected with fantasySeasonColumns
+func scanFantasySeason(row pgx.Row) (*model.FantasySeason, error) {
+	s := model.FantasySeason{
+		PlayoffSettings: &model.PlayoffSettings{},
+		WaiverSettings:  &model.WaiverSettings{},
+		TradeSettings:   &model.TradeSettings{},
+	}
+	var tiebreakers []string
+	var championID *string
+	var waiverMode string
+	var waiversProcessedAt *time.Time
+	var createdAt time.Time
+
+	err := row.Scan(
+		&s.ID, &s.DraftRoomID, &s.Year, &s.RegularSeasonWeeks, &s.CurrentWeek,
+		&s.PlayoffSettings.Teams, &s.PlayoffSettings.Byes, &s.PlayoffSettings.WeeksPerRound,
+		&tiebreakers, &championID,
+		&waiverMode, &s.WaiverSettings.FaabBudget, &s.WaiverSettings.ProcessDay, &s.WaiverSettings.ProcessHour,
+		&waiversProcessedAt,
+		&s.TradeSettings.ReviewHours, &s.TradeSettings.VetoVotes, &createdAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tb := range tiebreakers {
+		s.PlayoffSettings.Tiebreakers = append(s.PlayoffSettings.Tiebreakers, model.SeedingTiebreaker(tb))
+	}
+	if championID != nil {
+		s.ChampionTeamID = *championID
+	}
+	s.WaiverSettings.Mode = model.WaiverMode(waiverMode)
+
+	// Claims placed before the first batch wait for the first run after the season was scheduled
+	lastRun := createdAt
+	if waiversProcessedAt != nil {
+		lastRun = *waiversProcessedAt
+		lastProcessedAt := formatTimestamp(lastRun)
+		s.WaiverSettings.LastProcessedAt = &lastProcessedAt
+	}
+	s.WaiverSettings.NextProcessAt = formatTimestamp(waiverSettings(&s).NextRun(lastRun))
+	return &s, nil
+}
+
+// loadFantasySeason returns the season for a draft room, or nil if none has been scheduled
+func loadFantasySeason(ctx context.Context, q querier, draftRoomID string) (*model.FantasySeason, error) {
+	season, err := scanFantasySeason(q.QueryRow(ctx,
+		"SELECT "+fantasySeasonColumns+" FROM fantasy_seasons WHERE draft_room_id = $1",
+		draftRoomID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return season, err
+}
+
+// lockFantasySeason loads a draft room's season and locks it for the rest of the transaction
+func lockFantasySeason(ctx context.Context, tx pgx.Tx, draftRoomID string) (*model.FantasySeason, error) {
+	season, err := scanFantasySeason(tx.QueryRow(ctx,
+		"SELECT "+fantasySeasonColumns+" FROM fantasy_seasons WHERE draft_room_id = $1 FOR UPDATE",
+		draftRoomID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("draft room %s has no scheduled season", draftRoomID)
+	}
+	return season, err
+}
+
+// playoffSettings converts the stored season settings into the fantasy package's form
+func playoffSettings(season *model.FantasySeason) fantasy.PlayoffSettings {
+	settings := fantasy.PlayoffSettings{
+		Teams:         season.PlayoffSettings.Teams,
+		Byes:          season.PlayoffSettings.Byes,
+		WeeksPerRound: season.PlayoffSettings.WeeksPerRound,
+	}
+	for _, tb := range season.PlayoffSettings.Tiebreakers {
+		settings.Tiebreakers = append(settings.Tiebreakers, fantasy.Tiebreaker(tb))
+	}
+	return settings
+}
+
+// resolvePlayoffSettings fills any fields missing from the input with the league defaults
+func resolvePlayoffSettings(input *model.PlayoffSettingsInput, teamCount int) fantasy.PlayoffSettings {
+	settings := fantasy.DefaultPlayoffSettings(teamCount)
+	if input == nil {
+		return settings
+	}
+
+	settings.Teams = input.Teams
+	if input.Byes != nil {
+		settings.Byes = *input.Byes
+	}
+	if input.WeeksPerRound != nil {
+		settings.WeeksPerRound = *input.WeeksPerRound
+	}
+	if input.Tiebreakers != nil {
+		settings.Tiebreakers = nil
+		for _, tb := range input.Tiebreakers {
+			settings.Tiebreakers = append(settings.Tiebreakers, fantasy.Tiebreaker(tb))
+		}
+	}
+	return settings
+}
+
+// loadFantasyTeam returns a single fantasy team by ID
+func loadFantasyTeam(ctx context.Context, q querier, id string) (*model.FantasyTeam, error) {
+	var t model.FantasyTeam
+	err := q.QueryRow(ctx, `
+		SELECT id, name, draft_order_number, is_bot, waiver_priority, faab_remaining
+		FROM fantasy_teams
+		WHERE id = $1
+	`, id).Scan(&t.ID, &t.Name, &t.DraftOrderNumber, &t.IsBot, &t.WaiverPriority, &t.FaabRemaining)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// loadFantasyTeamIDs returns the IDs of every team in a draft room in draft order
+func loadFantasyTeamIDs(ctx context.Context, q querier, draftRoomID string) ([]string, error) {
+	rows, err := q.Query(ctx, `
+		SELECT id
+		FROM fantasy_teams
+		WHERE draft_room_id = $1
+		ORDER BY draft_order_number NULLS LAST, created_at, id
+	`, draftRoomID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// loadMatchups returns the matchups for one week of a draft room's season
+func loadMatchups(ctx context.Context, q querier, draftRoomID string, week int) ([]*model.Matchup, error) {
+	rows, err := q.Query(ctx, `
+		SELECT id, week, home_team_id, away_team_id, home_score, away_score, is_complete
+		FROM fantasy_matchups
+		WHERE draft_room_id = $1 AND week = $2
+		ORDER BY created_at, id
+	`, draftRoomID, week)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var matchups []*model.Matchup
+	for rows.Next() {
+		var m model.Matchup
+		if err := rows.Scan(&m.ID, &m.Week, &m.HomeTeamID, &m.AwayTeamID, &m.HomeScore, &m.AwayScore, &m.IsComplete); err != nil {
+			return nil, err
+		}
+		matchups = append(matchups, &m)
+	}
+	return matchups, rows.Err()
+}
+
+// loadRegularSeasonResults returns the final scores of every completed regular season matchup
+func loadRegularSeasonResults(ctx context.Context, q querier, season *model.FantasySeason) ([]fantasy.MatchupResult, error) {
+	rows, err := q.Query(ctx, `
+		SELECT home_team_id, away_team_id, home_score, away_score
+		FROM fantasy_matchups
+		WHERE draft_room_id = $1 AND week <= $2 AND is_complete
+	`, season.DraftRoomID, season.RegularSeasonWeeks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []fantasy.MatchupResult
+	for rows.Next() {
+		var r fantasy.MatchupResult
+		if err := rows.Scan(&r.HomeTeamID, &r.AwayTeamID, &r.HomeScore, &r.AwayScore); err != nil {
+			return nil, err
+		}
+		results = append(results, r)
+	}
+	return results, rows.Err()
+}
+
+// scoreStartingLineup totals the fantasy points of a team's starters for one pro week.
+// Starters without a stat line that week (bye, injury) score zero.
+func scoreStartingLineup(ctx context.Context, q querier, fantasyTeamID string, year, week int) (float64, error) {
+	rows, err := q.Query(ctx, `
+		SELECT fr.roster_spot, p.position, ws.sport_type, ws.stats
+		FROM fantasy_rosters fr
+		JOIN players p ON p.id = fr.player_id
+		JOIN weekly_stats ws ON ws.player_id = fr.player_id AND ws.year = $2 AND ws.week = $3
+		WHERE fr.fantasy_team_id = $1
+	`, fantasyTeamID, year, week)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	total := 0.0
+	for rows.Next() {
+		var spot, position, sportType string
+		var statsJSON []byte
+		if err := rows.Scan(&spot, &position, &sportType, &statsJSON); err != nil {
+			return 0, err
+		}
+		if !fantasy.IsStartingSpot(spot) {
+			continue
+		}
+
+		points, err := scoreWeeklyStats(sportType, position, statsJSON)
+		if err != nil {
+			return 0, err
+		}
+		total += points
+	}
+	return total, rows.Err()
+}
+
+// scoreWeeklyStats decodes a weekly_stats line with its sport's registered decoder and scores it with
+// the sport's standard scoring. Football lines are scored by the player's position, since DST units
+// are scored on defense. Baseball lines score no points; baseball leagues are ranked by rotisserie
+// categories instead.
+func scoreWeeklyStats(sportType, position string, statsJSON []byte) (float64, error) {
+	stats, err := decodeStats(sportType, statsJSON)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode weekly stats: %w", err)
+	}
+	switch stats := stats.(type) {
+	case *model.FootballStats:
+		return fantasy.ScoreFootball(position, *stats), nil
+	case *model.BasketballStats:
+		return fantasy.StandardBasketballScoring.Score(*stats), nil
+	case *model.BaseballStats:
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("no scoring for %s stats", sportType)
+	}
+}
+
+// createFantasySeason schedules a round-robin regular season for a COMPLETE draft room.
+// The season is scored against the most recent pro season of the room's universe that has weekly stats,
+// which must have enough weeks for both the regular season and the playoffs.
+// Baseball rooms are ranked by rotisserie categories, so they have no schedule.
+func createFantasySeason(ctx context.Context, tx pgx.Tx, draftRoomID string, regularSeasonWeeks int, playoffs *model.PlayoffSettingsInput, waivers *model.WaiverSettingsInput, trades *model.TradeSettingsInput) (*model.FantasySeason, error) {
+	var status, sportType, universeID string
+	err := tx.QueryRow(ctx, "SELECT status, sport_type, universe_id FROM draft_rooms WHERE id = $1 FOR UPDATE", draftRoomID).Scan(&status, &sportType, &universeID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("draft room %s not found", draftRoomID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if status != "COMPLETE" {
+		return nil, fmt.Errorf("draft room must be COMPLETE to schedule a season, is %s", status)
+	}
+	if model.SportType(sportType) == model.SportTypeBaseball {
+		return nil, fmt.Errorf("%s leagues are ranked by rotisserie categories and have no head-to-head schedule", sportType)
+	}
+
+	existing, err := loadFantasySeason(ctx, tx, draftRoomID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("draft room %s already has a season", draftRoomID)
+	}
+
+	teamIDs, err := loadFantasyTeamIDs(ctx, tx, draftRoomID)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := fantasy.GenerateSchedule(teamIDs, regularSeasonWeeks)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := resolvePlayoffSettings(playoffs, len(teamIDs))
+	if err := settings.Validate(len(teamIDs)); err != nil {
+		return nil, err
+	}
+	waiverRules := resolveWaiverSettings(waivers)
+	if err := waiverRules.Validate(); err != nil {
+		return nil, err
+	}
+	tradeRules := resolveTradeSettings(trades)
+	if err := tradeRules.Validate(); err != nil {
+		return nil, err
+	}
+	var vetoVotes *int
+	if tradeRules.VetoVotes > 0 {
+		vetoVotes = &tradeRules.VetoVotes
+	}
+
+	var year, weeksAvailable *int
+	err = tx.QueryRow(ctx, `
+		WITH universe_stats AS (
+			SELECT ws.year, ws.week
+			FROM weekly_stats ws
+			JOIN players p ON p.id = ws.player_id
+			JOIN pro_teams pt ON pt.id = p.team_id
+			WHERE ws.sport_type = $1 AND pt.universe_id = $2
+		)
+		SELECT year, MAX(week)
+		FROM universe_stats
+		WHERE year = (SELECT MAX(year) FROM universe_stats)
+		GROUP BY year
+	`, sportType, universeID).Scan(&year, &weeksAvailable)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("no weekly stats available to score a season")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if totalWeeks := regularSeasonWeeks + settings.TotalWeeks(); totalWeeks > *weeksAvailable {
+		return nil, fmt.Errorf("season %d only has %d weeks of stats, cannot schedule %d regular season and %d playoff weeks",
+			*year, *weeksAvailable, regularSeasonWeeks, settings.TotalWeeks())
+	}
+
+	tiebreakers := make([]string, len(settings.Tiebreakers))
+	for i, tb := range settings.Tiebreakers {
+		tiebreakers[i] = string(tb)
+	}
+
+	season, err := scanFantasySeason(tx.QueryRow(ctx, `
+		INSERT INTO fantasy_seasons (
+			draft_room_id, year, regular_season_weeks,
+			playoff_teams, playoff_byes, playoff_weeks_per_round, playoff_tiebreakers,
+			waiver_mode, faab_budget, waiver_process_day, waiver_process_hour,
+			trade_review_hours, trade_veto_votes
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING `+fantasySeasonColumns,
+		draftRoomID, *year, regularSeasonWeeks,
+		settings.Teams, settings.Byes, settings.WeeksPerRound, tiebreakers,
+		string(waiverRules.Mode), waiverRules.FAABBudget, int(waiverRules.ProcessDay), waiverRules.ProcessHour,
+		int(tradeRules.ReviewPeriod/time.Hour), vetoVotes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert season: %w", err)
+	}
+
+	if err := initializeWaiverOrder(ctx, tx, draftRoomID, waiverRules.FAABBudget); err != nil {
+		return nil, err
+	}
+
+	for weekIndex, pairings := range schedule {
+		for _, p := range pairings {
+			_, err := tx.Exec(ctx, `
+				INSERT INTO fantasy_matchups (draft_room_id, week, home_team_id, away_team_id)
+				VALUES ($1, $2, $3, $4)
+			`, draftRoomID, weekIndex+1, p.HomeTeamID, p.AwayTeamID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to insert matchup for week %d: %w", weekIndex+1, err)
+			}
+		}
+	}
+
+	return season, nil
+}
+
+// simulateFantasyWeek scores every matchup in the next unplayed week and advances the season.
+// Playoff weeks get their matchups from the bracket, and the last week of each round
+// sends the winners on to the next round.
+func simulateFantasyWeek(ctx context.Context, tx pgx.Tx, draftRoomID string) ([]*model.Matchup, error) {
+	season, err := lockFantasySeason(ctx, tx, draftRoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := playoffSettings(season)
+	if season.CurrentWeek >= season.RegularSeasonWeeks+settings.TotalWeeks() {
+		return nil, fmt.Errorf("season is already complete")
+	}
+
+	week := season.CurrentWeek + 1
+	round, lastWeekOfRound := settings.RoundForWeek(season.RegularSeasonWeeks, week)
+	if round > 0 {
+		if err := schedulePlayoffWeek(ctx, tx, draftRoomID, round, week); err != nil {
+			return nil, err
+		}
+	}
+
+	matchups, err := loadMatchups(ctx, tx, draftRoomID, week)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range matchups {
+		homeScore, err := scoreStartingLineup(ctx, tx, m.HomeTeamID, season.Year, week)
+		if err != nil {
+			return nil, err
+		}
+		awayScore, err := scoreStartingLineup(ctx, tx, m.AwayTeamID, season.Year, week)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE fantasy_matchups
+			SET home_score = $2, away_score = $3, is_complete = TRUE
+			WHERE id = $1
+		`, m.ID, homeScore, awayScore)
+		if err != nil {
+			return nil, fmt.Errorf("failed to record matchup %s: %w", m.ID, err)
+		}
+
+		m.HomeScore = &homeScore
+		m.AwayScore = &awayScore
+		m.IsComplete = true
+	}
+
+	switch {
+	case week == season.RegularSeasonWeeks:
+		if err := createPlayoffBracket(ctx, tx, season, settings); err != nil {
+			return nil, fmt.Errorf("failed to seed playoffs: %w", err)
+		}
+	case round > 0:
+		if err := settlePlayoffWeek(ctx, tx, draftRoomID, round, lastWeekOfRound); err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE fantasy_seasons
+		SET current_week = $2, updated_at = NOW()
+		WHERE id = $1
+	`, season.ID, week)
+	if err != nil {
+		return nil, fmt.Errorf("failed to advance season: %w", err)
+	}
+
+	return matchups, nil
+}
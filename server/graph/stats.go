@@ -0,0 +1,66 @@
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"fantasy-draft/fantasy"
+	"fantasy-draft/graph/model"
+)
+
+// statsDecoder turns a stored stats JSONB document into its sport's stat line
+type statsDecoder func(statsJSON []byte) (model.PlayerStats, error)
+
+func decodeFootballStats(statsJSON []byte) (model.PlayerStats, error) {
+	var stats model.FootballStats
+	if err := json.Unmarshal(statsJSON, &stats); err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+func decodeBasketballStats(statsJSON []byte) (model.PlayerStats, error) {
+	var stats model.BasketballStats
+	if err := json.Unmarshal(statsJSON, &stats); err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+func decodeBaseballStats(statsJSON []byte) (model.PlayerStats, error) {
+	var stats model.BaseballStats
+	if err := json.Unmarshal(statsJSON, &stats); err != nil {
+		return nil, err
+	}
+	return withBaseballRates(stats), nil
+}
+
+// withBaseballRates fills in the rate stats a stored baseball line leaves out
+func withBaseballRates(stats model.BaseballStats) *model.BaseballStats {
+	stats.BattingAverage = fantasy.BattingAverage(stats)
+	stats.InningsPitched = fantasy.InningsPitched(stats)
+	stats.Era = fantasy.EarnedRunAverage(stats)
+	stats.Whip = fantasy.WHIP(stats)
+	return &stats
+}
+
+// statsDecoders holds the stats decoder of every sport. A new sport registers its decoder
+// here and its stat type in the PlayerStats union.
+var statsDecoders = map[model.SportType]statsDecoder{
+	model.SportTypeFootball:   decodeFootballStats,
+	model.SportTypeBasketball: decodeBasketballStats,
+	model.SportTypeBaseball:   decodeBaseballStats,
+}
+
+// decodeStats decodes a stats document with the decoder registered for its sport
+func decodeStats(sportType string, statsJSON []byte) (model.PlayerStats, error) {
+	decode, ok := statsDecoders[model.SportType(sportType)]
+	if !ok {
+		return nil, fmt.Errorf("no stats decoder for sport type %q", sportType)
+	}
+	stats, err := decode(statsJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s stats: %w", sportType, err)
+	}
+	return stats, nil
+}
@@ -0,0 +1,99 @@
+package graph
+
+import (
+	"testing"
+
+	"fantasy-draft/graph/model"
+)
+
+func TestDecodeStats(t *testing.T) {
+	t.Run("football", func(t *testing.T) {
+		stats, err := decodeStats("FOOTBALL", []byte(`{"RushingYards": 1100, "RushingTDs": 9}`))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		football, ok := stats.(*model.FootballStats)
+		if !ok {
+			t.Fatalf("Expected football stats, got %T", stats)
+		}
+		if football.RushingYards != 1100 || football.RushingTDs != 9 {
+			t.Errorf("Expected 1100 yards and 9 TDs, got %d and %d", football.RushingYards, football.RushingTDs)
+		}
+	})
+
+	t.Run("football field goals by distance", func(t *testing.T) {
+		stats, err := decodeStats("FOOTBALL", []byte(`{"FieldGoals": 3, "FieldGoals40To49": 2, "FieldGoalsMade50Plus": 1}`))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if football, ok := stats.(*model.FootballStats); !ok || football.FieldGoals40To49 != 2 || football.FieldGoalsMade50Plus != 1 {
+			t.Errorf("Expected the distances to be decoded, got %#v", stats)
+		}
+	})
+
+	t.Run("basketball", func(t *testing.T) {
+		stats, err := decodeStats("BASKETBALL", []byte(`{"Points": 1500, "Rebounds": 400}`))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if basketball, ok := stats.(*model.BasketballStats); !ok || basketball.Points != 1500 {
+			t.Errorf("Expected basketball stats with 1500 points, got %#v", stats)
+		}
+	})
+
+	t.Run("baseball fills in rates", func(t *testing.T) {
+		stats, err := decodeStats("BASEBALL", []byte(`{"AtBats": 500, "Hits": 150, "OutsPitched": 0}`))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if baseball, ok := stats.(*model.BaseballStats); !ok || baseball.BattingAverage != 0.3 {
+			t.Errorf("Expected baseball stats with a .300 average, got %#v", stats)
+		}
+	})
+
+	t.Run("rejects unknown sports", func(t *testing.T) {
+		stats, err := decodeStats("HOCKEY", []byte(`{"Goals": 40}`))
+		if err == nil {
+			t.Errorf("Expected an error for an unknown sport, got %#v", stats)
+		}
+	})
+
+	t.Run("rejects malformed stats", func(t *testing.T) {
+		if _, err := decodeStats("FOOTBALL", []byte(`[1, 2]`)); err == nil {
+			t.Error("Expected an error for stats that aren't an object")
+		}
+	})
+}
+
+func TestStatsDecodersCoverEverySport(t *testing.T) {
+	for _, sport := range model.AllSportType {
+		if _, ok := statsDecoders[sport]; !ok {
+			t.Errorf("Expected a stats decoder for %s", sport)
+		}
+	}
+}
+
+func TestScoreWeeklyStats(t *testing.T) {
+	t.Run("football by position", func(t *testing.T) {
+		points, err := scoreWeeklyStats("FOOTBALL", "RB", []byte(`{"RushingYards": 100, "RushingTDs": 1}`))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if points != 16 {
+			t.Errorf("Expected 16 points, got %v", points)
+		}
+	})
+
+	t.Run("baseball scores no points", func(t *testing.T) {
+		points, err := scoreWeeklyStats("BASEBALL", "OF", []byte(`{"AtBats": 4, "Hits": 2, "HomeRuns": 1}`))
+		if err != nil || points != 0 {
+			t.Errorf("Expected 0 points and no error, got %v and %v", points, err)
+		}
+	})
+
+	t.Run("rejects unknown sports", func(t *testing.T) {
+		if _, err := scoreWeeklyStats("HOCKEY", "C", []byte(`{"Goals": 2}`)); err == nil {
+			t.Error("Expected an error for an unknown sport")
+		}
+	})
+}
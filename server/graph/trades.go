@@ -0,0 +1,559 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"fantasy-draft/fantasy"
+	"fantasy-draft/graph/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// tradeSettings converts the stored season settings into the fantasy package's form
+func tradeSettings(season *model.FantasySeason) fantasy.TradeSettings {
+	settings := fantasy.TradeSettings{
+		ReviewPeriod: time.Duration(season.TradeSettings.ReviewHours) * time.Hour,
+	}
+	if season.TradeSettings.VetoVotes != nil {
+		settings.VetoVotes = *season.TradeSettings.VetoVotes
+	}
+	return settings
+}
+
+// resolveTradeSettings fills any fields missing from the input with the league defaults
+func resolveTradeSettings(input *model.TradeSettingsInput) fantasy.TradeSettings {
+	settings := fantasy.DefaultTradeSettings
+	if input == nil {
+		return settings
+	}
+
+	if input.ReviewHours != nil {
+		settings.ReviewPeriod = time.Duration(*input.ReviewHours) * time.Hour
+	}
+	if input.VetoVotes != nil {
+		settings.VetoVotes = *input.VetoVotes
+	}
+	return settings
+}
+
+// tradeColumns is the column list scanned by scanTrade
+const tradeColumns = `
+	id, draft_room_id, proposing_team_id, receiving_team_id, status, counter_of_trade_id,
+	review_ends_at, failure_reason, created_at, resolved_at
+`
+
+// scanTrade scans a row selected with tradeColumns
+func scanTrade(row pgx.Row) (*model.Trade, error) {
+	var t model.Trade
+	var status string
+	var counterOfTradeID *string
+	var reviewEndsAt, resolvedAt *time.Time
+	var createdAt time.Time
+
+	err := row.Scan(
+		&t.ID, &t.DraftRoomID, &t.ProposingTeamID, &t.ReceivingTeamID, &status, &counterOfTradeID,
+		&reviewEndsAt, &t.FailureReason, &createdAt, &resolvedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	t.Status = model.TradeStatus(status)
+	if counterOfTradeID != nil {
+		t.CounterOfTradeID = *counterOfTradeID
+	}
+	t.CreatedAt = formatTimestamp(createdAt)
+	if reviewEndsAt != nil {
+		formatted := formatTimestamp(*reviewEndsAt)
+		t.ReviewEndsAt = &formatted
+	}
+	if resolvedAt != nil {
+		formatted := formatTimestamp(*resolvedAt)
+		t.ResolvedAt = &formatted
+	}
+	return &t, nil
+}
+
+// loadTrade returns a single trade by ID, or nil if there is no such trade
+func loadTrade(ctx context.Context, q querier, id string) (*model.Trade, error) {
+	trade, err := scanTrade(q.QueryRow(ctx, "SELECT "+tradeColumns+" FROM fantasy_trades WHERE id = $1", id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return trade, err
+}
+
+// loadTrades returns a draft room's trades, newest first
+func loadTrades(ctx context.Context, q querier, draftRoomID string, teamID *string, status *model.TradeStatus) ([]*model.Trade, error) {
+	var statusFilter *string
+	if status != nil {
+		value := status.String()
+		statusFilter = &value
+	}
+
+	rows, err := q.Query(ctx, `
+		SELECT `+tradeColumns+`
+		FROM fantasy_trades
+		WHERE draft_room_id = $1
+		  AND ($2::uuid IS NULL OR proposing_team_id = $2 OR receiving_team_id = $2)
+		  AND ($3::trade_status_enum IS NULL OR status = $3)
+		ORDER BY created_at DESC, id
+	`, draftRoomID, teamID, statusFilter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var trades []*model.Trade
+	for rows.Next() {
+		t, err := scanTrade(rows)
+		if err != nil {
+			return nil, err
+		}
+		trades = append(trades, t)
+	}
+	return trades, rows.Err()
+}
+
+// loadTradePlayers returns the players changing hands in a trade
+func loadTradePlayers(ctx context.Context, q querier, tradeID string) ([]*model.TradePlayer, error) {
+	rows, err := q.Query(ctx, `
+		SELECT player_id, from_team_id, to_team_id
+		FROM fantasy_trade_players
+		WHERE trade_id = $1
+		ORDER BY from_team_id, player_id
+	`, tradeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var players []*model.TradePlayer
+	for rows.Next() {
+		var p model.TradePlayer
+		if err := rows.Scan(&p.PlayerID, &p.FromTeamID, &p.ToTeamID); err != nil {
+			return nil, err
+		}
+		players = append(players, &p)
+	}
+	return players, rows.Err()
+}
+
+// countVetoVotes returns how many managers have voted to veto a trade
+func countVetoVotes(ctx context.Context, q querier, tradeID string) (int, error) {
+	var votes int
+	err := q.QueryRow(ctx, "SELECT COUNT(*) FROM fantasy_trade_votes WHERE trade_id = $1", tradeID).Scan(&votes)
+	return votes, err
+}
+
+// vetoVotesRequired returns how many votes veto a trade in the trade's draft room
+func vetoVotesRequired(ctx context.Context, q querier, draftRoomID string) (int, error) {
+	season, err := loadFantasySeason(ctx, q, draftRoomID)
+	if err != nil {
+		return 0, err
+	}
+	settings := fantasy.DefaultTradeSettings
+	if season != nil {
+		settings = tradeSettings(season)
+	}
+
+	teamIDs, err := loadFantasyTeamIDs(ctx, q, draftRoomID)
+	if err != nil {
+		return 0, err
+	}
+	return settings.VetoVotesRequired(len(teamIDs)), nil
+}
+
+// lockTrade locks a trade's season, then the trade itself, so trade actions and other
+// roster moves in the draft room run one at a time
+func lockTrade(ctx context.Context, tx pgx.Tx, tradeID string) (*model.FantasySeason, *model.Trade, error) {
+	trade, err := loadTrade(ctx, tx, tradeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if trade == nil {
+		return nil, nil, fmt.Errorf("trade %s not found", tradeID)
+	}
+
+	season, err := lockFantasySeason(ctx, tx, trade.DraftRoomID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Re-read under the lock in case the trade changed while we waited
+	trade, err = scanTrade(tx.QueryRow(ctx, "SELECT "+tradeColumns+" FROM fantasy_trades WHERE id = $1 FOR UPDATE", tradeID))
+	if err != nil {
+		return nil, nil, err
+	}
+	return season, trade, nil
+}
+
+// requireTradeStatus returns an error unless the trade is in the expected status
+func requireTradeStatus(trade *model.Trade, expected model.TradeStatus) error {
+	if trade.Status != expected {
+		return fmt.Errorf("trade %s is %s, expected %s", trade.ID, trade.Status, expected)
+	}
+	return nil
+}
+
+// resolveTrade moves a trade to a final status
+func resolveTrade(ctx context.Context, tx pgx.Tx, tradeID string, status model.TradeStatus, failureReason *string) (*model.Trade, error) {
+	trade, err := scanTrade(tx.QueryRow(ctx, `
+		UPDATE fantasy_trades
+		SET status = $2, failure_reason = $3, resolved_at = NOW()
+		WHERE id = $1
+		RETURNING `+tradeColumns,
+		tradeID, status.String(), failureReason))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update trade %s: %w", tradeID, err)
+	}
+	return trade, nil
+}
+
+// insertTrade validates and records a new proposal. Every offered player must be on the
+// proposing team and every requested player on the receiving team.
+func insertTrade(ctx context.Context, tx pgx.Tx, draftRoomID, proposingTeamID, receivingTeamID string, offeredPlayerIDs, requestedPlayerIDs []string, counterOfTradeID *string) (*model.Trade, error) {
+	if proposingTeamID == receivingTeamID {
+		return nil, fmt.Errorf("a team cannot trade with itself")
+	}
+	if len(offeredPlayerIDs) == 0 || len(requestedPlayerIDs) == 0 {
+		return nil, fmt.Errorf("a trade needs at least one player from each team")
+	}
+
+	receivingRoomID, err := loadTeamDraftRoomID(ctx, tx, receivingTeamID)
+	if err != nil {
+		return nil, err
+	}
+	if receivingRoomID != draftRoomID {
+		return nil, fmt.Errorf("team %s is not in the same draft room", receivingTeamID)
+	}
+
+	var assets []fantasy.TradeAsset
+	sides := []struct {
+		fromTeamID, toTeamID string
+		playerIDs            []string
+	}{
+		{proposingTeamID, receivingTeamID, offeredPlayerIDs},
+		{receivingTeamID, proposingTeamID, requestedPlayerIDs},
+	}
+	for _, side := range sides {
+		roster, err := loadRoster(ctx, tx, side.fromTeamID)
+		if err != nil {
+			return nil, err
+		}
+		for _, playerID := range side.playerIDs {
+			if _, ok := roster[playerID]; !ok {
+				return nil, fmt.Errorf("player %s is not on team %s's roster", playerID, side.fromTeamID)
+			}
+			if slices.ContainsFunc(assets, func(a fantasy.TradeAsset) bool { return a.PlayerID == playerID }) {
+				return nil, fmt.Errorf("player %s is listed more than once", playerID)
+			}
+			assets = append(assets, fantasy.TradeAsset{PlayerID: playerID, FromTeamID: side.fromTeamID, ToTeamID: side.toTeamID})
+		}
+	}
+
+	trade, err := scanTrade(tx.QueryRow(ctx, `
+		INSERT INTO fantasy_trades (draft_room_id, proposing_team_id, receiving_team_id, counter_of_trade_id)
+		VALUES ($1, $2, $3, $4)
+		RETURNING `+tradeColumns,
+		draftRoomID, proposingTeamID, receivingTeamID, counterOfTradeID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert trade: %w", err)
+	}
+
+	for _, asset := range assets {
+		_, err := tx.Exec(ctx, `
+			INSERT INTO fantasy_trade_players (trade_id, player_id, from_team_id, to_team_id)
+			VALUES ($1, $2, $3, $4)
+		`, trade.ID, asset.PlayerID, asset.FromTeamID, asset.ToTeamID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert trade player %s: %w", asset.PlayerID, err)
+		}
+	}
+	return trade, nil
+}
+
+// proposeTrade offers a trade from one team to another in the same draft room
+func proposeTrade(ctx context.Context, tx pgx.Tx, proposingTeamID, receivingTeamID string, offeredPlayerIDs, requestedPlayerIDs []string) (*model.Trade, error) {
+	season, err := lockTeamSeason(ctx, tx, proposingTeamID)
+	if err != nil {
+		return nil, err
+	}
+	return insertTrade(ctx, tx, season.DraftRoomID, proposingTeamID, receivingTeamID, offeredPlayerIDs, requestedPlayerIDs, nil)
+}
+
+// counterTrade closes a proposal and sends new terms back to the original proposer
+func counterTrade(ctx context.Context, tx pgx.Tx, tradeID string, offeredPlayerIDs, requestedPlayerIDs []string) (*model.Trade, error) {
+	season, trade, err := lockTrade(ctx, tx, tradeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireTradeStatus(trade, model.TradeStatusProposed); err != nil {
+		return nil, err
+	}
+
+	if _, err := resolveTrade(ctx, tx, trade.ID, model.TradeStatusCountered, nil); err != nil {
+		return nil, err
+	}
+	return insertTrade(ctx, tx, season.DraftRoomID, trade.ReceivingTeamID, trade.ProposingTeamID, offeredPlayerIDs, requestedPlayerIDs, &trade.ID)
+}
+
+// checkTradeLegality returns an error if the trade can no longer execute as proposed
+// or would leave either team with a roster that breaks the draft room's rules
+func checkTradeLegality(ctx context.Context, tx pgx.Tx, trade *model.Trade) ([]fantasy.TradeAsset, error) {
+	players, err := loadTradePlayers(ctx, tx, trade.ID)
+	if err != nil {
+		return nil, err
+	}
+	assets := make([]fantasy.TradeAsset, len(players))
+	for i, p := range players {
+		assets[i] = fantasy.TradeAsset{PlayerID: p.PlayerID, FromTeamID: p.FromTeamID, ToTeamID: p.ToTeamID}
+	}
+
+	rules, err := loadRosterRules(ctx, tx, trade.DraftRoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	rosters := make(map[string]map[string]string, 2)
+	for _, teamID := range []string{trade.ProposingTeamID, trade.ReceivingTeamID} {
+		if rosters[teamID], err = loadRoster(ctx, tx, teamID); err != nil {
+			return nil, err
+		}
+	}
+
+	positions := make(map[string]string)
+	rows, err := tx.Query(ctx, `
+		SELECT p.id, p.position
+		FROM fantasy_rosters fr
+		JOIN players p ON p.id = fr.player_id
+		WHERE fr.fantasy_team_id = ANY($1)
+	`, []string{trade.ProposingTeamID, trade.ReceivingTeamID})
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var playerID, position string
+		if err := rows.Scan(&playerID, &position); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		positions[playerID] = position
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := fantasy.CheckTrade(rules, rosters, positions, assets); err != nil {
+		return nil, err
+	}
+	return assets, nil
+}
+
+// executeTrade swaps the players if the trade is still legal, otherwise marks it FAILED.
+// Traded players land on their new team's bench.
+func executeTrade(ctx context.Context, tx pgx.Tx, trade *model.Trade) (*model.Trade, error) {
+	assets, err := checkTradeLegality(ctx, tx, trade)
+	if err != nil {
+		reason := err.Error()
+		return resolveTrade(ctx, tx, trade.ID, model.TradeStatusFailed, &reason)
+	}
+
+	for _, asset := range assets {
+		_, err := tx.Exec(ctx, `
+			UPDATE fantasy_rosters
+			SET fantasy_team_id = $3, roster_spot = $4
+			WHERE fantasy_team_id = $1 AND player_id = $2
+		`, asset.FromTeamID, asset.PlayerID, asset.ToTeamID, fantasy.BenchSpot)
+		if err != nil {
+			return nil, fmt.Errorf("failed to move player %s: %w", asset.PlayerID, err)
+		}
+		_, err = recordTransaction(ctx, tx, trade.DraftRoomID, asset.ToTeamID, asset.PlayerID, model.TransactionTypeTrade, nil, &trade.ID, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resolveTrade(ctx, tx, trade.ID, model.TradeStatusCompleted, nil)
+}
+
+// acceptTrade agrees to a proposal and starts its review period, or executes it
+// immediately when the league has no review period
+func acceptTrade(ctx context.Context, tx pgx.Tx, tradeID string) (*model.Trade, error) {
+	season, trade, err := lockTrade(ctx, tx, tradeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireTradeStatus(trade, model.TradeStatusProposed); err != nil {
+		return nil, err
+	}
+	if _, err := checkTradeLegality(ctx, tx, trade); err != nil {
+		return nil, err
+	}
+
+	settings := tradeSettings(season)
+	if settings.ReviewPeriod == 0 {
+		return executeTrade(ctx, tx, trade)
+	}
+
+	trade, err = scanTrade(tx.QueryRow(ctx, `
+		UPDATE fantasy_trades
+		SET status = 'IN_REVIEW', review_ends_at = NOW() + make_interval(hours => $2)
+		WHERE id = $1
+		RETURNING `+tradeColumns,
+		trade.ID, season.TradeSettings.ReviewHours))
+	if err != nil {
+		return nil, fmt.Errorf("failed to start trade review: %w", err)
+	}
+	return trade, nil
+}
+
+// closeProposal rejects or withdraws a proposal that has not been answered yet
+func closeProposal(ctx context.Context, tx pgx.Tx, tradeID string, status model.TradeStatus) (*model.Trade, error) {
+	_, trade, err := lockTrade(ctx, tx, tradeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireTradeStatus(trade, model.TradeStatusProposed); err != nil {
+		return nil, err
+	}
+	return resolveTrade(ctx, tx, trade.ID, status, nil)
+}
+
+// voteToVetoTrade records a veto vote from a team outside the trade and
+// overturns the trade once enough votes are in
+func voteToVetoTrade(ctx context.Context, tx pgx.Tx, tradeID, teamID string) (*model.Trade, error) {
+	season, trade, err := lockTrade(ctx, tx, tradeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireTradeStatus(trade, model.TradeStatusInReview); err != nil {
+		return nil, err
+	}
+
+	draftRoomID, err := loadTeamDraftRoomID(ctx, tx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if draftRoomID != trade.DraftRoomID {
+		return nil, fmt.Errorf("team %s is not in the same draft room", teamID)
+	}
+	if teamID == trade.ProposingTeamID || teamID == trade.ReceivingTeamID {
+		return nil, fmt.Errorf("teams in the trade cannot vote on it")
+	}
+
+	tag, err := tx.Exec(ctx, `
+		INSERT INTO fantasy_trade_votes (trade_id, fantasy_team_id)
+		VALUES ($1, $2)
+		ON CONFLICT (trade_id, fantasy_team_id) DO NOTHING
+	`, trade.ID, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record veto vote: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, fmt.Errorf("team %s has already voted on trade %s", teamID, trade.ID)
+	}
+
+	votes, err := countVetoVotes(ctx, tx, trade.ID)
+	if err != nil {
+		return nil, err
+	}
+	teamIDs, err := loadFantasyTeamIDs(ctx, tx, trade.DraftRoomID)
+	if err != nil {
+		return nil, err
+	}
+	if votes >= tradeSettings(season).VetoVotesRequired(len(teamIDs)) {
+		return resolveTrade(ctx, tx, trade.ID, model.TradeStatusVetoed, nil)
+	}
+	return trade, nil
+}
+
+// reviewTrade is the commissioner's ruling on a trade under review
+func reviewTrade(ctx context.Context, tx pgx.Tx, tradeID string, approve bool) (*model.Trade, error) {
+	_, trade, err := lockTrade(ctx, tx, tradeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireTradeStatus(trade, model.TradeStatusInReview); err != nil {
+		return nil, err
+	}
+
+	if approve {
+		return executeTrade(ctx, tx, trade)
+	}
+	return resolveTrade(ctx, tx, trade.ID, model.TradeStatusVetoed, nil)
+}
+
+// ProcessDueTrades executes every trade whose review period has ended without a veto.
+// Each trade runs in its own transaction so one failure doesn't hold up the rest.
+// It returns how many trades were processed.
+func ProcessDueTrades(ctx context.Context, db *pgxpool.Pool) (int, error) {
+	rows, err := db.Query(ctx, `
+		SELECT id
+		FROM fantasy_trades
+		WHERE status = 'IN_REVIEW' AND review_ends_at <= NOW()
+		ORDER BY review_ends_at, id
+	`)
+	if err != nil {
+		return 0, err
+	}
+
+	var due []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		due = append(due, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	processed := 0
+	for _, tradeID := range due {
+		if err := processDueTrade(ctx, db, tradeID); err != nil {
+			errs = append(errs, fmt.Errorf("trade %s: %w", tradeID, err))
+			continue
+		}
+		processed++
+	}
+	return processed, errors.Join(errs...)
+}
+
+func processDueTrade(ctx context.Context, db *pgxpool.Pool, tradeID string) error {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	_, trade, err := lockTrade(ctx, tx, tradeID)
+	if err != nil {
+		return err
+	}
+	// A veto or commissioner ruling may have landed since the trade was selected
+	if trade.Status != model.TradeStatusInReview {
+		return nil
+	}
+
+	trade, err = executeTrade(ctx, tx, trade)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	log.Printf("Trade %s in draft room %s is %s", trade.ID, trade.DraftRoomID, trade.Status)
+	return nil
+}
@@ -0,0 +1,643 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"fantasy-draft/fantasy"
+	"fantasy-draft/graph/model"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// formatTimestamp renders database timestamps for the API
+func formatTimestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// waiverSettings converts the stored season settings into the fantasy package's form
+func waiverSettings(season *model.FantasySeason) fantasy.WaiverSettings {
+	return fantasy.WaiverSettings{
+		Mode:        fantasy.WaiverMode(season.WaiverSettings.Mode),
+		FAABBudget:  season.WaiverSettings.FaabBudget,
+		ProcessDay:  time.Weekday(season.WaiverSettings.ProcessDay),
+		ProcessHour: season.WaiverSettings.ProcessHour,
+	}
+}
+
+// resolveWaiverSettings fills any fields missing from the input with the league defaults
+func resolveWaiverSettings(input *model.WaiverSettingsInput) fantasy.WaiverSettings {
+	settings := fantasy.DefaultWaiverSettings
+	if input == nil {
+		return settings
+	}
+
+	settings.Mode = fantasy.WaiverMode(input.Mode)
+	if input.FaabBudget != nil {
+		settings.FAABBudget = *input.FaabBudget
+	}
+	if input.ProcessDay != nil {
+		settings.ProcessDay = time.Weekday(*input.ProcessDay)
+	}
+	if input.ProcessHour != nil {
+		settings.ProcessHour = *input.ProcessHour
+	}
+	return settings
+}
+
+// initializeWaiverOrder gives the last pick of the draft the first waiver claim and resets every budget
+func initializeWaiverOrder(ctx context.Context, tx pgx.Tx, draftRoomID string, faabBudget int) error {
+	_, err := tx.Exec(ctx, `
+		UPDATE fantasy_teams ft
+		SET waiver_priority = ordered.priority, faab_remaining = $2
+		FROM (
+			SELECT id, ROW_NUMBER() OVER (ORDER BY draft_order_number DESC NULLS LAST, created_at, id) AS priority
+			FROM fantasy_teams
+			WHERE draft_room_id = $1
+		) ordered
+		WHERE ft.id = ordered.id
+	`, draftRoomID, faabBudget)
+	if err != nil {
+		return fmt.Errorf("failed to set waiver order: %w", err)
+	}
+	return nil
+}
+
+// loadTeamDraftRoomID returns the draft room a fantasy team plays in
+func loadTeamDraftRoomID(ctx context.Context, q querier, teamID string) (string, error) {
+	var draftRoomID string
+	err := q.QueryRow(ctx, "SELECT draft_room_id FROM fantasy_teams WHERE id = $1", teamID).Scan(&draftRoomID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("fantasy team %s not found", teamID)
+	}
+	return draftRoomID, err
+}
+
+// loadRosterRules returns a draft room's roster rules, or its sport's defaults if it has none
+func loadRosterRules(ctx context.Context, q querier, draftRoomID string) (fantasy.RosterRules, error) {
+	var raw []byte
+	var sportType string
+	err := q.QueryRow(ctx, "SELECT roster_rules, sport_type FROM draft_rooms WHERE id = $1", draftRoomID).Scan(&raw, &sportType)
+	if err != nil {
+		return fantasy.RosterRules{}, err
+	}
+	if len(raw) == 0 {
+		return fantasy.DefaultRosterRulesFor(sportType), nil
+	}
+	return fantasy.ParseRosterRules(raw)
+}
+
+// loadRoster maps each player on a fantasy team to their roster spot
+func loadRoster(ctx context.Context, q querier, teamID string) (map[string]string, error) {
+	rows, err := q.Query(ctx, "SELECT player_id, roster_spot FROM fantasy_rosters WHERE fantasy_team_id = $1", teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	roster := make(map[string]string)
+	for rows.Next() {
+		var playerID, spot string
+		if err := rows.Scan(&playerID, &spot); err != nil {
+			return nil, err
+		}
+		roster[playerID] = spot
+	}
+	return roster, rows.Err()
+}
+
+// checkPlayerAvailable returns an error unless the player is an active pro who is not on any roster in the draft room
+func checkPlayerAvailable(ctx context.Context, q querier, draftRoomID, playerID string) error {
+	var status string
+	var rostered bool
+	err := q.QueryRow(ctx, `
+		SELECT p.status, EXISTS (
+			SELECT 1
+			FROM fantasy_rosters fr
+			JOIN fantasy_teams ft ON ft.id = fr.fantasy_team_id
+			WHERE ft.draft_room_id = $1 AND fr.player_id = p.id
+		)
+		FROM players p
+		WHERE p.id = $2
+	`, draftRoomID, playerID).Scan(&status, &rostered)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("player %s not found", playerID)
+	}
+	if err != nil {
+		return err
+	}
+	if status == string(model.PlayerStatusRetired) {
+		return fmt.Errorf("player %s is retired", playerID)
+	}
+	if rostered {
+		return fmt.Errorf("player %s is already on a roster in this draft room", playerID)
+	}
+	return nil
+}
+
+// isOnWaivers reports whether a player was dropped since the last waiver batch started and has
+// not cleared yet. A batch is stamped with the time it started, so the drops its own claims make
+// carry the same time and wait for the next batch.
+func isOnWaivers(ctx context.Context, q querier, draftRoomID, playerID string) (bool, error) {
+	var clearedBefore time.Time
+	var lastDrop *time.Time
+	err := q.QueryRow(ctx, `
+		SELECT COALESCE(s.waivers_processed_at, s.created_at), (
+			SELECT MAX(t.created_at)
+			FROM fantasy_transactions t
+			WHERE t.draft_room_id = s.draft_room_id AND t.player_id = $2 AND t.type = 'DROP'
+		)
+		FROM fantasy_seasons s
+		WHERE s.draft_room_id = $1
+	`, draftRoomID, playerID).Scan(&clearedBefore, &lastDrop)
+	if err != nil {
+		return false, err
+	}
+	return lastDrop != nil && !lastDrop.Before(clearedBefore), nil
+}
+
+// waiverClaimColumns is the column list scanned by scanWaiverClaim
+const waiverClaimColumns = `
+	id, fantasy_team_id, add_player_id, drop_player_id, bid_amount,
+	status, failure_reason, created_at, processed_at
+`
+
+// scanWaiverClaim scans a row selected with waiverClaimColumns
+func scanWaiverClaim(row pgx.Row) (*model.WaiverClaim, error) {
+	var c model.WaiverClaim
+	var dropPlayerID *string
+	var status string
+	var createdAt time.Time
+	var processedAt *time.Time
+
+	err := row.Scan(
+		&c.ID, &c.TeamID, &c.AddPlayerID, &dropPlayerID, &c.Bid,
+		&status, &c.FailureReason, &createdAt, &processedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if dropPlayerID != nil {
+		c.DropPlayerID = *dropPlayerID
+	}
+	c.Status = model.WaiverClaimStatus(status)
+	c.CreatedAt = formatTimestamp(createdAt)
+	if processedAt != nil {
+		processed := formatTimestamp(*processedAt)
+		c.ProcessedAt = &processed
+	}
+	return &c, nil
+}
+
+// loadWaiverClaims returns a draft room's claims in submission order
+func loadWaiverClaims(ctx context.Context, q querier, draftRoomID string, teamID *string, status *model.WaiverClaimStatus) ([]*model.WaiverClaim, error) {
+	var statusFilter *string
+	if status != nil {
+		value := status.String()
+		statusFilter = &value
+	}
+
+	rows, err := q.Query(ctx, `
+		SELECT `+waiverClaimColumns+`
+		FROM fantasy_waiver_claims
+		WHERE draft_room_id = $1
+		  AND ($2::uuid IS NULL OR fantasy_team_id = $2)
+		  AND ($3::waiver_claim_status_enum IS NULL OR status = $3)
+		ORDER BY created_at, id
+	`, draftRoomID, teamID, statusFilter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var claims []*model.WaiverClaim
+	for rows.Next() {
+		c, err := scanWaiverClaim(rows)
+		if err != nil {
+			return nil, err
+		}
+		claims = append(claims, c)
+	}
+	return claims, rows.Err()
+}
+
+// transactionColumns is the column list scanned by scanTransaction
+const transactionColumns = `id, type, fantasy_team_id, player_id, faab_amount, trade_id, created_at`
+
+// scanTransaction scans a row selected with transactionColumns
+func scanTransaction(row pgx.Row) (*model.FantasyTransaction, error) {
+	var t model.FantasyTransaction
+	var txType string
+	var tradeID *string
+	var createdAt time.Time
+
+	if err := row.Scan(&t.ID, &txType, &t.TeamID, &t.PlayerID, &t.FaabAmount, &tradeID, &createdAt); err != nil {
+		return nil, err
+	}
+	t.Type = model.TransactionType(txType)
+	if tradeID != nil {
+		t.TradeID = *tradeID
+	}
+	t.CreatedAt = formatTimestamp(createdAt)
+	return &t, nil
+}
+
+// loadTransactions returns a draft room's roster moves, newest first
+func loadTransactions(ctx context.Context, q querier, draftRoomID string, teamID *string, limit int) ([]*model.FantasyTransaction, error) {
+	rows, err := q.Query(ctx, `
+		SELECT `+transactionColumns+`
+		FROM fantasy_transactions
+		WHERE draft_room_id = $1
+		  AND ($2::uuid IS NULL OR fantasy_team_id = $2)
+		ORDER BY created_at DESC, type, id
+		LIMIT $3
+	`, draftRoomID, teamID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transactions []*model.FantasyTransaction
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, t)
+	}
+	return transactions, rows.Err()
+}
+
+// recordTransaction appends a roster move to the draft room's history
+func recordTransaction(ctx context.Context, tx pgx.Tx, draftRoomID, teamID, playerID string, txType model.TransactionType, claimID, tradeID *string, faabAmount *int) (*model.FantasyTransaction, error) {
+	t, err := scanTransaction(tx.QueryRow(ctx, `
+		INSERT INTO fantasy_transactions (draft_room_id, fantasy_team_id, player_id, type, waiver_claim_id, trade_id, faab_amount)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING `+transactionColumns,
+		draftRoomID, teamID, playerID, txType.String(), claimID, tradeID, faabAmount))
+	if err != nil {
+		return nil, fmt.Errorf("failed to record %s transaction: %w", txType, err)
+	}
+	return t, nil
+}
+
+// addRosterPlayer puts a player on a team's bench and records the ADD
+func addRosterPlayer(ctx context.Context, tx pgx.Tx, draftRoomID, teamID, playerID string, claimID *string, faabAmount *int) (*model.FantasyTransaction, error) {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO fantasy_rosters (fantasy_team_id, player_id, roster_spot)
+		VALUES ($1, $2, $3)
+	`, teamID, playerID, fantasy.BenchSpot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add player %s: %w", playerID, err)
+	}
+	return recordTransaction(ctx, tx, draftRoomID, teamID, playerID, model.TransactionTypeAdd, claimID, nil, faabAmount)
+}
+
+// removeRosterPlayer releases a player from a team and records the DROP
+func removeRosterPlayer(ctx context.Context, tx pgx.Tx, draftRoomID, teamID, playerID string, claimID *string) (*model.FantasyTransaction, error) {
+	tag, err := tx.Exec(ctx, "DELETE FROM fantasy_rosters WHERE fantasy_team_id = $1 AND player_id = $2", teamID, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to drop player %s: %w", playerID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, fmt.Errorf("player %s is not on team %s's roster", playerID, teamID)
+	}
+	return recordTransaction(ctx, tx, draftRoomID, teamID, playerID, model.TransactionTypeDrop, claimID, nil, nil)
+}
+
+// lockTeamSeason finds a team's draft room and locks its season so roster moves in the room run one at a time
+func lockTeamSeason(ctx context.Context, tx pgx.Tx, teamID string) (*model.FantasySeason, error) {
+	draftRoomID, err := loadTeamDraftRoomID(ctx, tx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return lockFantasySeason(ctx, tx, draftRoomID)
+}
+
+// submitWaiverClaim queues a claim for the next waiver batch
+func submitWaiverClaim(ctx context.Context, tx pgx.Tx, teamID, addPlayerID string, dropPlayerID *string, bid *int) (*model.WaiverClaim, error) {
+	season, err := lockTeamSeason(ctx, tx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPlayerAvailable(ctx, tx, season.DraftRoomID, addPlayerID); err != nil {
+		return nil, err
+	}
+
+	if dropPlayerID != nil {
+		roster, err := loadRoster(ctx, tx, teamID)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := roster[*dropPlayerID]; !ok {
+			return nil, fmt.Errorf("player %s is not on team %s's roster", *dropPlayerID, teamID)
+		}
+	}
+
+	// Bids only matter in FAAB leagues
+	amount := 0
+	if waiverSettings(season).Mode == fantasy.WaiverModeFAAB && bid != nil {
+		amount = *bid
+		if amount < 0 {
+			return nil, fmt.Errorf("bid cannot be negative, got %d", amount)
+		}
+		team, err := loadFantasyTeam(ctx, tx, teamID)
+		if err != nil {
+			return nil, err
+		}
+		if team.FaabRemaining != nil && amount > *team.FaabRemaining {
+			return nil, fmt.Errorf("bid of %d exceeds remaining budget of %d", amount, *team.FaabRemaining)
+		}
+	}
+
+	var duplicate bool
+	err = tx.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM fantasy_waiver_claims
+			WHERE fantasy_team_id = $1 AND add_player_id = $2 AND status = 'PENDING'
+		)
+	`, teamID, addPlayerID).Scan(&duplicate)
+	if err != nil {
+		return nil, err
+	}
+	if duplicate {
+		return nil, fmt.Errorf("team %s already has a pending claim for player %s", teamID, addPlayerID)
+	}
+
+	claim, err := scanWaiverClaim(tx.QueryRow(ctx, `
+		INSERT INTO fantasy_waiver_claims (draft_room_id, fantasy_team_id, add_player_id, drop_player_id, bid_amount)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING `+waiverClaimColumns,
+		season.DraftRoomID, teamID, addPlayerID, dropPlayerID, amount))
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert waiver claim: %w", err)
+	}
+	return claim, nil
+}
+
+// cancelWaiverClaim withdraws a claim that has not been processed yet
+func cancelWaiverClaim(ctx context.Context, tx pgx.Tx, claimID string) (*model.WaiverClaim, error) {
+	claim, err := scanWaiverClaim(tx.QueryRow(ctx, `
+		UPDATE fantasy_waiver_claims
+		SET status = 'CANCELLED', processed_at = NOW()
+		WHERE id = $1 AND status = 'PENDING'
+		RETURNING `+waiverClaimColumns,
+		claimID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("waiver claim %s not found or no longer pending", claimID)
+	}
+	return claim, err
+}
+
+// addFreeAgent signs a player who is not on waivers straight onto a team's bench
+func addFreeAgent(ctx context.Context, tx pgx.Tx, teamID, playerID string, dropPlayerID *string) ([]*model.FantasyTransaction, error) {
+	season, err := lockTeamSeason(ctx, tx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPlayerAvailable(ctx, tx, season.DraftRoomID, playerID); err != nil {
+		return nil, err
+	}
+
+	onWaivers, err := isOnWaivers(ctx, tx, season.DraftRoomID, playerID)
+	if err != nil {
+		return nil, err
+	}
+	if onWaivers {
+		return nil, fmt.Errorf("player %s is on waivers until %s, submit a waiver claim instead",
+			playerID, season.WaiverSettings.NextProcessAt)
+	}
+
+	rules, err := loadRosterRules(ctx, tx, season.DraftRoomID)
+	if err != nil {
+		return nil, err
+	}
+	roster, err := loadRoster(ctx, tx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if dropPlayerID != nil {
+		delete(roster, *dropPlayerID)
+	}
+	if fantasy.ActivePlayers(roster) >= rules.MaxActivePlayers() {
+		return nil, fmt.Errorf("roster is full, drop a player to make room")
+	}
+
+	var transactions []*model.FantasyTransaction
+	if dropPlayerID != nil {
+		drop, err := removeRosterPlayer(ctx, tx, season.DraftRoomID, teamID, *dropPlayerID, nil)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, drop)
+	}
+	add, err := addRosterPlayer(ctx, tx, season.DraftRoomID, teamID, playerID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return append(transactions, add), nil
+}
+
+// dropPlayer releases a player onto waivers
+func dropPlayer(ctx context.Context, tx pgx.Tx, teamID, playerID string) (*model.FantasyTransaction, error) {
+	season, err := lockTeamSeason(ctx, tx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return removeRosterPlayer(ctx, tx, season.DraftRoomID, teamID, playerID, nil)
+}
+
+// loadWaiverTeams returns every team in a draft room with its waiver standing and roster
+func loadWaiverTeams(ctx context.Context, q querier, draftRoomID string) ([]fantasy.WaiverTeam, error) {
+	rows, err := q.Query(ctx, `
+		SELECT id, COALESCE(waiver_priority, 0), COALESCE(faab_remaining, 0)
+		FROM fantasy_teams
+		WHERE draft_room_id = $1
+		ORDER BY waiver_priority NULLS LAST, id
+	`, draftRoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	var teams []fantasy.WaiverTeam
+	for rows.Next() {
+		var t fantasy.WaiverTeam
+		if err := rows.Scan(&t.ID, &t.Priority, &t.Budget); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		teams = append(teams, t)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range teams {
+		if teams[i].Roster, err = loadRoster(ctx, q, teams[i].ID); err != nil {
+			return nil, err
+		}
+	}
+	return teams, nil
+}
+
+// processWaiverClaims runs a draft room's batch of pending claims, applies the winning
+// add/drops and updates each team's waiver priority and budget.
+// The processed claims are returned in the order they were decided.
+func processWaiverClaims(ctx context.Context, tx pgx.Tx, draftRoomID string) ([]*model.WaiverClaim, error) {
+	season, err := lockFantasySeason(ctx, tx, draftRoomID)
+	if err != nil {
+		return nil, err
+	}
+	settings := waiverSettings(season)
+
+	// The batch is stamped before its claims run: NOW() is the transaction's start time, so the
+	// drops the claims record carry the stamp and stay on waivers until the next batch
+	_, err = tx.Exec(ctx, `
+		UPDATE fantasy_seasons
+		SET waivers_processed_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+	`, season.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark waivers processed: %w", err)
+	}
+
+	rules, err := loadRosterRules(ctx, tx, draftRoomID)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := loadWaiverTeams(ctx, tx, draftRoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := model.WaiverClaimStatusPending
+	pending, err := loadWaiverClaims(ctx, tx, draftRoomID, nil, &status)
+	if err != nil {
+		return nil, err
+	}
+	claims := make([]fantasy.WaiverClaim, len(pending))
+	for i, c := range pending {
+		claims[i] = fantasy.WaiverClaim{
+			ID:           c.ID,
+			TeamID:       c.TeamID,
+			AddPlayerID:  c.AddPlayerID,
+			DropPlayerID: c.DropPlayerID,
+			Bid:          c.Bid,
+		}
+	}
+
+	results, updated := fantasy.ProcessWaivers(settings.Mode, rules.MaxActivePlayers(), teams, claims)
+
+	processed := make([]*model.WaiverClaim, 0, len(results))
+	for _, result := range results {
+		claim := result.Claim
+		status, reason := model.WaiverClaimStatusFailed, &result.Reason
+		if result.Successful {
+			status, reason = model.WaiverClaimStatusSuccessful, nil
+
+			if claim.DropPlayerID != "" {
+				if _, err := removeRosterPlayer(ctx, tx, draftRoomID, claim.TeamID, claim.DropPlayerID, &claim.ID); err != nil {
+					return nil, err
+				}
+			}
+			var faabAmount *int
+			if settings.Mode == fantasy.WaiverModeFAAB {
+				faabAmount = &claim.Bid
+			}
+			if _, err := addRosterPlayer(ctx, tx, draftRoomID, claim.TeamID, claim.AddPlayerID, &claim.ID, faabAmount); err != nil {
+				return nil, err
+			}
+		}
+
+		c, err := scanWaiverClaim(tx.QueryRow(ctx, `
+			UPDATE fantasy_waiver_claims
+			SET status = $2, failure_reason = $3, processed_at = NOW()
+			WHERE id = $1
+			RETURNING `+waiverClaimColumns,
+			claim.ID, status.String(), reason))
+		if err != nil {
+			return nil, fmt.Errorf("failed to update waiver claim %s: %w", claim.ID, err)
+		}
+		processed = append(processed, c)
+	}
+
+	for _, t := range updated {
+		_, err := tx.Exec(ctx, `
+			UPDATE fantasy_teams
+			SET waiver_priority = $2, faab_remaining = $3
+			WHERE id = $1
+		`, t.ID, t.Priority, t.Budget)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update waiver order: %w", err)
+		}
+	}
+
+	return processed, nil
+}
+
+// ProcessDueWaivers runs the waiver batch for every draft room whose scheduled time has passed.
+// Each draft room is processed in its own transaction so one failure doesn't hold up the rest.
+// It returns how many draft rooms were processed.
+func ProcessDueWaivers(ctx context.Context, db *pgxpool.Pool, now time.Time) (int, error) {
+	rows, err := db.Query(ctx, "SELECT "+fantasySeasonColumns+" FROM fantasy_seasons")
+	if err != nil {
+		return 0, err
+	}
+
+	var due []string
+	for rows.Next() {
+		season, err := scanFantasySeason(rows)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		nextRun, err := time.Parse(time.RFC3339, season.WaiverSettings.NextProcessAt)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		if !nextRun.After(now) {
+			due = append(due, season.DraftRoomID)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	processed := 0
+	for _, draftRoomID := range due {
+		if err := processDueRoom(ctx, db, draftRoomID); err != nil {
+			errs = append(errs, fmt.Errorf("draft room %s: %w", draftRoomID, err))
+			continue
+		}
+		processed++
+	}
+	return processed, errors.Join(errs...)
+}
+
+func processDueRoom(ctx context.Context, db *pgxpool.Pool, draftRoomID string) error {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx) // Will be ignored if tx.Commit() succeeds
+
+	claims, err := processWaiverClaims(ctx, tx, draftRoomID)
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	log.Printf("Processed %d waiver claims for draft room %s", len(claims), draftRoomID)
+	return nil
+}
@@ -0,0 +1,261 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// fakeRoom is a draft room's waiver state, kept in memory for fakeTx to read and write
+type fakeRoom struct {
+	id                 string
+	seasonCreatedAt    time.Time
+	waiversProcessedAt *time.Time
+	priorities         map[string]int
+	rosters            map[string]map[string]string
+	claims             []fakeClaim
+	transactions       []fakeTransaction
+}
+
+type fakeClaim struct {
+	id, teamID, addPlayerID string
+	dropPlayerID            *string
+	status                  string
+}
+
+type fakeTransaction struct {
+	id, teamID, playerID, txType string
+	createdAt                    time.Time
+}
+
+// fakeTx answers the statements the waiver functions run against a fakeRoom. Like Postgres,
+// NOW() is the time the transaction started.
+type fakeTx struct {
+	room *fakeRoom
+	now  time.Time
+}
+
+func (tx *fakeTx) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	room := tx.room
+	switch {
+	case strings.Contains(sql, "UPDATE fantasy_seasons"):
+		now := tx.now
+		room.waiversProcessedAt = &now
+		return pgconn.NewCommandTag("UPDATE 1"), nil
+	case strings.Contains(sql, "DELETE FROM fantasy_rosters"):
+		roster := room.rosters[args[0].(string)]
+		if _, ok := roster[args[1].(string)]; !ok {
+			return pgconn.NewCommandTag("DELETE 0"), nil
+		}
+		delete(roster, args[1].(string))
+		return pgconn.NewCommandTag("DELETE 1"), nil
+	case strings.Contains(sql, "INSERT INTO fantasy_rosters"):
+		room.rosters[args[0].(string)][args[1].(string)] = args[2].(string)
+		return pgconn.NewCommandTag("INSERT 0 1"), nil
+	case strings.Contains(sql, "UPDATE fantasy_teams"):
+		room.priorities[args[0].(string)] = args[1].(int)
+		return pgconn.NewCommandTag("UPDATE 1"), nil
+	}
+	return pgconn.CommandTag{}, fmt.Errorf("fakeTx can't exec %s", sql)
+}
+
+func (tx *fakeTx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	room := tx.room
+	switch {
+	case strings.Contains(sql, "FROM fantasy_seasons WHERE draft_room_id = $1 FOR UPDATE"):
+		return fakeRow{values: []any{
+			"season-1", room.id, 2025, 14, 1,
+			4, 0, 1, []string(nil), nil,
+			"ROLLING", 100, 3, 9, room.waiversProcessedAt,
+			24, nil, room.seasonCreatedAt,
+		}}
+	case strings.Contains(sql, "FROM draft_rooms"):
+		return fakeRow{values: []any{[]byte(nil), "FOOTBALL"}}
+	case strings.Contains(sql, "SELECT draft_room_id FROM fantasy_teams"):
+		return fakeRow{values: []any{room.id}}
+	case strings.Contains(sql, "SELECT p.status"):
+		rostered := false
+		for _, roster := range room.rosters {
+			_, ok := roster[args[1].(string)]
+			rostered = rostered || ok
+		}
+		return fakeRow{values: []any{"ACTIVE", rostered}}
+	case strings.Contains(sql, "COALESCE(s.waivers_processed_at, s.created_at)"):
+		clearedBefore := room.seasonCreatedAt
+		if room.waiversProcessedAt != nil {
+			clearedBefore = *room.waiversProcessedAt
+		}
+		var lastDrop *time.Time
+		for _, t := range room.transactions {
+			if t.playerID == args[1].(string) && t.txType == "DROP" && (lastDrop == nil || t.createdAt.After(*lastDrop)) {
+				lastDrop = &t.createdAt
+			}
+		}
+		return fakeRow{values: []any{clearedBefore, lastDrop}}
+	case strings.Contains(sql, "INSERT INTO fantasy_transactions"):
+		t := fakeTransaction{id: fmt.Sprintf("transaction-%d", len(room.transactions)+1), teamID: args[1].(string), playerID: args[2].(string), txType: args[3].(string), createdAt: tx.now}
+		room.transactions = append(room.transactions, t)
+		return fakeRow{values: []any{t.id, t.txType, t.teamID, t.playerID, args[6], nil, t.createdAt}}
+	case strings.Contains(sql, "UPDATE fantasy_waiver_claims"):
+		for i := range room.claims {
+			if room.claims[i].id == args[0].(string) {
+				room.claims[i].status = args[1].(string)
+				return fakeRow{values: tx.claimValues(room.claims[i], args[2])}
+			}
+		}
+		return fakeRow{err: pgx.ErrNoRows}
+	}
+	return fakeRow{err: fmt.Errorf("fakeTx can't query %s", sql)}
+}
+
+func (tx *fakeTx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	room := tx.room
+	var rows [][]any
+	switch {
+	case strings.Contains(sql, "COALESCE(waiver_priority, 0)"):
+		teams := make([]string, 0, len(room.priorities))
+		for id := range room.priorities {
+			teams = append(teams, id)
+		}
+		slices.SortFunc(teams, func(a, b string) int { return room.priorities[a] - room.priorities[b] })
+		for _, id := range teams {
+			rows = append(rows, []any{id, room.priorities[id], 0})
+		}
+	case strings.Contains(sql, "FROM fantasy_rosters"):
+		for playerID, spot := range room.rosters[args[0].(string)] {
+			rows = append(rows, []any{playerID, spot})
+		}
+	case strings.Contains(sql, "FROM fantasy_waiver_claims"):
+		for _, claim := range room.claims {
+			if claim.status == "PENDING" {
+				rows = append(rows, tx.claimValues(claim, nil))
+			}
+		}
+	default:
+		return nil, fmt.Errorf("fakeTx can't query %s", sql)
+	}
+	return &fakeRows{rows: rows, next: -1}, nil
+}
+
+// claimValues lays a claim out as waiverClaimColumns
+func (tx *fakeTx) claimValues(claim fakeClaim, reason any) []any {
+	var processedAt *time.Time
+	if claim.status != "PENDING" {
+		processedAt = &tx.now
+	}
+	return []any{claim.id, claim.teamID, claim.addPlayerID, claim.dropPlayerID, 0, claim.status, reason, tx.room.seasonCreatedAt, processedAt}
+}
+
+func (tx *fakeTx) Begin(ctx context.Context) (pgx.Tx, error) { return nil, nil }
+func (tx *fakeTx) Commit(ctx context.Context) error          { return nil }
+func (tx *fakeTx) Rollback(ctx context.Context) error        { return nil }
+func (tx *fakeTx) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	return 0, nil
+}
+func (tx *fakeTx) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults { return nil }
+func (tx *fakeTx) LargeObjects() pgx.LargeObjects                               { return pgx.LargeObjects{} }
+func (tx *fakeTx) Prepare(ctx context.Context, name, sql string) (*pgconn.StatementDescription, error) {
+	return nil, nil
+}
+func (tx *fakeTx) Conn() *pgx.Conn { return nil }
+
+// fakeRow scans its values into the destinations, converting them to the destinations' types
+type fakeRow struct {
+	values []any
+	err    error
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	return scanFakeValues(r.values, dest)
+}
+
+func scanFakeValues(values []any, dest []any) error {
+	if len(values) != len(dest) {
+		return fmt.Errorf("fake row has %d values for %d destinations", len(values), len(dest))
+	}
+	for i, value := range values {
+		target := reflect.ValueOf(dest[i]).Elem()
+		if value == nil {
+			target.Set(reflect.Zero(target.Type()))
+			continue
+		}
+		v := reflect.ValueOf(value)
+		if target.Kind() == reflect.Pointer && v.Kind() != reflect.Pointer {
+			p := reflect.New(target.Type().Elem())
+			p.Elem().Set(v.Convert(target.Type().Elem()))
+			target.Set(p)
+			continue
+		}
+		target.Set(v.Convert(target.Type()))
+	}
+	return nil
+}
+
+// fakeRows steps through fakeTx query results
+type fakeRows struct {
+	rows [][]any
+	next int
+}
+
+func (r *fakeRows) Close()                                       {}
+func (r *fakeRows) Err() error                                   { return nil }
+func (r *fakeRows) CommandTag() pgconn.CommandTag                { return pgconn.CommandTag{} }
+func (r *fakeRows) FieldDescriptions() []pgconn.FieldDescription { return nil }
+func (r *fakeRows) Next() bool {
+	r.next++
+	return r.next < len(r.rows)
+}
+func (r *fakeRows) Scan(dest ...any) error { return scanFakeValues(r.rows[r.next], dest) }
+func (r *fakeRows) Values() ([]any, error) { return r.rows[r.next], nil }
+func (r *fakeRows) RawValues() [][]byte    { return nil }
+func (r *fakeRows) Conn() *pgx.Conn        { return nil }
+
+func TestClaimDropClearsInALaterBatch(t *testing.T) {
+	ctx := context.Background()
+	batch := time.Date(2025, 10, 1, 9, 0, 0, 0, time.UTC)
+	dropped := "player-1"
+	room := &fakeRoom{
+		id:              "room-1",
+		seasonCreatedAt: batch.AddDate(0, 0, -7),
+		priorities:      map[string]int{"team-a": 1, "team-b": 2},
+		rosters: map[string]map[string]string{
+			"team-a": {dropped: "BENCH"},
+			"team-b": {"player-2": "BENCH"},
+		},
+		claims: []fakeClaim{{id: "claim-1", teamID: "team-a", addPlayerID: "player-3", dropPlayerID: &dropped, status: "PENDING"}},
+	}
+
+	processed, err := processWaiverClaims(ctx, &fakeTx{room: room, now: batch}, room.id)
+	if err != nil {
+		t.Fatalf("Expected the batch to run, got %v", err)
+	}
+	if len(processed) != 1 || processed[0].Status != "SUCCESSFUL" {
+		t.Fatalf("Expected the claim to succeed, got %+v", processed)
+	}
+
+	// The batch's own drop waits for the next batch, however soon after it the player is picked up
+	_, err = addFreeAgent(ctx, &fakeTx{room: room, now: batch.Add(time.Second)}, "team-b", dropped, nil)
+	if err == nil || !strings.Contains(err.Error(), "on waivers") {
+		t.Errorf("Expected the dropped player to be on waivers, got %v", err)
+	}
+
+	if _, err := processWaiverClaims(ctx, &fakeTx{room: room, now: batch.AddDate(0, 0, 7)}, room.id); err != nil {
+		t.Fatalf("Expected the next batch to run, got %v", err)
+	}
+	if _, err := addFreeAgent(ctx, &fakeTx{room: room, now: batch.AddDate(0, 0, 7).Add(time.Second)}, "team-b", dropped, nil); err != nil {
+		t.Errorf("Expected the player to clear waivers in the next batch, got %v", err)
+	}
+	if _, ok := room.rosters["team-b"][dropped]; !ok {
+		t.Error("Expected the player to join team-b")
+	}
+}
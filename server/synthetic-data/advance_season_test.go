@@ -0,0 +1,390 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRetirementChance(t *testing.T) {
+	tests := []struct {
+		name     string
+		player   Player
+		expected float64
+	}{
+		{"young starter", Player{Position: "RB", Age: 24, Skill: 0.8}, baseRetirementChance},
+		{"three years from retirement", Player{Position: "RB", Age: 28, Skill: 0.8}, 0.2},
+		{"a year from retirement", Player{Position: "RB", Age: 30, Skill: 0.8}, 0.6},
+		{"past retirement age", Player{Position: "RB", Age: 33, Skill: 0.8}, 0.8},
+		{"quarterbacks last longer", Player{Position: "QB", Age: 33, Skill: 0.8}, baseRetirementChance},
+		{"declining young player", Player{Position: "WR", Age: 24, Skill: 0.2}, baseRetirementChance + 0.3},
+		{"declining veteran", Player{Position: "WR", Age: 35, Skill: 0.2}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := retirementChance(tt.player); fmt.Sprintf("%.3f", result) != fmt.Sprintf("%.3f", tt.expected) {
+				t.Errorf("Expected %.3f, got %.3f", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestAgePlayer(t *testing.T) {
+	player := Player{ID: "rb-1", Position: "RB", Age: 27, YearsOfExperience: 5, DraftYear: 2020, Skill: 0.8}
+
+	aged := agePlayer(player)
+
+	if aged.Age != 28 || aged.YearsOfExperience != 6 {
+		t.Errorf("Expected age 28 with 6 years of experience, got %d and %d", aged.Age, aged.YearsOfExperience)
+	}
+	if aged.DraftYear != 2020 {
+		t.Errorf("Expected the draft year to stay 2020, got %d", aged.DraftYear)
+	}
+	if aged.Skill >= player.Skill {
+		t.Errorf("Expected a running back past their peak to decline, got %.3f from %.3f", aged.Skill, player.Skill)
+	}
+}
+
+func newTestAdvancer(retiring ...string) *SeasonAdvancer {
+	prospects := 0
+	return NewSeasonAdvancer(SeasonAdvancerConfig{
+		SimulatorConfig: YearSimulatorConfig{
+			GamesPerSeason: 4,
+			InjuryRoller:   func(age int, position string) (bool, int) { return false, 0 },
+			StatsGenerator: func(player Player, yoe int) FootballStats {
+				return FootballStats{RushingAttempts: 10, RushingYards: 50}
+			},
+			StatMultiplier: func(player Player, stats FootballStats) FootballStats {
+				return stats
+			},
+		},
+		RetirementRoller: func(player Player) bool {
+			for _, id := range retiring {
+				if player.ID == id {
+					return true
+				}
+			}
+			return false
+		},
+		ProspectGenerator: func(position Position, draftYear int) Player {
+			prospects++
+			return Player{
+				ID:           fmt.Sprintf("prospect-%d", prospects),
+				Position:     string(position),
+				Age:          22,
+				DraftYear:    draftYear,
+				Skill:        0.5,
+				ScoutedSkill: 0.45,
+				Status:       "ACTIVE",
+			}
+		},
+		Rand:  rand.New(rand.NewSource(1)),
+		Quiet: true,
+	})
+}
+
+func testLeagueState() LeagueState {
+	roster := FootballTeamRoster{}
+	for i := range NFLRosterComposition["RB"] {
+		roster.RB = append(roster.RB, Player{
+			ID:        fmt.Sprintf("rb-%d", i+1),
+			Position:  "RB",
+			TeamID:    "team-1",
+			Age:       25,
+			DraftYear: 2021,
+			Skill:     0.9 - 0.2*float64(i),
+			Status:    "ACTIVE",
+		})
+	}
+	roster.RB[0].Status = "INJURED"
+	return LeagueState{
+		Year:    2025,
+		Teams:   []Team{{ID: "team-1"}},
+		Rosters: map[string]FootballTeamRoster{"team-1": roster},
+	}
+}
+
+func TestSeasonAdvancerForLeague(t *testing.T) {
+	stored := DefaultLeagueDefinition
+	stored.GamesPerSeason = 14
+
+	t.Run("plays the stored league", func(t *testing.T) {
+		advancer, err := NewSeasonAdvancer(SeasonAdvancerConfig{Quiet: true}).forLeague(&stored)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if advancer.simulatorConfig.GamesPerSeason != 14 || !reflect.DeepEqual(advancer.league, stored) {
+			t.Errorf("Expected the stored 14 game league, got %d games", advancer.simulatorConfig.GamesPerSeason)
+		}
+	})
+
+	t.Run("keeps a season length it was given", func(t *testing.T) {
+		advancer, err := newTestAdvancer().forLeague(&stored)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if advancer.simulatorConfig.GamesPerSeason != 4 {
+			t.Errorf("Expected the configured 4 game season, got %d", advancer.simulatorConfig.GamesPerSeason)
+		}
+	})
+
+	t.Run("refuses a league that isn't the stored one", func(t *testing.T) {
+		advancer := NewSeasonAdvancer(SeasonAdvancerConfig{League: DefaultLeagueDefinition, Quiet: true})
+		if _, err := advancer.forLeague(&stored); err == nil {
+			t.Error("Expected an error for a league the universe wasn't generated from")
+		}
+		if _, err := advancer.forLeague(&DefaultLeagueDefinition); err != nil {
+			t.Errorf("Expected the stored league to be accepted, got %v", err)
+		}
+	})
+
+	t.Run("plays the league it has without a stored one", func(t *testing.T) {
+		advancer := NewSeasonAdvancer(SeasonAdvancerConfig{Quiet: true})
+		if played, err := advancer.forLeague(nil); err != nil || played != advancer {
+			t.Errorf("Expected the advancer to play its own league, got %v", err)
+		}
+	})
+}
+
+func TestSeasonAdvancerAdvanceLeague(t *testing.T) {
+	t.Run("plays the season", func(t *testing.T) {
+		result := newTestAdvancer().AdvanceLeague(testLeagueState())
+
+		if result.Season != 2025 {
+			t.Errorf("Expected the 2025 season to be played, got %d", result.Season)
+		}
+		firstBye, lastBye := byeWeekWindow(4)
+		if result.Teams[0].ByeWeek < firstBye || result.Teams[0].ByeWeek > lastBye {
+			t.Errorf("Expected a new bye week, got %d", result.Teams[0].ByeWeek)
+		}
+
+		played := 0
+		for _, season := range result.Stats {
+			if season.Year == 2025 {
+				played++
+				if season.Age != 25 {
+					t.Errorf("Expected %s to play the season at 25, got %d", season.PlayerID, season.Age)
+				}
+			}
+		}
+		if played != NFLRosterComposition["RB"] {
+			t.Errorf("Expected %d played seasons, got %d", NFLRosterComposition["RB"], played)
+		}
+	})
+
+	t.Run("ages returning players and heals injuries", func(t *testing.T) {
+		result := newTestAdvancer().AdvanceLeague(testLeagueState())
+
+		for _, player := range result.Players {
+			if player.Age != 26 || player.YearsOfExperience != 1 {
+				t.Errorf("Expected %s to be 26 with 1 year of experience, got %d and %d", player.ID, player.Age, player.YearsOfExperience)
+			}
+			if player.Status != "ACTIVE" {
+				t.Errorf("Expected %s to be ACTIVE, got %s", player.ID, player.Status)
+			}
+		}
+	})
+
+	t.Run("retires players and drafts rookies to refill the roster", func(t *testing.T) {
+		result := newTestAdvancer("rb-1", "rb-3").AdvanceLeague(testLeagueState())
+
+		retired := 0
+		for _, player := range result.Players {
+			if player.Status == "RETIRED" {
+				retired++
+				if player.Age != 25 {
+					t.Errorf("Expected retiree %s to keep their final season's age, got %d", player.ID, player.Age)
+				}
+			}
+		}
+		if retired != 2 {
+			t.Errorf("Expected 2 retirees, got %d", retired)
+		}
+
+		// Every position is refilled, not only the one that lost players
+		rosterSize := 0
+		for _, position := range footballPositions {
+			rosterSize += NFLRosterComposition[string(position)]
+		}
+		if len(result.Rookies) != rosterSize-NFLRosterComposition["RB"]+2 {
+			t.Errorf("Expected %d rookies, got %d", rosterSize-NFLRosterComposition["RB"]+2, len(result.Rookies))
+		}
+		for _, rookie := range result.Rookies {
+			if rookie.DraftYear != 2026 {
+				t.Errorf("Expected rookies drafted in 2026, got %d", rookie.DraftYear)
+			}
+			if strings.HasPrefix(rookie.ID, "prospect-") {
+				t.Errorf("Expected rookie %s to be scoped to the universe", rookie.ID)
+			}
+			if rookie.TeamID != "team-1" || rookie.DraftRound == 0 || rookie.DraftPick != 1 {
+				t.Errorf("Expected %s to be team-1's pick in a draft round, got team %q round %d pick %d", rookie.ID, rookie.TeamID, rookie.DraftRound, rookie.DraftPick)
+			}
+		}
+		if len(result.DepthCharts) != rosterSize {
+			t.Errorf("Expected %d depth chart entries, got %d", rosterSize, len(result.DepthCharts))
+		}
+
+		placeholders := 0
+		for _, season := range result.Stats {
+			if season.Year == 2026 {
+				placeholders++
+				if season.Skill != 0.45 {
+					t.Errorf("Expected %s's first season to show the scouted skill 0.45, got %v", season.PlayerID, season.Skill)
+				}
+			}
+		}
+		if placeholders != len(result.Rookies) {
+			t.Errorf("Expected an empty first season for each of the %d rookies, got %d", len(result.Rookies), placeholders)
+		}
+	})
+
+	t.Run("plays each defense against the offense it faced", func(t *testing.T) {
+		state := testLeagueState()
+		state.Teams = append(state.Teams, Team{ID: "team-2"})
+		state.Rosters["team-2"] = state.Rosters["team-1"]
+		for _, teamID := range []string{"team-1", "team-2"} {
+			roster := state.Rosters[teamID]
+			roster.RB = slices.Clone(roster.RB)
+			for i := range roster.RB {
+				roster.RB[i].ID = teamID + "-" + roster.RB[i].ID
+				roster.RB[i].TeamID = teamID
+			}
+			roster.DST = []Player{{ID: teamID + "-dst", Position: "DST", TeamID: teamID, DraftYear: 2020, YearsOfExperience: 5, Skill: 0.6, Status: "ACTIVE"}}
+			state.Rosters[teamID] = roster
+		}
+
+		result := newTestAdvancer().AdvanceLeague(state)
+
+		rushingYards := make(map[int]int)
+		var defense *PlayerYearlyStatsFootball
+		for i, season := range result.Stats {
+			switch {
+			case season.PlayerID == "team-1-dst":
+				defense = &result.Stats[i]
+			case strings.HasPrefix(season.PlayerID, "team-2-rb"):
+				for _, week := range season.Stats.Weeks {
+					rushingYards[week.Week] += week.Stats.RushingYards
+				}
+			}
+		}
+		if defense == nil || len(defense.Stats.Weeks) == 0 {
+			t.Fatalf("Expected team-1's defense to play the 2025 season, got %+v", defense)
+		}
+		for _, week := range defense.Stats.Weeks {
+			if week.Stats.YardsAllowed != rushingYards[week.Week] {
+				t.Errorf("Expected week %d to allow team-2's %d rushing yards, got %d", week.Week, rushingYards[week.Week], week.Stats.YardsAllowed)
+			}
+		}
+
+		for _, player := range result.Players {
+			if player.Position == "DST" && (player.Status != "ACTIVE" || player.YearsOfExperience != 6) {
+				t.Errorf("Expected %s to return for a sixth season, got %s with %d years", player.ID, player.Status, player.YearsOfExperience)
+			}
+		}
+	})
+
+	t.Run("kicks for the offense and allows the kicks", func(t *testing.T) {
+		state := testLeagueState()
+		state.Teams = append(state.Teams, Team{ID: "team-2"})
+		state.Rosters["team-2"] = state.Rosters["team-1"]
+		for _, teamID := range []string{"team-1", "team-2"} {
+			roster := state.Rosters[teamID]
+			roster.RB = slices.Clone(roster.RB)
+			for i := range roster.RB {
+				roster.RB[i].ID = teamID + "-" + roster.RB[i].ID
+				roster.RB[i].TeamID = teamID
+			}
+			roster.PK = []Player{{ID: teamID + "-pk", Position: "PK", TeamID: teamID, Age: 28, DraftYear: 2020, YearsOfExperience: 5, Skill: 0.6, Status: "ACTIVE"}}
+			roster.DST = []Player{{ID: teamID + "-dst", Position: "DST", TeamID: teamID, DraftYear: 2020, YearsOfExperience: 5, Skill: 0.6, Status: "ACTIVE"}}
+			state.Rosters[teamID] = roster
+		}
+		advancer := newTestAdvancer()
+		advancer.simulatorConfig.StatsGenerator = func(player Player, yoe int) FootballStats {
+			return FootballStats{RushingAttempts: 10, RushingYards: 50, RushingTDs: 1}
+		}
+
+		result := advancer.AdvanceLeague(state)
+
+		touchdowns := make(map[int]int)
+		kicker := make(map[int]FootballStats)
+		var defense *PlayerYearlyStatsFootball
+		for i, season := range result.Stats {
+			for _, week := range season.Stats.Weeks {
+				switch {
+				case strings.HasPrefix(season.PlayerID, "team-2-rb"):
+					touchdowns[week.Week] += week.Stats.RushingTDs
+				case season.PlayerID == "team-2-pk":
+					kicker[week.Week] = week.Stats
+				}
+			}
+			if season.PlayerID == "team-1-dst" {
+				defense = &result.Stats[i]
+			}
+		}
+		if len(kicker) == 0 || defense == nil {
+			t.Fatalf("Expected team-2's kicker and team-1's defense to play, got %d kicker weeks and %+v", len(kicker), defense)
+		}
+		for week, kicks := range kicker {
+			if kicks.ExtraPoints != touchdowns[week] {
+				t.Errorf("Expected an extra point try for each of team-2's %d touchdowns in week %d, got %d", touchdowns[week], week, kicks.ExtraPoints)
+			}
+		}
+		for _, week := range defense.Stats.Weeks {
+			kicks := kicker[week.Week]
+			if allowed := 6*touchdowns[week.Week] + 3*kicks.FieldGoalsMade + kicks.ExtraPointsMade; week.Stats.PointsAllowed != allowed {
+				t.Errorf("Expected week %d to allow team-2's %d points, got %d", week.Week, allowed, week.Stats.PointsAllowed)
+			}
+		}
+	})
+
+	t.Run("ranks depth charts by skill", func(t *testing.T) {
+		result := newTestAdvancer("rb-1").AdvanceLeague(testLeagueState())
+
+		ranks := make(map[string]int)
+		for _, entry := range result.DepthCharts {
+			ranks[entry.PlayerID] = entry.Rank
+		}
+		// rb-2 (0.7) takes over from the retired starter, and rb-3 and the rookie (0.5) move ahead of rb-4 (0.3)
+		if ranks["rb-2"] != 1 {
+			t.Errorf("Expected rb-2 to start, got rank %d", ranks["rb-2"])
+		}
+		if ranks["rb-4"] != 4 {
+			t.Errorf("Expected rb-4 last, got rank %d", ranks["rb-4"])
+		}
+	})
+}
+
+func TestSeasonAdvancerSave(t *testing.T) {
+	advancer := newTestAdvancer("rb-1")
+	season := advancer.AdvanceLeague(testLeagueState())
+	mockTx := &MockTx{}
+
+	result, err := advancer.Save(context.Background(), mockTx, season)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Season != 2025 {
+		t.Errorf("Expected season 2025, got %d", result.Season)
+	}
+	if result.PlayersRetired != 1 {
+		t.Errorf("Expected 1 retiree, got %d", result.PlayersRetired)
+	}
+	if result.RookiesDrafted != len(season.Rookies) {
+		t.Errorf("Expected %d rookies, got %d", len(season.Rookies), result.RookiesDrafted)
+	}
+	if result.WeeklyStatsInserted != 4*(1+1+1) {
+		t.Errorf("Expected 12 weekly stat lines for the three running backs who saw the field, got %d", result.WeeklyStatsInserted)
+	}
+
+	expected := len(season.Teams) + 1 + len(season.Players) + len(season.Rookies) + 1 +
+		len(season.DepthCharts) + len(season.Stats) + result.WeeklyStatsInserted
+	if len(mockTx.ExecCalls) != expected {
+		t.Errorf("Expected %d exec calls, got %d", expected, len(mockTx.ExecCalls))
+	}
+}
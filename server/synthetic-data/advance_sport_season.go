@@ -0,0 +1,343 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math/rand"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// =============================================================================
+// BASKETBALL AND BASEBALL OFFSEASONS
+// =============================================================================
+
+// sportOffseason describes how a basketball or baseball league plays a season and restocks its rosters
+type sportOffseason struct {
+	// Name is the league's name in log output
+	Name string
+	// SportType is the sport_type the league's teams and stats are stored under
+	SportType string
+	// Positions lists the positions a roster carries, in the order prospects are generated
+	Positions []Position
+	// Composition is the roster teams draft back up to
+	Composition RosterComposition
+	// Groups splits a roster into its position groups, keeping each group's order
+	Groups func(roster []Player) [][]Player
+	// PlaySeason plays a position group, ordered starter first, through a season
+	PlaySeason func(group []Player, year int) []sportSeason
+	// Prospect creates a prospect entering the rookie draft
+	Prospect func(position Position, draftYear int) Player
+	// EmptyTotal is the season total of a rookie who hasn't played yet
+	EmptyTotal any
+}
+
+// basketballOffseason plays basketball seasons by rotation, drawing from rng
+func basketballOffseason(rng *rand.Rand) sportOffseason {
+	return sportOffseason{
+		Name:        "basketball",
+		SportType:   SportBasketball,
+		Positions:   basketballPositions,
+		Composition: BasketballRosterComposition,
+		Groups:      basketballRotations,
+		PlaySeason: func(group []Player, year int) []sportSeason {
+			walker := NewBasketballCareerSimulator(BasketballSimulatorConfig{Clock: yearClock(year), Rand: rng}).walker()
+			var played []PlayerYearlyStatsBasketball
+			for i, stats := range walker.season(group, year) {
+				played = append(played, walker.seasonRecord(group[i], year, stats))
+			}
+			return basketballSeasons(played)
+		},
+		Prospect: func(position Position, draftYear int) Player {
+			return createBasketballProspect(position, draftYear, rng)
+		},
+		EmptyTotal: BasketballStats{},
+	}
+}
+
+// baseballOffseason plays baseball seasons by depth chart, drawing from rng
+func baseballOffseason(rng *rand.Rand) sportOffseason {
+	return sportOffseason{
+		Name:        "baseball",
+		SportType:   SportBaseball,
+		Positions:   baseballPositions,
+		Composition: BaseballRosterComposition,
+		Groups:      baseballDepthCharts,
+		PlaySeason: func(group []Player, year int) []sportSeason {
+			walker := NewBaseballCareerSimulator(BaseballSimulatorConfig{Clock: yearClock(year), Rand: rng}).walker()
+			var played []PlayerYearlyStatsBaseball
+			for i, stats := range walker.season(group, year) {
+				played = append(played, walker.seasonRecord(group[i], year, stats))
+			}
+			return baseballSeasons(played)
+		},
+		Prospect: func(position Position, draftYear int) Player {
+			return createBaseballProspect(position, draftYear, rng)
+		},
+		EmptyTotal: BaseballStats{},
+	}
+}
+
+// SportLeagueState is a basketball or baseball league as stored in the database, going into its next unplayed season
+type SportLeagueState struct {
+	// Year is the next season to be played; players' ages and skills describe them in this season
+	Year int
+	// Teams holds every team of the league; only the ID is used
+	Teams []Team
+	// Rosters maps team IDs to their players still in the league, each position ordered by depth chart
+	Rosters map[string][]Player
+}
+
+// SportSeasonResult holds everything an advanced basketball or baseball season changes
+type SportSeasonResult struct {
+	// Season is the year that was played
+	Season int
+	// Seasons holds the played season of every player, plus an empty first season for each rookie
+	Seasons []sportSeason
+	// Players are the returning players as they go into the next season, retirees included with status RETIRED
+	Players []Player
+	// Rookies are the players drafted to refill the rosters, in draft order
+	Rookies []Player
+	// DepthCharts ranks every roster going into the next season
+	DepthCharts []DepthChartEntry
+}
+
+// AdvanceSportLeague plays out a basketball or baseball league's next season and runs the offseason after it,
+// without touching the database. It follows AdvanceLeague: each position group plays the season by depth
+// chart, then every player ages a year, some retire and the teams draft back up to the sport's roster
+// composition. The teams don't play a schedule, so every team has the same record and the draft is held in
+// a random order.
+func (a *SeasonAdvancer) AdvanceSportLeague(offseason sportOffseason, state SportLeagueState) SportSeasonResult {
+	result := SportSeasonResult{Season: state.Year}
+	nextRosters := make(map[string][]Player, len(state.Teams))
+	records := make([]TeamRecord, len(state.Teams))
+	for i, team := range state.Teams {
+		records[i] = TeamRecord{TeamID: team.ID}
+
+		var season []sportSeason
+		var players []Player
+		for _, group := range offseason.Groups(state.Rosters[team.ID]) {
+			season = append(season, offseason.PlaySeason(group, state.Year)...)
+			players = append(players, group...)
+		}
+
+		// Injuries heal over the offseason unless the player was still out when the season ended
+		for j := range players {
+			if players[j].Status == "INJURED" {
+				players[j].Status = "ACTIVE"
+			}
+		}
+		markInjuredSportPlayers(players, season)
+		result.Seasons = append(result.Seasons, season...)
+
+		for _, player := range players {
+			aged := agePlayer(player)
+			if a.retirementRoller(aged) {
+				player.Status = "RETIRED"
+				result.Players = append(result.Players, player)
+				continue
+			}
+			result.Players = append(result.Players, aged)
+			nextRosters[team.ID] = append(nextRosters[team.ID], aged)
+		}
+	}
+
+	// Every team drafts to fill the spots its retirees left open
+	nextYear := state.Year + 1
+	needs := make(map[string]map[string]int, len(state.Teams))
+	openSpots := make(map[Position]int)
+	for _, team := range state.Teams {
+		needs[team.ID] = make(map[string]int)
+		for _, position := range offseason.Positions {
+			returning := 0
+			for _, player := range nextRosters[team.ID] {
+				if player.Position == string(position) {
+					returning++
+				}
+			}
+			open := max(offseason.Composition[string(position)]-returning, 0)
+			needs[team.ID][string(position)] = open
+			openSpots[position] += open
+		}
+	}
+	// Prospects join the universe's players, so their IDs are scoped to it like theirs
+	scope := newUniverseScope(a.universe)
+	var class []Player
+	for _, position := range offseason.Positions {
+		for range classSize(openSpots[position]) {
+			prospect := offseason.Prospect(position, nextYear)
+			prospect.ID = scope.id(prospect.ID)
+			class = append(class, prospect)
+		}
+	}
+	result.Rookies = runRookieDraft(draftOrder(records, a.rng), needs, class)
+
+	for _, rookie := range result.Rookies {
+		nextRosters[rookie.TeamID] = append(nextRosters[rookie.TeamID], rookie)
+		result.Seasons = append(result.Seasons, sportSeason{
+			PlayerID: rookie.ID,
+			Year:     nextYear,
+			Age:      rookie.Age,
+			// Until they've played, a rookie's season shows what the scouts saw, not their true skill
+			Skill: knownSkill(rookie),
+			Total: offseason.EmptyTotal,
+		})
+	}
+
+	for _, team := range state.Teams {
+		groups := offseason.Groups(nextRosters[team.ID])
+		for _, group := range groups {
+			// The best players start, whether they're veterans or rookies
+			slices.SortStableFunc(group, func(x, y Player) int {
+				return cmp.Compare(knownSkill(y), knownSkill(x))
+			})
+		}
+		result.DepthCharts = append(result.DepthCharts, groupDepthChartEntries(team.ID, groups)...)
+	}
+	return result
+}
+
+// advanceSportLeagues plays the next season of the universe's basketball and baseball leagues,
+// adding what they stored to result. A universe without one of the leagues skips it.
+func (a *SeasonAdvancer) advanceSportLeagues(ctx context.Context, tx pgx.Tx, result *AdvanceResult) error {
+	for _, offseason := range []sportOffseason{basketballOffseason(a.rng), baseballOffseason(a.rng)} {
+		state, err := loadSportLeagueState(ctx, tx, a.universe, offseason.SportType)
+		if err != nil {
+			return fmt.Errorf("failed to load %s league: %w", offseason.Name, err)
+		}
+		if state == nil {
+			a.log("⏭️  Universe %s has no %s league, skipping it", a.universe, offseason.Name)
+			continue
+		}
+
+		a.log("📅 Simulating the %d %s season...", state.Year, offseason.Name)
+		if err := a.SaveSport(ctx, tx, offseason, a.AdvanceSportLeague(offseason, *state), result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SaveSport stores an advanced basketball or baseball season, adding what it stored to result
+func (a *SeasonAdvancer) SaveSport(ctx context.Context, tx pgx.Tx, offseason sportOffseason, season SportSeasonResult, result *AdvanceResult) error {
+	// The season's rookies were stored with an empty first season, which is replaced by the played one
+	_, err := tx.Exec(ctx, "DELETE FROM yearly_stats WHERE player_id IN ("+universePlayers+") AND year = $2 AND sport_type = $3",
+		a.universe, season.Season, offseason.SportType)
+	if err != nil {
+		return fmt.Errorf("failed to clear %s rookie placeholder stats: %w", offseason.Name, err)
+	}
+
+	a.log("📝 Updating %d %s players and inserting %d rookies...", len(season.Players), offseason.Name, len(season.Rookies))
+	if err := updatePlayers(ctx, tx, season.Players); err != nil {
+		return fmt.Errorf("failed to update %s players: %w", offseason.Name, err)
+	}
+	if err := insertPlayers(ctx, tx, season.Rookies); err != nil {
+		return fmt.Errorf("failed to insert %s rookies: %w", offseason.Name, err)
+	}
+
+	_, err = tx.Exec(ctx, "DELETE FROM team_depth_charts WHERE team_id IN (SELECT id FROM pro_teams WHERE sport_type = $2 AND universe_id IN ("+universeIDs+"))",
+		a.universe, offseason.SportType)
+	if err != nil {
+		return fmt.Errorf("failed to clear %s depth charts: %w", offseason.Name, err)
+	}
+	if err := insertDepthCharts(ctx, tx, season.DepthCharts); err != nil {
+		return fmt.Errorf("failed to insert %s depth charts: %w", offseason.Name, err)
+	}
+
+	a.log("📝 Inserting %d %s yearly stats records...", len(season.Seasons), offseason.Name)
+	if err := insertSportYearlyStats(ctx, tx, offseason.SportType, season.Seasons); err != nil {
+		return fmt.Errorf("failed to insert %s yearly stats: %w", offseason.Name, err)
+	}
+	weeklyStatsInserted, err := insertSportWeeklyStats(ctx, tx, offseason.SportType, season.Seasons)
+	if err != nil {
+		return fmt.Errorf("failed to insert %s weekly stats: %w", offseason.Name, err)
+	}
+	injuriesInserted, err := insertSportInjuries(ctx, tx, season.Seasons)
+	if err != nil {
+		return fmt.Errorf("failed to insert %s injuries: %w", offseason.Name, err)
+	}
+
+	retired := 0
+	for _, player := range season.Players {
+		if player.Status == "RETIRED" {
+			retired++
+		}
+	}
+	result.PlayersRetired += retired
+	result.RookiesDrafted += len(season.Rookies)
+	result.YearlyStatsInserted += len(season.Seasons)
+	result.WeeklyStatsInserted += weeklyStatsInserted
+	result.InjuriesInserted += injuriesInserted
+
+	a.log("✅ Played the %d %s season: %d retired, %d rookies drafted", season.Season, offseason.Name, retired, len(season.Rookies))
+	return nil
+}
+
+// loadSportLeagueState reads a universe's basketball or baseball teams and every player still in the league,
+// grouped into rosters by depth chart. It returns nil for a universe without players in the sport.
+func loadSportLeagueState(ctx context.Context, tx pgx.Tx, universe, sportType string) (*SportLeagueState, error) {
+	state := &SportLeagueState{Rosters: make(map[string][]Player)}
+
+	// A player's draft year plus their experience is the season their record describes
+	var year *int
+	err := tx.QueryRow(ctx, `
+		SELECT MAX(p.draft_year + p.years_of_experience)
+		FROM players p
+		JOIN pro_teams pt ON pt.id = p.team_id
+		WHERE p.status <> 'RETIRED' AND pt.sport_type = $2 AND pt.universe_id IN (`+universeIDs+`)
+	`, universe, sportType).Scan(&year)
+	if err != nil {
+		return nil, err
+	}
+	if year == nil {
+		return nil, nil
+	}
+	state.Year = *year
+
+	teamRows, err := tx.Query(ctx, `
+		SELECT id
+		FROM pro_teams
+		WHERE sport_type = $2 AND universe_id IN (`+universeIDs+`)
+		ORDER BY abbreviation
+	`, universe, sportType)
+	if err != nil {
+		return nil, err
+	}
+	defer teamRows.Close()
+	for teamRows.Next() {
+		var team Team
+		if err := teamRows.Scan(&team.ID); err != nil {
+			return nil, err
+		}
+		state.Teams = append(state.Teams, team)
+	}
+	if err := teamRows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, `
+		SELECT p.id, p.first_name, p.last_name, p.position, p.team_id, p.height, p.weight, p.age,
+		       p.years_of_experience, p.draft_year, p.jersey_number, p.status, p.skill
+		FROM players p
+		JOIN pro_teams pt ON pt.id = p.team_id
+		LEFT JOIN team_depth_charts dc ON dc.player_id = p.id
+		WHERE p.status <> 'RETIRED' AND pt.sport_type = $2 AND pt.universe_id IN (`+universeIDs+`)
+		ORDER BY p.team_id, p.position, dc.rank NULLS LAST, p.skill DESC, p.id
+	`, universe, sportType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var p Player
+		err := rows.Scan(&p.ID, &p.FirstName, &p.LastName, &p.Position, &p.TeamID, &p.Height, &p.Weight, &p.Age,
+			&p.YearsOfExperience, &p.DraftYear, &p.Jersey, &p.Status, &p.Skill)
+		if err != nil {
+			return nil, err
+		}
+		state.Rosters[p.TeamID] = append(state.Rosters[p.TeamID], p)
+	}
+	return state, rows.Err()
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// testBasketballOffseason plays real basketball seasons but drafts from a fixed prospect
+func testBasketballOffseason(rng *rand.Rand) sportOffseason {
+	offseason := basketballOffseason(rng)
+	prospects := 0
+	offseason.Prospect = func(position Position, draftYear int) Player {
+		prospects++
+		return Player{
+			ID:           fmt.Sprintf("prospect-%d", prospects),
+			Position:     string(position),
+			Age:          20,
+			DraftYear:    draftYear,
+			Skill:        0.5,
+			ScoutedSkill: 0.95,
+			Status:       "ACTIVE",
+		}
+	}
+	return offseason
+}
+
+func testBasketballLeagueState() SportLeagueState {
+	var roster []Player
+	for _, position := range basketballPositions {
+		for i := range BasketballRosterComposition[string(position)] {
+			roster = append(roster, Player{
+				ID:        fmt.Sprintf("%s-%d", strings.ToLower(string(position)), i+1),
+				Position:  string(position),
+				TeamID:    "team-1",
+				Age:       25,
+				DraftYear: 2021,
+				Skill:     0.9 - 0.2*float64(i),
+				Status:    "ACTIVE",
+			})
+		}
+	}
+	return SportLeagueState{
+		Year:    2025,
+		Teams:   []Team{{ID: "team-1"}},
+		Rosters: map[string][]Player{"team-1": roster},
+	}
+}
+
+func TestSeasonAdvancerAdvanceSportLeague(t *testing.T) {
+	advancer := newTestAdvancer("pg-1")
+	state := testBasketballLeagueState()
+
+	result := advancer.AdvanceSportLeague(testBasketballOffseason(advancer.rng), state)
+
+	played := 0
+	for _, season := range result.Seasons {
+		if season.Year == 2025 {
+			played++
+			if season.GamesPlayed == 0 {
+				t.Errorf("Expected %s to play in the 2025 season", season.PlayerID)
+			}
+		}
+	}
+	if played != len(state.Rosters["team-1"]) {
+		t.Errorf("Expected %d played seasons, got %d", len(state.Rosters["team-1"]), played)
+	}
+
+	for _, player := range result.Players {
+		switch {
+		case player.ID == "pg-1" && player.Status != "RETIRED":
+			t.Errorf("Expected pg-1 to retire, got %s", player.Status)
+		case player.ID != "pg-1" && (player.Age != 26 || player.YearsOfExperience != 1):
+			t.Errorf("Expected %s to be 26 with 1 year of experience, got %d and %d", player.ID, player.Age, player.YearsOfExperience)
+		}
+	}
+
+	if len(result.Rookies) != 1 || result.Rookies[0].Position != "PG" || result.Rookies[0].TeamID != "team-1" {
+		t.Fatalf("Expected team-1 to draft a point guard, got %+v", result.Rookies)
+	}
+	rookie := result.Rookies[0]
+	last := result.Seasons[len(result.Seasons)-1]
+	if last.PlayerID != rookie.ID || last.Year != 2026 || last.Total != (BasketballStats{}) {
+		t.Errorf("Expected an empty 2026 season for the rookie, got %+v", last)
+	}
+
+	ranks := make(map[string]int)
+	for _, entry := range result.DepthCharts {
+		ranks[entry.PlayerID] = entry.Rank
+	}
+	if len(result.DepthCharts) != len(state.Rosters["team-1"]) {
+		t.Errorf("Expected a full %d man depth chart, got %d entries", len(state.Rosters["team-1"]), len(result.DepthCharts))
+	}
+	if ranks[rookie.ID] != 1 {
+		t.Errorf("Expected the rookie's scouted skill to make them the starter, got rank %d", ranks[rookie.ID])
+	}
+	if _, ok := ranks["pg-1"]; ok {
+		t.Error("Expected the retiree to leave the depth chart")
+	}
+}
+
+func TestSeasonAdvancerSaveSport(t *testing.T) {
+	advancer := newTestAdvancer("pg-1")
+	offseason := testBasketballOffseason(advancer.rng)
+	season := advancer.AdvanceSportLeague(offseason, testBasketballLeagueState())
+	mockTx := &MockTx{}
+	result := &AdvanceResult{PlayersRetired: 2}
+
+	if err := advancer.SaveSport(context.Background(), mockTx, offseason, season, result); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.PlayersRetired != 3 || result.RookiesDrafted != 1 {
+		t.Errorf("Expected the retiree and rookie to be added to the result, got %d and %d", result.PlayersRetired, result.RookiesDrafted)
+	}
+	if result.YearlyStatsInserted != len(season.Seasons) {
+		t.Errorf("Expected %d yearly stats, got %d", len(season.Seasons), result.YearlyStatsInserted)
+	}
+
+	for _, call := range mockTx.ExecCalls {
+		if strings.Contains(call.SQL, "yearly_stats (") && call.Args[2] != SportBasketball {
+			t.Errorf("Expected basketball yearly stats, got %v", call.Args[2])
+		}
+		if strings.Contains(call.SQL, "DELETE FROM team_depth_charts") && call.Args[1] != SportBasketball {
+			t.Errorf("Expected only basketball depth charts to be cleared, got %v", call.Args[1])
+		}
+	}
+	expected := 1 + len(season.Players) + len(season.Rookies) + 1 + len(season.DepthCharts) +
+		len(season.Seasons) + result.WeeklyStatsInserted + result.InjuriesInserted
+	if len(mockTx.ExecCalls) != expected {
+		t.Errorf("Expected %d exec calls, got %d", expected, len(mockTx.ExecCalls))
+	}
+}
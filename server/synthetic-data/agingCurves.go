@@ -0,0 +1,87 @@
+package main
+
+// AgingCurve describes how a position's ability develops over a career
+type AgingCurve struct {
+	// PeakStart and PeakEnd bound the ages at which a player is at full ability
+	PeakStart int
+	PeakEnd   int
+	// GrowthRate is the share of peak ability gained each year on the way to the peak
+	GrowthRate float64
+	// DeclineRate is the share of peak ability lost each year after the peak
+	DeclineRate float64
+}
+
+// positionAgingCurves holds the aging curve of each position. Running backs peak early and fall
+// off fast, quarterbacks and kickers develop slowly and last well into their thirties.
+var positionAgingCurves = map[string]AgingCurve{
+	"QB": {PeakStart: 27, PeakEnd: 32, GrowthRate: 0.04, DeclineRate: 0.04},
+	"RB": {PeakStart: 23, PeakEnd: 26, GrowthRate: 0.05, DeclineRate: 0.08},
+	"WR": {PeakStart: 25, PeakEnd: 28, GrowthRate: 0.05, DeclineRate: 0.05},
+	"TE": {PeakStart: 26, PeakEnd: 29, GrowthRate: 0.05, DeclineRate: 0.05},
+	"PK": {PeakStart: 26, PeakEnd: 34, GrowthRate: 0.03, DeclineRate: 0.02},
+	// Defensive backs lose a step soonest; linemen rely on strength and technique a bit longer
+	"DL": {PeakStart: 25, PeakEnd: 29, GrowthRate: 0.05, DeclineRate: 0.06},
+	"LB": {PeakStart: 25, PeakEnd: 28, GrowthRate: 0.05, DeclineRate: 0.06},
+	"DB": {PeakStart: 24, PeakEnd: 27, GrowthRate: 0.05, DeclineRate: 0.07},
+	// Basketball guards keep their game longer than the bigs banging inside
+	"PG": {PeakStart: 26, PeakEnd: 31, GrowthRate: 0.05, DeclineRate: 0.04},
+	"SG": {PeakStart: 25, PeakEnd: 30, GrowthRate: 0.05, DeclineRate: 0.05},
+	"SF": {PeakStart: 25, PeakEnd: 30, GrowthRate: 0.05, DeclineRate: 0.05},
+	"PF": {PeakStart: 25, PeakEnd: 29, GrowthRate: 0.05, DeclineRate: 0.06},
+	"C":  {PeakStart: 25, PeakEnd: 28, GrowthRate: 0.05, DeclineRate: 0.07},
+	// Baseball players develop slowly; catchers wear down behind the plate and hitters who only
+	// have to hit last the longest
+	"SP":      {PeakStart: 27, PeakEnd: 31, GrowthRate: 0.04, DeclineRate: 0.05},
+	"RP":      {PeakStart: 27, PeakEnd: 31, GrowthRate: 0.04, DeclineRate: 0.05},
+	"CATCHER": {PeakStart: 26, PeakEnd: 29, GrowthRate: 0.04, DeclineRate: 0.06},
+	"1B":      {PeakStart: 26, PeakEnd: 31, GrowthRate: 0.04, DeclineRate: 0.04},
+	"2B":      {PeakStart: 26, PeakEnd: 30, GrowthRate: 0.04, DeclineRate: 0.05},
+	"3B":      {PeakStart: 26, PeakEnd: 30, GrowthRate: 0.04, DeclineRate: 0.05},
+	"SS":      {PeakStart: 26, PeakEnd: 30, GrowthRate: 0.04, DeclineRate: 0.05},
+	"LF":      {PeakStart: 26, PeakEnd: 30, GrowthRate: 0.04, DeclineRate: 0.05},
+	"CF":      {PeakStart: 26, PeakEnd: 29, GrowthRate: 0.04, DeclineRate: 0.06},
+	"RF":      {PeakStart: 26, PeakEnd: 30, GrowthRate: 0.04, DeclineRate: 0.05},
+	"DH":      {PeakStart: 27, PeakEnd: 33, GrowthRate: 0.04, DeclineRate: 0.04},
+}
+
+// defaultAgingCurve is used for positions without their own curve
+var defaultAgingCurve = AgingCurve{PeakStart: 25, PeakEnd: 29, GrowthRate: 0.05, DeclineRate: 0.05}
+
+// minAgingFactor keeps very young or very old players from losing all of their ability
+const minAgingFactor = 0.4
+
+// agingCurveFor returns the aging curve of a position
+func agingCurveFor(position string) AgingCurve {
+	if curve, ok := positionAgingCurves[position]; ok {
+		return curve
+	}
+	return defaultAgingCurve
+}
+
+// factor returns a player's ability at an age as a share of their peak ability
+func (c AgingCurve) factor(age int) float64 {
+	switch {
+	case age < c.PeakStart:
+		return max(1-c.GrowthRate*float64(c.PeakStart-age), minAgingFactor)
+	case age > c.PeakEnd:
+		return max(1-c.DeclineRate*float64(age-c.PeakEnd), minAgingFactor)
+	default:
+		return 1
+	}
+}
+
+// skillAtAge moves a skill along the position's aging curve from the age it was measured at to another age
+func skillAtAge(skill float64, position string, currentAge, age int) float64 {
+	curve := agingCurveFor(position)
+	return clampFloat(skill*curve.factor(age)/curve.factor(currentAge), 0, 1)
+}
+
+// playerInSeason returns the player as they were in the given season: their age, years of
+// experience and the skill their aging curve gives them at that age. player describes them in currentYear.
+func playerInSeason(player Player, year, currentYear int) Player {
+	seasonPlayer := player
+	seasonPlayer.Age = player.Age - (currentYear - year)
+	seasonPlayer.YearsOfExperience = max(year-player.DraftYear, 0)
+	seasonPlayer.Skill = skillAtAge(player.Skill, player.Position, player.Age, seasonPlayer.Age)
+	return seasonPlayer
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAgingCurveFactor(t *testing.T) {
+	curve := AgingCurve{PeakStart: 25, PeakEnd: 28, GrowthRate: 0.05, DeclineRate: 0.1}
+
+	tests := []struct {
+		name     string
+		age      int
+		expected float64
+	}{
+		{"two years before peak", 23, 0.9},
+		{"start of peak", 25, 1.0},
+		{"end of peak", 28, 1.0},
+		{"three years past peak", 31, 0.7},
+		{"floored", 40, minAgingFactor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := curve.factor(tt.age); math.Abs(result-tt.expected) > 1e-9 {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestAgingCurvesByPosition(t *testing.T) {
+	// Running backs should fall off well before quarterbacks
+	if rb, qb := agingCurveFor("RB").factor(31), agingCurveFor("QB").factor(31); rb >= qb {
+		t.Errorf("Expected a 31 year old RB (%v) to have declined more than a QB (%v)", rb, qb)
+	}
+	if agingCurveFor("XX") != defaultAgingCurve {
+		t.Error("Expected unknown positions to use the default curve")
+	}
+}
+
+func TestSkillAtAge(t *testing.T) {
+	tests := []struct {
+		name       string
+		skill      float64
+		currentAge int
+		age        int
+		expected   float64
+	}{
+		{"same age", 0.8, 27, 27, 0.8},
+		{"younger WR was less skilled", 0.8, 26, 22, 0.68},
+		{"older WR declines", 0.8, 28, 30, 0.72},
+		{"clamped at 1", 0.95, 36, 30, 1.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := skillAtAge(tt.skill, "WR", tt.currentAge, tt.age)
+			if math.Abs(result-tt.expected) > 1e-9 {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestPlayerInSeason(t *testing.T) {
+	player := Player{ID: "wr-1", Position: "WR", Age: 28, DraftYear: 2019, YearsOfExperience: 6, Skill: 0.8}
+
+	rookieSeason := playerInSeason(player, 2019, 2025)
+	if rookieSeason.Age != 22 {
+		t.Errorf("Expected age 22 in 2019, got %d", rookieSeason.Age)
+	}
+	if rookieSeason.YearsOfExperience != 0 {
+		t.Errorf("Expected 0 years of experience as a rookie, got %d", rookieSeason.YearsOfExperience)
+	}
+	if rookieSeason.Skill >= player.Skill {
+		t.Errorf("Expected a lower skill as a rookie, got %v", rookieSeason.Skill)
+	}
+
+	thisSeason := playerInSeason(player, 2025, 2025)
+	if thisSeason.Age != 28 || thisSeason.YearsOfExperience != 6 || thisSeason.Skill != 0.8 {
+		t.Errorf("Expected the current season to match the player, got %+v", thisSeason)
+	}
+}
@@ -0,0 +1,191 @@
+package main
+
+// careerWalker is the skeleton every sport's career simulator is built on. It walks a position
+// group, ordered starter first, through the seasons of their careers and each season through its
+// games. Every game the injured heal a game, the sport's playing-time rule picks who appears from
+// the healthy players, and each of them rolls for injury and plays. G is the sport's stat line for
+// a game, S its yearly stats and R the season record a career is made of.
+type careerWalker[G, S, R any] struct {
+	clock          Clock
+	gamesPerSeason int
+	injuryRoller   func(int, string) (bool, int)
+	injuryTyper    func(string) string
+
+	// playingTime starts a season's playing-time rule. It's called once a season so a rule can
+	// carry state from game to game, like whose turn it is in a pitching rotation.
+	playingTime func() playingTimeRule
+	// weekOfGame is the fantasy week a player's game falls in. gameIndex is 0-based, weeks are 1-based.
+	weekOfGame func(gameIndex int, player Player) int
+	// playGame generates the line of a player appearing in a game
+	playGame func(player Player, turn appearance) G
+	// seasonStats sums a player's walked season into the sport's yearly stats
+	seasonStats func(season walkedSeason[G]) S
+	// record pairs a season's stats with the player as they were that season
+	record func(player Player, year int, seasonPlayer Player, stats S) R
+}
+
+// appearance is a healthy player picked to play in a game
+type appearance struct {
+	// Index is the player's place in the position group
+	Index int
+	// Rank is the player's place among the healthy players at their position
+	Rank int
+	// Share is the player's share of a starter's workload
+	Share float64
+}
+
+// playingTimeRule picks who appears in a game. healthy holds the indexes of the healthy players,
+// in depth chart order.
+type playingTimeRule func(players []Player, healthy []int) []appearance
+
+// depthChartPlayingTime gives each healthy player the workload of their place on the depth chart,
+// so a backup steps into the starter's role while the starter is injured
+func depthChartPlayingTime(players []Player, healthy []int) []appearance {
+	var appearances []appearance
+	for rank, i := range healthy {
+		if share := snapShare(players[i].Position, rank); share > 0 {
+			appearances = append(appearances, appearance{Index: i, Rank: rank, Share: share})
+		}
+	}
+	return appearances
+}
+
+// playedGame is a player's line for a game they appeared in
+type playedGame[G any] struct {
+	Week  int
+	Stats G
+}
+
+// walkedSeason is a player's season as the walker played it, game by game
+type walkedSeason[G any] struct {
+	Games          []playedGame[G]
+	Injuries       []Injury
+	OutAtSeasonEnd bool
+}
+
+// weekLine is a fantasy week's games summed into one line
+type weekLine[G any] struct {
+	Week  int
+	Games int
+	Stats G
+}
+
+// sumByWeek adds a season's games up into fantasy weeks and a season total
+func sumByWeek[G any](games []playedGame[G], add func(a, b G) G) (weeks []weekLine[G], total G) {
+	for _, game := range games {
+		if len(weeks) == 0 || weeks[len(weeks)-1].Week != game.Week {
+			weeks = append(weeks, weekLine[G]{Week: game.Week})
+		}
+		current := &weeks[len(weeks)-1]
+		current.Games++
+		current.Stats = add(current.Stats, game.Stats)
+		total = add(total, game.Stats)
+	}
+	return weeks, total
+}
+
+// spreadOverWeeks spreads a season's games evenly over its fantasy weeks. gameIndex is 0-based, weeks are 1-based.
+func spreadOverWeeks(gameIndex, gamesPerSeason, weeksPerSeason int) int {
+	return gameIndex*weeksPerSeason/gamesPerSeason + 1
+}
+
+// seasonRecord pairs a season's stats with a snapshot of the player's age and skill that season
+func (w careerWalker[G, S, R]) seasonRecord(player Player, year int, stats S) R {
+	return w.record(player, year, playerInSeason(player, year, w.clock.Now().Year()), stats)
+}
+
+// careers generates the careers of a position group together, so each season's playing time is
+// shared out down the depth chart. The result holds each player's career at the same index as
+// group. A season only includes the players drafted by then.
+func (w careerWalker[G, S, R]) careers(group []Player) [][]R {
+	currentYear := w.clock.Now().Year()
+	careers := make([][]R, len(group))
+
+	firstYear := currentYear
+	for i, player := range group {
+		// Player is a rookie about to start their first year
+		if player.DraftYear == currentYear {
+			careers[i] = []R{w.seasonRecord(player, currentYear, w.seasonStats(walkedSeason[G]{}))}
+		}
+		firstYear = min(firstYear, player.DraftYear)
+	}
+
+	for year := firstYear; year < currentYear; year++ {
+		var active []Player
+		var activeIndexes []int
+		for i, player := range group {
+			if player.DraftYear <= year {
+				active = append(active, player)
+				activeIndexes = append(activeIndexes, i)
+			}
+		}
+
+		for j, stats := range w.season(active, year) {
+			i := activeIndexes[j]
+			careers[i] = append(careers[i], w.seasonRecord(group[i], year, stats))
+		}
+	}
+	return careers
+}
+
+// season plays a position group through a season and sums each player's games into their yearly
+// stats. Each player plays at the age, experience and skill their aging curve gives them that season.
+func (w careerWalker[G, S, R]) season(group []Player, year int) []S {
+	walked := w.walkSeason(group, year)
+	stats := make([]S, len(walked))
+	for i, season := range walked {
+		stats[i] = w.seasonStats(season)
+	}
+	return stats
+}
+
+// walkSeason walks a position group through each game in a season, handling injuries and
+// collecting each player's game lines
+func (w careerWalker[G, S, R]) walkSeason(group []Player, year int) []walkedSeason[G] {
+	currentYear := w.clock.Now().Year()
+	gamesOut := make([]int, len(group))
+	seasonPlayers := make([]Player, len(group))
+	seasons := make([]walkedSeason[G], len(group))
+	for i := range group {
+		seasonPlayers[i] = playerInSeason(group[i], year, currentYear)
+		seasons[i].Games = make([]playedGame[G], 0, w.gamesPerSeason)
+	}
+
+	rule := w.playingTime()
+	for gameIndex := range w.gamesPerSeason {
+		// Injured players heal a game at a time whether or not they'd have played
+		var healthy []int
+		for i := range seasonPlayers {
+			if gamesOut[i] > 0 {
+				gamesOut[i]--
+				continue
+			}
+			healthy = append(healthy, i)
+		}
+
+		for _, turn := range rule(seasonPlayers, healthy) {
+			player := seasonPlayers[turn.Index]
+			week := w.weekOfGame(gameIndex, player)
+
+			// Only players who take the field can get hurt; they finish the game and miss the following ones
+			wasInjured, injuryGamesAffected := w.injuryRoller(player.Age, player.Position)
+			if wasInjured {
+				gamesOut[turn.Index] = injuryGamesAffected
+				severity := injurySeverity(injuryGamesAffected)
+				seasons[turn.Index].Injuries = append(seasons[turn.Index].Injuries, Injury{
+					Week:        week,
+					GamesMissed: injuryGamesAffected,
+					Type:        w.injuryTyper(severity),
+					Severity:    severity,
+				})
+			}
+
+			seasons[turn.Index].Games = append(seasons[turn.Index].Games, playedGame[G]{Week: week, Stats: w.playGame(player, turn)})
+		}
+	}
+
+	for i := range seasons {
+		seasons[i].OutAtSeasonEnd = gamesOut[i] > 0
+	}
+	return seasons
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// newTestCareerWalker walks seasons of 4 games, one a week, in which a player's line is their share
+func newTestCareerWalker(injuryRoller func(int, string) (bool, int)) careerWalker[float64, walkedSeason[float64], walkedSeason[float64]] {
+	return careerWalker[float64, walkedSeason[float64], walkedSeason[float64]]{
+		clock:          MockClock{mockTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		gamesPerSeason: 4,
+		injuryRoller:   injuryRoller,
+		injuryTyper:    func(severity string) string { return "Ankle sprain" },
+		playingTime:    func() playingTimeRule { return depthChartPlayingTime },
+		weekOfGame:     func(gameIndex int, player Player) int { return gameIndex + 1 },
+		playGame:       func(player Player, turn appearance) float64 { return turn.Share },
+		seasonStats:    func(season walkedSeason[float64]) walkedSeason[float64] { return season },
+		record: func(player Player, year int, seasonPlayer Player, stats walkedSeason[float64]) walkedSeason[float64] {
+			return stats
+		},
+	}
+}
+
+func TestDepthChartPlayingTime(t *testing.T) {
+	group := []Player{{Position: "RB"}, {Position: "RB"}, {Position: "RB"}, {Position: "RB"}}
+
+	appearances := depthChartPlayingTime(group, []int{1, 2, 3})
+
+	expected := []appearance{{Index: 1, Rank: 0, Share: 1.0}, {Index: 2, Rank: 1, Share: 0.35}, {Index: 3, Rank: 2, Share: 0.1}}
+	if len(appearances) != len(expected) {
+		t.Fatalf("Expected %d appearances, got %v", len(expected), appearances)
+	}
+	for i := range expected {
+		if appearances[i] != expected[i] {
+			t.Errorf("Expected appearance %d to be %+v, got %+v", i, expected[i], appearances[i])
+		}
+	}
+}
+
+func TestSumByWeek(t *testing.T) {
+	games := []playedGame[int]{{Week: 1, Stats: 3}, {Week: 1, Stats: 4}, {Week: 3, Stats: 5}}
+
+	weeks, total := sumByWeek(games, func(a, b int) int { return a + b })
+
+	if total != 12 {
+		t.Errorf("Expected a season total of 12, got %d", total)
+	}
+	if len(weeks) != 2 || weeks[0] != (weekLine[int]{Week: 1, Games: 2, Stats: 7}) || weeks[1] != (weekLine[int]{Week: 3, Games: 1, Stats: 5}) {
+		t.Errorf("Expected weeks 1 and 3 to hold 2 and 1 games, got %+v", weeks)
+	}
+}
+
+func TestCareerWalkerWalkSeason(t *testing.T) {
+	starter := Player{ID: "qb-1", Position: "QB", DraftYear: 2020, Age: 27}
+	backup := Player{ID: "qb-2", Position: "QB", DraftYear: 2022, Age: 25}
+
+	rolls := 0
+	walker := newTestCareerWalker(func(age int, position string) (bool, int) {
+		rolls++
+		// The starter is hurt in the first game and misses the next two
+		return rolls == 1, 2
+	})
+
+	seasons := walker.walkSeason([]Player{starter, backup}, 2024)
+
+	if len(seasons[0].Games) != 2 || seasons[0].Games[1].Week != 4 {
+		t.Errorf("Expected the starter to play weeks 1 and 4, got %+v", seasons[0].Games)
+	}
+	if len(seasons[0].Injuries) != 1 || seasons[0].Injuries[0].Week != 1 || seasons[0].Injuries[0].Severity != InjuryMinor {
+		t.Errorf("Expected one minor injury in week 1, got %+v", seasons[0].Injuries)
+	}
+	if seasons[0].OutAtSeasonEnd {
+		t.Error("Expected the starter to be healthy by the end of the season")
+	}
+
+	// The backup takes the starter's full workload while they're out
+	backupShares := map[int]float64{}
+	for _, game := range seasons[1].Games {
+		backupShares[game.Week] = game.Stats
+	}
+	if backupShares[1] != 0.05 || backupShares[2] != 1.0 || backupShares[3] != 1.0 || backupShares[4] != 0.05 {
+		t.Errorf("Expected the backup to start weeks 2 and 3 only, got %v", backupShares)
+	}
+}
+
+func TestCareerWalkerCareers(t *testing.T) {
+	veteran := Player{ID: "rb-1", Position: "RB", DraftYear: 2022, Age: 26}
+	rookie := Player{ID: "rb-2", Position: "RB", DraftYear: 2025, Age: 22}
+	walker := newTestCareerWalker(func(age int, position string) (bool, int) { return false, 0 })
+
+	careers := walker.careers([]Player{veteran, rookie})
+
+	if len(careers[0]) != 3 {
+		t.Errorf("Expected the veteran to have played 2022 through 2024, got %d seasons", len(careers[0]))
+	}
+	if len(careers[1]) != 1 || len(careers[1][0].Games) != 0 {
+		t.Errorf("Expected the rookie to have an empty first season, got %+v", careers[1])
+	}
+}
@@ -1,12 +1,10 @@
 package main
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"strconv"
-	"time"
 )
 
 /**
@@ -14,7 +12,8 @@ import (
  * which was publicly available from an API. This module is a set
  * of functions that will process that data and return a set of
  * aggregated stats that can be used to generate synthetic players.
- * I do not own the source data so it is not included in this repository.
+ * I do not own the source data so it is not included in this repository;
+ * see playerSource.go for the other sources players can be drawn from.
  */
 
 type PlayerNameCount struct {
@@ -79,27 +78,24 @@ func NewPositionProfile() *PositionProfile {
 	}
 }
 
-func importRealData() map[string]any {
-	file, err := os.Open(os.Getenv("REAL_DATA_FILE")) // you'll need to provide this file in the format specified
-	if err != nil {
-		log.Fatalf("Failed to open file: %v", err)
-	}
-	defer file.Close()
-
-	decoder := json.NewDecoder(file)
-	var data map[string]interface{}
-	if err := decoder.Decode(&data); err != nil {
-		log.Fatalf("Failed to decode JSON: %v", err)
-	}
-
-	return data
-}
-
-func collectPlayerAttributes(data map[string]any) []PlayerStat {
+// collectPlayerAttributes reads the players of a roster dump's athletes list, skipping free agents
+// and anyone it can't read
+func collectPlayerAttributes(data map[string]any) ([]PlayerStat, error) {
 
 	athletes, ok := data["athletes"].([]any)
 	if !ok {
-		log.Fatalf("Error: 'athletes' field is not a list")
+		return nil, fmt.Errorf("'athletes' field is not a list")
+	}
+
+	// The dump's newest draft class are its rookies, so experience counts from that draft rather
+	// than from today and the same dump always profiles the same way
+	newestDraft := 0
+	for _, p := range athletes {
+		if player, ok := p.(map[string]any); ok {
+			if draftYear, err := readDraftYear(player); err == nil {
+				newestDraft = max(newestDraft, draftYear)
+			}
+		}
 	}
 
 	stats := make([]PlayerStat, 0)
@@ -108,7 +104,7 @@ func collectPlayerAttributes(data map[string]any) []PlayerStat {
 		if !ok {
 			continue
 		}
-		stat, err := normalizePlayerData(player)
+		stat, err := normalizePlayerData(player, newestDraft)
 		if err != nil {
 			// fmt.Printf("Skipping player: %v\n", err)
 			continue
@@ -116,7 +112,7 @@ func collectPlayerAttributes(data map[string]any) []PlayerStat {
 		stats = append(stats, stat)
 	}
 
-	return stats
+	return stats, nil
 }
 
 func aggregateAttributesByPosition(stats []PlayerStat) map[string]*PositionProfile {
@@ -163,11 +159,39 @@ type AggregatedPlayerStats struct {
 	LastNames       NameFrequency               `json:"last_names"`
 }
 
-type StatsAggregator func() AggregatedPlayerStats
+type StatsAggregator func() (AggregatedPlayerStats, error)
+
+// collectAndAggregatePlayerAttributes loads the profile PLAYER_PROFILE names, or aggregates the
+// players of the source REAL_DATA_FILE names. Without REAL_DATA_FILE the bundled sample is
+// aggregated instead so generation can still run, but a file either names has to be usable.
+func collectAndAggregatePlayerAttributes() (AggregatedPlayerStats, error) {
+	if path := os.Getenv("PLAYER_PROFILE"); path != "" {
+		stats, err := loadPlayerProfile(path)
+		if err != nil {
+			return AggregatedPlayerStats{}, fmt.Errorf("PLAYER_PROFILE can't be used: %w", err)
+		}
+		log.Printf("📊 Generating players from the profile %s", path)
+		return stats, nil
+	}
+
+	path := os.Getenv("REAL_DATA_FILE")
+	if path == "" {
+		log.Println("🧪 REAL_DATA_FILE isn't set; generating players from the bundled sample")
+		stats, err := aggregatePlayerSource(SamplePlayerSource{})
+		if err != nil {
+			panic(fmt.Sprintf("the bundled sample players can't be aggregated: %v", err))
+		}
+		return stats, nil
+	}
+	stats, err := aggregatePlayerSource(playerSourceFromEnv())
+	if err != nil {
+		return AggregatedPlayerStats{}, fmt.Errorf("REAL_DATA_FILE %s can't be used: %w", path, err)
+	}
+	return stats, nil
+}
 
-func collectAndAggregatePlayerAttributes() AggregatedPlayerStats {
-	data := importRealData()
-	stats := collectPlayerAttributes(data)
+// aggregatePlayerStats builds the name and position distributions synthetic players are drawn from
+func aggregatePlayerStats(stats []PlayerStat) AggregatedPlayerStats {
 	return AggregatedPlayerStats{
 		PositionProfile: aggregateAttributesByPosition(stats),
 		FirstNames:      aggregateFirstNames(stats),
@@ -175,7 +199,22 @@ func collectAndAggregatePlayerAttributes() AggregatedPlayerStats {
 	}
 }
 
-func normalizePlayerData(data map[string]interface{}) (PlayerStat, error) {
+// readDraftYear reads the year a roster dump's player was drafted
+func readDraftYear(data map[string]interface{}) (int, error) {
+	draftMap, ok := data["draft"].(map[string]interface{})
+	if !ok {
+		return 0, fmt.Errorf("missing or invalid draft map")
+	}
+	if dYearVal, ok := draftMap["year"].(float64); ok {
+		return int(dYearVal), nil
+	} else if dYearVal, ok := draftMap["year"].(int); ok {
+		return dYearVal, nil
+	}
+	return 0, fmt.Errorf("missing or invalid draft year")
+}
+
+// normalizePlayerData reads a roster dump's player, counting their experience up to thisYear
+func normalizePlayerData(data map[string]interface{}, thisYear int) (PlayerStat, error) {
 	// Assert the types
 	emptyStat := PlayerStat{}
 
@@ -204,19 +243,10 @@ func normalizePlayerData(data map[string]interface{}) (PlayerStat, error) {
 	}
 
 	// Draft information (used to get the years of experience)
-	draftMap, ok := data["draft"].(map[string]interface{})
-	if !ok {
-		return emptyStat, fmt.Errorf("missing or invalid draft map")
-	}
-	var draftYear int
-	if dYearVal, ok := draftMap["year"].(float64); ok {
-		draftYear = int(dYearVal)
-	} else if dYearVal, ok := draftMap["year"].(int); ok {
-		draftYear = dYearVal
-	} else {
-		return emptyStat, fmt.Errorf("missing or invalid draft year")
+	draftYear, err := readDraftYear(data)
+	if err != nil {
+		return emptyStat, err
 	}
-	thisYear := time.Now().Year()
 	yearsOfExperience := thisYear - draftYear
 
 	// Safely assert other fields
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"math/rand"
+	"slices"
+
+	"fantasy-draft/fantasy"
+)
+
+// BaseballSimulatorConfig holds all injectable dependencies for simulating baseball seasons
+// Any nil fields will use production defaults when passed to NewBaseballCareerSimulator
+type BaseballSimulatorConfig struct {
+	// Clock for getting current time (default: RealClock)
+	Clock Clock
+
+	// Rand is the source the default rollers and stats generator draw from (default: seeded from the clock)
+	Rand *rand.Rand
+
+	// GamesPerSeason is number of games in a season (default: 162)
+	GamesPerSeason int
+
+	// WeeksPerSeason is number of fantasy weeks the games are grouped into (default: 26)
+	WeeksPerSeason int
+
+	// InjuryRoller determines if a player gets injured in a game (default: rollForBaseballInjury)
+	InjuryRoller func(age int, position string) (injured bool, gamesOut int)
+
+	// InjuryTypeRoller names an injury of the given severity (default: rollInjuryType)
+	InjuryTypeRoller func(severity string) string
+
+	// AppearanceRoller decides whether a player with the given share of the games plays in one (default: rollForAppearance)
+	AppearanceRoller func(share float64) bool
+
+	// StatsGenerator creates a player's line for a game they appear in. healthyRank is their place
+	// among the healthy players at their position, so the first reliever pitches the saves.
+	// (default: generateBaseballGameStats)
+	StatsGenerator func(player Player, healthyRank int) BaseballStats
+}
+
+// BaseballCareerSimulator simulates baseball seasons with injectable dependencies
+type BaseballCareerSimulator struct {
+	clock            Clock
+	rng              *rand.Rand
+	gamesPerSeason   int
+	weeksPerSeason   int
+	injuryRoller     func(int, string) (bool, int)
+	injuryTyper      func(string) string
+	appearanceRoller func(float64) bool
+	statsGenerator   func(Player, int) BaseballStats
+}
+
+// NewBaseballCareerSimulator creates a BaseballCareerSimulator with the given config
+// Any zero/nil values in config will use production defaults
+func NewBaseballCareerSimulator(cfg BaseballSimulatorConfig) *BaseballCareerSimulator {
+	sim := &BaseballCareerSimulator{
+		clock:            cfg.Clock,
+		rng:              cfg.Rand,
+		gamesPerSeason:   cfg.GamesPerSeason,
+		weeksPerSeason:   cfg.WeeksPerSeason,
+		injuryRoller:     cfg.InjuryRoller,
+		injuryTyper:      cfg.InjuryTypeRoller,
+		appearanceRoller: cfg.AppearanceRoller,
+		statsGenerator:   cfg.StatsGenerator,
+	}
+
+	// Apply defaults for any unset dependencies
+	if sim.clock == nil {
+		sim.clock = RealClock{}
+	}
+	if sim.rng == nil {
+		sim.rng = clockSeededRandom()
+	}
+	if sim.gamesPerSeason == 0 {
+		sim.gamesPerSeason = fantasy.BaseballGamesPerSeason
+	}
+	if sim.weeksPerSeason == 0 {
+		sim.weeksPerSeason = 26
+	}
+	if sim.injuryRoller == nil {
+		sim.injuryRoller = func(age int, position string) (bool, int) {
+			return rollForBaseballInjury(sim.rng, age, position)
+		}
+	}
+	if sim.injuryTyper == nil {
+		sim.injuryTyper = func(severity string) string {
+			return rollInjuryType(sim.rng, severity)
+		}
+	}
+	if sim.appearanceRoller == nil {
+		sim.appearanceRoller = func(share float64) bool {
+			return rollForAppearance(sim.rng, share)
+		}
+	}
+	if sim.statsGenerator == nil {
+		sim.statsGenerator = func(player Player, healthyRank int) BaseballStats {
+			return generateBaseballGameStats(sim.rng, player, healthyRank)
+		}
+	}
+
+	return sim
+}
+
+// walker builds the career walker the simulator plays its seasons on. The season's games are
+// spread over its fantasy weeks.
+func (sim *BaseballCareerSimulator) walker() careerWalker[BaseballStats, BaseballYearlyStats, PlayerYearlyStatsBaseball] {
+	return careerWalker[BaseballStats, BaseballYearlyStats, PlayerYearlyStatsBaseball]{
+		clock:          sim.clock,
+		gamesPerSeason: sim.gamesPerSeason,
+		injuryRoller:   sim.injuryRoller,
+		injuryTyper:    sim.injuryTyper,
+		playingTime:    sim.playingTime,
+		weekOfGame: func(gameIndex int, _ Player) int {
+			return spreadOverWeeks(gameIndex, sim.gamesPerSeason, sim.weeksPerSeason)
+		},
+		playGame: func(player Player, turn appearance) BaseballStats {
+			return sim.statsGenerator(player, turn.Rank)
+		},
+		seasonStats: func(season walkedSeason[BaseballStats]) BaseballYearlyStats {
+			weeks, total := sumByWeek(season.Games, addBaseballStats)
+			return BaseballYearlyStats{
+				Total:          total,
+				GamesPlayed:    len(season.Games),
+				Weeks:          weeks,
+				Injuries:       season.Injuries,
+				OutAtSeasonEnd: season.OutAtSeasonEnd,
+			}
+		},
+		record: func(player Player, year int, seasonPlayer Player, stats BaseballYearlyStats) PlayerYearlyStatsBaseball {
+			return PlayerYearlyStatsBaseball{PlayerID: player.ID, Year: year, Age: seasonPlayer.Age, Skill: seasonPlayer.Skill, Stats: stats}
+		},
+	}
+}
+
+// CreateDepthChartCareers generates the careers of a position group together, so each season's
+// games are shared out down the depth chart. group is ordered starter first, and the result holds
+// each player's career at the same index. A season only includes the players who had come up by then.
+func (sim *BaseballCareerSimulator) CreateDepthChartCareers(group []Player) [][]PlayerYearlyStatsBaseball {
+	return sim.walker().careers(group)
+}
+
+// SimulateDepthChartYear walks through each game in a season for a position group ordered by
+// depth chart, starter first. Game lines are summed into fantasy weeks.
+func (sim *BaseballCareerSimulator) SimulateDepthChartYear(group []Player, year int) []BaseballYearlyStats {
+	return sim.walker().season(group, year)
+}
+
+// playingTime starts a season's playing-time rule. Starting pitchers take turns through the
+// rotation, skipping the injured. Everyone else appears in their healthy place's share of the
+// games, so a backup plays every day while the starter is hurt.
+func (sim *BaseballCareerSimulator) playingTime() playingTimeRule {
+	nextStarter := 0
+	return func(players []Player, healthy []int) []appearance {
+		starter := sim.rotationTurn(healthy, nextStarter, len(players))
+		if starter >= 0 {
+			nextStarter = (starter + 1) % len(players)
+		}
+
+		var appearances []appearance
+		for rank, i := range healthy {
+			if players[i].Position == string(SP) {
+				// The day's starter pitches like the ace of the staff
+				if i == starter {
+					appearances = append(appearances, appearance{Index: i, Rank: 0, Share: 1.0})
+				}
+				continue
+			}
+			share := snapShare(players[i].Position, rank)
+			if sim.appearanceRoller(share) {
+				appearances = append(appearances, appearance{Index: i, Rank: rank, Share: share})
+			}
+		}
+		return appearances
+	}
+}
+
+// rotationTurn returns the index of the healthy starting pitcher whose turn it is, starting the
+// search from the pitcher due up, or -1 if the whole rotation is hurt. healthy holds the indexes
+// of the healthy pitchers in order.
+func (sim *BaseballCareerSimulator) rotationTurn(healthy []int, due, rotationSize int) int {
+	for offset := range rotationSize {
+		turn := (due + offset) % rotationSize
+		if slices.Contains(healthy, turn) {
+			return turn
+		}
+	}
+	return -1
+}
+
+// rollForAppearance decides whether a player with the given share of the games plays in one
+func rollForAppearance(rng *rand.Rand, share float64) bool {
+	return rng.Float64() < share
+}
+
+// rollForBaseballInjury rolls for an injury in a game a player appears in
+func rollForBaseballInjury(rng *rand.Rand, playerAge int, playerPosition string) (bool, int) {
+	injuryRate := fantasy.BaseballGameInjuryRate(playerAge, playerPosition)
+
+	if rng.Float64() >= injuryRate {
+		return false, 0
+	}
+	return true, normalIntInRangeWithMeanBias(rng, 1, 60, 0.2)
+}
+
+// hitterProfile shapes a lineup position's production: the share of hits that leave the park and
+// the chance of stealing a base after reaching on a single
+type hitterProfile struct {
+	power float64
+	speed float64
+}
+
+// hitterProfiles give the corner spots the power and the middle of the field the speed
+var hitterProfiles = map[string]hitterProfile{
+	"CATCHER": {power: 0.12, speed: 0.01},
+	"1B":      {power: 0.20, speed: 0.02},
+	"2B":      {power: 0.08, speed: 0.10},
+	"3B":      {power: 0.15, speed: 0.04},
+	"SS":      {power: 0.08, speed: 0.12},
+	"LF":      {power: 0.17, speed: 0.07},
+	"CF":      {power: 0.12, speed: 0.15},
+	"RF":      {power: 0.17, speed: 0.07},
+	"DH":      {power: 0.22, speed: 0.01},
+}
+
+// saveOpportunityRate is the share of a closer's appearances that come with a lead to protect
+const saveOpportunityRate = 0.6
+
+// generateBaseballGameStats creates a player's line for a game they appear in
+func generateBaseballGameStats(rng *rand.Rand, player Player, healthyRank int) BaseballStats {
+	switch Position(player.Position) {
+	case SP:
+		outs := roundToNearestInt(float64(normalIntInRange(rng, 12, 21)) * (0.8 + 0.3*player.Skill))
+		return generatePitchingLine(rng, player, clampInt(outs, 3, 27))
+	case RP:
+		stats := generatePitchingLine(rng, player, normalIntInRange(rng, 2, 4))
+		if healthyRank == 0 && stats.EarnedRuns == 0 && rng.Float64() < saveOpportunityRate {
+			stats.Saves = 1
+		}
+		return stats
+	}
+
+	profile, ok := hitterProfiles[player.Position]
+	if !ok {
+		return BaseballStats{}
+	}
+
+	stats := BaseballStats{AtBats: normalIntInRange(rng, 3, 5)}
+	average := 0.215 + 0.1*player.Skill
+	for range stats.AtBats {
+		if rng.Float64() >= average {
+			continue
+		}
+		stats.Hits++
+		switch {
+		case rng.Float64() < profile.power*(0.6+0.8*player.Skill):
+			// A home run drives in the batter and whoever is on base
+			stats.HomeRuns++
+			stats.RunsBattedIn += 1 + normalIntInRangeWithMeanBias(rng, 0, 3, 0.2)
+		case rng.Float64() < 0.3:
+			stats.RunsBattedIn++
+		default:
+			if rng.Float64() < profile.speed*(0.5+player.Skill) {
+				stats.StolenBases++
+			}
+		}
+	}
+	return stats
+}
+
+// generatePitchingLine creates a pitcher's line for an outing of the given number of outs.
+// Better pitchers allow fewer baserunners and strike out more, and earned runs never pass the
+// baserunners allowed.
+func generatePitchingLine(rng *rand.Rand, player Player, outs int) BaseballStats {
+	innings := float64(outs) / 3
+	perInning := func(rate float64) int {
+		return roundToNearestInt(innings * rate * normalInRange(rng, 0.4, 1.6))
+	}
+
+	hitsAllowed := perInning(1.15 - 0.5*player.Skill)
+	walksAllowed := perInning(0.45 - 0.2*player.Skill)
+	return BaseballStats{
+		OutsPitched:  outs,
+		Strikeouts:   min(perInning(0.6+0.6*player.Skill), outs),
+		EarnedRuns:   min(perInning(0.7-0.45*player.Skill), hitsAllowed+walksAllowed),
+		HitsAllowed:  hitsAllowed,
+		WalksAllowed: walksAllowed,
+	}
+}
+
+func addBaseballStats(a, b BaseballStats) BaseballStats {
+	return BaseballStats{
+		AtBats:       a.AtBats + b.AtBats,
+		Hits:         a.Hits + b.Hits,
+		HomeRuns:     a.HomeRuns + b.HomeRuns,
+		RunsBattedIn: a.RunsBattedIn + b.RunsBattedIn,
+		StolenBases:  a.StolenBases + b.StolenBases,
+		OutsPitched:  a.OutsPitched + b.OutsPitched,
+		Strikeouts:   a.Strikeouts + b.Strikeouts,
+		EarnedRuns:   a.EarnedRuns + b.EarnedRuns,
+		HitsAllowed:  a.HitsAllowed + b.HitsAllowed,
+		WalksAllowed: a.WalksAllowed + b.WalksAllowed,
+		Saves:        a.Saves + b.Saves,
+	}
+}
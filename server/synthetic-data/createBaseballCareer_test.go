@@ -0,0 +1,164 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func newTestBaseballSimulator(injuryRoller func(int, string) (bool, int)) *BaseballCareerSimulator {
+	return NewBaseballCareerSimulator(BaseballSimulatorConfig{
+		Clock:            MockClock{mockTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		GamesPerSeason:   10,
+		WeeksPerSeason:   2,
+		InjuryRoller:     injuryRoller,
+		AppearanceRoller: func(share float64) bool { return share >= 0.5 },
+		StatsGenerator: func(player Player, healthyRank int) BaseballStats {
+			if isPitcher(player.Position) {
+				stats := BaseballStats{OutsPitched: 18, Strikeouts: 6}
+				if player.Position == "RP" && healthyRank == 0 {
+					stats = BaseballStats{OutsPitched: 3, Saves: 1}
+				}
+				return stats
+			}
+			return BaseballStats{AtBats: 4, Hits: 1}
+		},
+	})
+}
+
+func TestNewBaseballCareerSimulator(t *testing.T) {
+	sim := NewBaseballCareerSimulator(BaseballSimulatorConfig{})
+
+	if sim.gamesPerSeason != 162 || sim.weeksPerSeason != 26 {
+		t.Errorf("Expected a 162 game season over 26 weeks, got %d games over %d weeks", sim.gamesPerSeason, sim.weeksPerSeason)
+	}
+	if sim.walker().weekOfGame(161, Player{}) != 26 {
+		t.Errorf("Expected the last game in week 26, got %d", sim.walker().weekOfGame(161, Player{}))
+	}
+	if sim.clock == nil || sim.injuryRoller == nil || sim.injuryTyper == nil || sim.appearanceRoller == nil || sim.statsGenerator == nil {
+		t.Error("Expected every dependency to have a default")
+	}
+}
+
+func TestBaseballCareerSimulatorSimulateDepthChartYear(t *testing.T) {
+	healthy := func(age int, position string) (bool, int) { return false, 0 }
+	rotation := []Player{
+		{ID: "sp-1", Position: "SP", DraftYear: 2020, Age: 28},
+		{ID: "sp-2", Position: "SP", DraftYear: 2020, Age: 28},
+		{ID: "sp-3", Position: "SP", DraftYear: 2020, Age: 28},
+		{ID: "sp-4", Position: "SP", DraftYear: 2020, Age: 28},
+		{ID: "sp-5", Position: "SP", DraftYear: 2020, Age: 28},
+	}
+
+	t.Run("starters take turns through the rotation", func(t *testing.T) {
+		results := newTestBaseballSimulator(healthy).SimulateDepthChartYear(rotation, 2024)
+
+		for i, result := range results {
+			if result.GamesPlayed != 2 {
+				t.Errorf("Expected %s to start 2 of 10 games, got %d", rotation[i].ID, result.GamesPlayed)
+			}
+		}
+	})
+
+	t.Run("the rotation skips an injured starter", func(t *testing.T) {
+		rolls := 0
+		sim := newTestBaseballSimulator(func(age int, position string) (bool, int) {
+			rolls++
+			// The ace is hurt in their first start and misses the rest of the season
+			return rolls == 1, 20
+		})
+
+		results := sim.SimulateDepthChartYear(rotation, 2024)
+
+		if results[0].GamesPlayed != 1 || !results[0].OutAtSeasonEnd {
+			t.Errorf("Expected the ace to make 1 start and end the season hurt, got %d starts", results[0].GamesPlayed)
+		}
+		starts := 0
+		for _, result := range results {
+			starts += result.GamesPlayed
+		}
+		if starts != 10 {
+			t.Errorf("Expected every game to have a starter, got %d starts", starts)
+		}
+	})
+
+	t.Run("the closer pitches the saves", func(t *testing.T) {
+		bullpen := []Player{
+			{ID: "rp-1", Position: "RP", DraftYear: 2020, Age: 28},
+			{ID: "rp-2", Position: "RP", DraftYear: 2020, Age: 28},
+			{ID: "rp-3", Position: "RP", DraftYear: 2020, Age: 28},
+		}
+		sim := newTestBaseballSimulator(healthy)
+		sim.appearanceRoller = func(share float64) bool { return share > 0 }
+
+		results := sim.SimulateDepthChartYear(bullpen, 2024)
+
+		if results[0].Total.Saves != 10 || results[1].Total.Saves != 0 {
+			t.Errorf("Expected the closer to record every save, got %d and %d", results[0].Total.Saves, results[1].Total.Saves)
+		}
+	})
+
+	t.Run("bench players spell the starter", func(t *testing.T) {
+		infield := []Player{
+			{ID: "ss-1", Position: "SS", DraftYear: 2020, Age: 28},
+			{ID: "ss-2", Position: "SS", DraftYear: 2020, Age: 28},
+		}
+
+		results := newTestBaseballSimulator(healthy).SimulateDepthChartYear(infield, 2024)
+
+		if results[0].GamesPlayed != 10 || results[0].Total.AtBats != 40 {
+			t.Errorf("Expected the starter to play every game, got %d games and %d at bats", results[0].GamesPlayed, results[0].Total.AtBats)
+		}
+		if results[1].GamesPlayed != 0 {
+			t.Errorf("Expected the backup to sit, got %d games", results[1].GamesPlayed)
+		}
+		if len(results[0].Weeks) != 2 || results[0].Weeks[0].Games != 5 {
+			t.Errorf("Expected 5 games in each of 2 weeks, got %+v", results[0].Weeks)
+		}
+	})
+}
+
+func TestGenerateBaseballGameStats(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for range 200 {
+		hitter := generateBaseballGameStats(rng, Player{Position: "1B", Skill: 0.9}, 0)
+		if hitter.Hits > hitter.AtBats || hitter.HomeRuns > hitter.Hits {
+			t.Fatalf("Expected home runs <= hits <= at bats, got %+v", hitter)
+		}
+		if hitter.RunsBattedIn < hitter.HomeRuns || hitter.OutsPitched != 0 {
+			t.Fatalf("Expected a hitter's home runs to drive themselves in and no pitching, got %+v", hitter)
+		}
+
+		starter := generateBaseballGameStats(rng, Player{Position: "SP", Skill: 0.5}, 0)
+		if starter.OutsPitched < 3 || starter.OutsPitched > 27 || starter.AtBats != 0 {
+			t.Fatalf("Expected a starter to pitch 1 to 9 innings and not bat, got %+v", starter)
+		}
+		if starter.EarnedRuns > starter.HitsAllowed+starter.WalksAllowed || starter.Strikeouts > starter.OutsPitched {
+			t.Fatalf("Expected earned runs within baserunners and strikeouts within outs, got %+v", starter)
+		}
+
+		setup := generateBaseballGameStats(rng, Player{Position: "RP", Skill: 0.5}, 1)
+		if setup.Saves != 0 {
+			t.Fatalf("Expected only the closer to get saves, got %+v", setup)
+		}
+	}
+}
+
+func TestRollForBaseballInjury(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	hitters, starters := 0, 0
+	for range 20000 {
+		if injured, gamesOut := rollForBaseballInjury(rng, 27, "2B"); injured {
+			hitters++
+			if gamesOut < 1 || gamesOut > 60 {
+				t.Fatalf("Expected 1 to 60 games out, got %d", gamesOut)
+			}
+		}
+		if injured, _ := rollForBaseballInjury(rng, 27, "SP"); injured {
+			starters++
+		}
+	}
+	if starters <= hitters {
+		t.Errorf("Expected starting pitchers to get hurt more often per game, got %d starters and %d hitters", starters, hitters)
+	}
+}
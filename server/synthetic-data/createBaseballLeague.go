@@ -0,0 +1,197 @@
+package main
+
+import (
+	"math/rand"
+)
+
+// Baseball positions. Catchers are stored as CATCHER since C is the basketball center.
+const (
+	SP         Position = "SP"
+	RP         Position = "RP"
+	CATCHER    Position = "CATCHER"
+	FirstBase  Position = "1B"
+	SecondBase Position = "2B"
+	ThirdBase  Position = "3B"
+	SS         Position = "SS"
+	LF         Position = "LF"
+	CF         Position = "CF"
+	RF         Position = "RF"
+	DH         Position = "DH"
+)
+
+// baseballPositions lists the positions a baseball roster carries, pitchers first
+var baseballPositions = []Position{SP, RP, CATCHER, FirstBase, SecondBase, ThirdBase, SS, LF, CF, RF, DH}
+
+// isPitcher reports whether a baseball position pitches rather than hits
+func isPitcher(position string) bool {
+	return position == string(SP) || position == string(RP)
+}
+
+// BaseballRosterComposition is the standard 26 man baseball roster: a five man rotation, an
+// eight man bullpen and a starter at every spot in the lineup with backups up the middle
+var BaseballRosterComposition = RosterComposition{
+	"SP":      5,
+	"RP":      8,
+	"CATCHER": 2,
+	"1B":      2,
+	"2B":      1,
+	"3B":      1,
+	"SS":      2,
+	"LF":      1,
+	"CF":      2,
+	"RF":      1,
+	"DH":      1,
+}
+
+// A curated list of 30 synthetic baseball teams
+var allAvailableBaseballFranchises = []Franchise{
+	{"Albuquerque", "NM", "Roadrunners", "ABQ"},
+	{"Birmingham", "AL", "Ironmen", "BHM"},
+	{"Buffalo", "NY", "Snowbirds", "BUF"},
+	{"Charlotte", "NC", "Hornets", "CLT"},
+	{"Cincinnati", "OH", "Rivermen", "CIN"},
+	{"Cleveland", "OH", "Lakers", "CLE"},
+	{"Dallas", "TX", "Drillers", "DAL"},
+	{"Des Moines", "IA", "Cornhuskers", "DSM"},
+	{"El Paso", "TX", "Sun Dogs", "ELP"},
+	{"Fresno", "CA", "Raisins", "FRE"},
+	{"Hartford", "CT", "Whalers", "HFD"},
+	{"Honolulu", "HI", "Islanders", "HNL"},
+	{"Houston", "TX", "Launch", "HOU"},
+	{"Indianapolis", "IN", "Speedsters", "IND"},
+	{"Jacksonville", "FL", "Shrimp", "JAX"},
+	{"Kansas City", "MO", "Monarchs", "KC"},
+	{"Little Rock", "AR", "Travelers", "LR"},
+	{"Montreal", "QC", "Voyageurs", "MTL"},
+	{"Newark", "NJ", "Eagles", "NWK"},
+	{"Oakland", "CA", "Oaks", "OAK"},
+	{"Phoenix", "AZ", "Firebirds", "PHX"},
+	{"Pittsburgh", "PA", "Steelheads", "PIT"},
+	{"Providence", "RI", "Grays", "PRO"},
+	{"Richmond", "VA", "Squirrels", "RIC"},
+	{"Rochester", "NY", "Red Wings", "ROC"},
+	{"St. Louis", "MO", "Archers", "STL"},
+	{"San Jose", "CA", "Giants", "SJ"},
+	{"Spokane", "WA", "Falls", "SPK"},
+	{"Tampa", "FL", "Cigars", "TPA"},
+	{"Toledo", "OH", "Mud Hens", "TOL"},
+}
+
+// baseballBodies holds the height (inches) and weight (lbs) ranges of each baseball position
+var baseballBodies = map[Position]struct {
+	minHeight, maxHeight int
+	minWeight, maxWeight int
+}{
+	SP:         {73, 79, 190, 240},
+	RP:         {72, 78, 185, 245},
+	CATCHER:    {70, 75, 200, 235},
+	FirstBase:  {73, 77, 210, 250},
+	SecondBase: {68, 73, 170, 200},
+	ThirdBase:  {71, 75, 195, 225},
+	SS:         {69, 74, 170, 205},
+	LF:         {71, 76, 190, 225},
+	CF:         {70, 75, 180, 210},
+	RF:         {71, 76, 195, 230},
+	DH:         {72, 77, 215, 255},
+}
+
+// generateBaseballLeagueFlat creates a 30 team baseball league: two leagues of three five-team divisions
+func generateBaseballLeagueFlat(uuidGenerator UUIDGenerator, rng *rand.Rand) LeagueFlat {
+	league := LeagueFlat{}
+
+	for _, confName := range []string{"Continental League", "Frontier League"} {
+		league.Conferences = append(league.Conferences, generateConference(confName, uuidGenerator))
+	}
+
+	divisionNames := []string{"East", "Central", "West"}
+	for _, conference := range league.Conferences {
+		for _, divName := range divisionNames {
+			league.Divisions = append(league.Divisions, generateDivision(divName, conference.ID, uuidGenerator))
+		}
+	}
+
+	// Deal the franchises out to the divisions in a random order
+	divisionSize := len(allAvailableBaseballFranchises) / len(league.Divisions)
+	for i, franchiseIndex := range rng.Perm(len(allAvailableBaseballFranchises)) {
+		division := league.Divisions[i/divisionSize]
+		team := generateTeam(allAvailableBaseballFranchises[franchiseIndex], division.ID, uuidGenerator)
+		team.SportType = SportBaseball
+		league.Teams = append(league.Teams, team)
+	}
+
+	return league
+}
+
+// createBaseballPlayer generates a baseball player. Players come up after a few years in the
+// minors, so they reach the league later than in the other sports.
+func createBaseballPlayer(position Position, teamID string, generators PlayerGenerators, clock Clock, uuidGenerator UUIDGenerator) Player {
+	rng := generators.Rand
+	body := baseballBodies[position]
+	age := normalIntInRangeWithMeanBias(rng, 21, 38, 0.4)
+	yoe := clampInt(age-normalIntInRange(rng, 21, 24), 0, age-21)
+
+	return Player{
+		ID:                uuidGenerator(),
+		DraftYear:         clock.Now().Year() - yoe,
+		FirstName:         generators.FirstNameGenerator(),
+		LastName:          generators.LastNameGenerator(),
+		Position:          string(position),
+		Jersey:            normalIntInRange(rng, 1, 99),
+		Height:            normalIntInRange(rng, body.minHeight, body.maxHeight),
+		Weight:            normalIntInRange(rng, body.minWeight, body.maxWeight),
+		Age:               age,
+		YearsOfExperience: yoe,
+		Status:            "ACTIVE",
+		Skill:             generators.SkillGenerator(),
+		TeamID:            teamID,
+	}
+}
+
+// createBaseballProspect generates a minor league prospect entering the baseball draft in draftYear, with a
+// hidden true skill and the noisier skill scouts see
+func createBaseballProspect(position Position, draftYear int, rng *rand.Rand) Player {
+	generators := getPlayerGenerators(collectAndAggregatePlayerAttributes, rng)
+	prospect := createBaseballPlayer(position, "", generators, yearClock(draftYear), uuidsFrom(rng))
+	prospect.Age = normalIntInRange(rng, 21, 24)
+	prospect.YearsOfExperience = 0
+	prospect.DraftYear = draftYear
+	prospect.Skill = clampFloat(generators.SkillGenerator(), 0.15, 0.95)
+	prospect.ScoutedSkill = scoutSkill(prospect.Skill, rng)
+	return prospect
+}
+
+// createBaseballRoster fills a team to BaseballRosterComposition, each position ordered from
+// starter down with skill falling off down the depth chart. The rotation and bullpen are ordered
+// the same way, so the closer is the first reliever.
+func createBaseballRoster(teamID string, rng *rand.Rand, clock Clock) []Player {
+	generators := getPlayerGenerators(collectAndAggregatePlayerAttributes, rng)
+	uuidGenerator := uuidsFrom(rng)
+
+	var roster []Player
+	for _, position := range baseballPositions {
+		count := BaseballRosterComposition[string(position)]
+		for depthIndex := range count {
+			player := createBaseballPlayer(position, teamID, generators, clock, uuidGenerator)
+			player.Skill = createSkillForDepthPosition(rng, depthIndex, count)
+			roster = append(roster, player)
+		}
+	}
+	return roster
+}
+
+// baseballDepthCharts splits a baseball roster into its position groups, keeping each group's order
+func baseballDepthCharts(roster []Player) [][]Player {
+	var groups [][]Player
+	for _, position := range baseballPositions {
+		var group []Player
+		for _, player := range roster {
+			if player.Position == string(position) {
+				group = append(group, player)
+			}
+		}
+		if len(group) > 0 {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestGenerateBaseballLeagueFlat(t *testing.T) {
+	counter := 0
+	league := generateBaseballLeagueFlat(mockUUIDGenerator("bsb", &counter), rand.New(rand.NewSource(1)))
+
+	if len(league.Conferences) != 2 || len(league.Divisions) != 6 {
+		t.Errorf("Expected 2 leagues of 3 divisions, got %d and %d", len(league.Conferences), len(league.Divisions))
+	}
+	if len(league.Teams) != len(allAvailableBaseballFranchises) {
+		t.Fatalf("Expected %d teams, got %d", len(allAvailableBaseballFranchises), len(league.Teams))
+	}
+
+	teamsPerDivision := make(map[string]int)
+	abbreviations := make(map[string]bool)
+	for _, team := range league.Teams {
+		teamsPerDivision[team.DivisionID]++
+		if team.SportType != SportBaseball {
+			t.Errorf("Expected %s to be a baseball team, got %q", team.Abbr, team.SportType)
+		}
+		if abbreviations[team.Abbr] {
+			t.Errorf("Expected unique abbreviations, %s appears twice", team.Abbr)
+		}
+		abbreviations[team.Abbr] = true
+	}
+	for _, division := range league.Divisions {
+		if teamsPerDivision[division.ID] != 5 {
+			t.Errorf("Expected 5 teams in %s, got %d", division.Name, teamsPerDivision[division.ID])
+		}
+	}
+}
+
+func TestBaseballRosterComposition(t *testing.T) {
+	total := 0
+	for _, position := range baseballPositions {
+		count, ok := BaseballRosterComposition[string(position)]
+		if !ok {
+			t.Errorf("Expected a roster spot for %s", position)
+		}
+		total += count
+	}
+	if total != 26 {
+		t.Errorf("Expected a 26 man roster, got %d", total)
+	}
+}
+
+func TestCreateBaseballPlayer(t *testing.T) {
+	counter := 0
+	generators := PlayerGenerators{
+		FirstNameGenerator: func() string { return "Test" },
+		LastNameGenerator:  func() string { return "Player" },
+		SkillGenerator:     func() float64 { return 0.5 },
+		Rand:               rand.New(rand.NewSource(1)),
+	}
+
+	for _, position := range baseballPositions {
+		player := createBaseballPlayer(position, "team-1", generators, yearClock(2025), mockUUIDGenerator("p", &counter))
+		body := baseballBodies[position]
+
+		if player.Weight < body.minWeight || player.Weight > body.maxWeight {
+			t.Errorf("Expected a %s between %d and %d lbs, got %d", position, body.minWeight, body.maxWeight, player.Weight)
+		}
+		if player.Age < 21 || player.YearsOfExperience > player.Age-21 {
+			t.Errorf("Expected a %s to come up at 21 or older, got age %d with %d years", position, player.Age, player.YearsOfExperience)
+		}
+		if player.DraftYear+player.YearsOfExperience != 2025 {
+			t.Errorf("Expected draft year plus experience to be 2025, got %d + %d", player.DraftYear, player.YearsOfExperience)
+		}
+	}
+}
+
+func TestIsPitcher(t *testing.T) {
+	if !isPitcher("SP") || !isPitcher("RP") {
+		t.Error("Expected starters and relievers to be pitchers")
+	}
+	if isPitcher("CATCHER") || isPitcher("DH") {
+		t.Error("Expected catchers and designated hitters not to be pitchers")
+	}
+}
@@ -0,0 +1,232 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+
+	"fantasy-draft/fantasy"
+)
+
+// BasketballSimulatorConfig holds all injectable dependencies for simulating basketball seasons
+// Any nil fields will use production defaults when passed to NewBasketballCareerSimulator
+type BasketballSimulatorConfig struct {
+	// Clock for getting current time (default: RealClock)
+	Clock Clock
+
+	// Rand is the source the default rollers and stats generator draw from (default: seeded from the clock)
+	Rand *rand.Rand
+
+	// GamesPerSeason is number of games in a season (default: 82)
+	GamesPerSeason int
+
+	// WeeksPerSeason is number of fantasy weeks the games are grouped into (default: 24)
+	WeeksPerSeason int
+
+	// InjuryRoller determines if a player gets injured in a game (default: rollForBasketballInjury)
+	InjuryRoller func(age int, position string) (injured bool, gamesOut int)
+
+	// InjuryTypeRoller names an injury of the given severity (default: rollInjuryType)
+	InjuryTypeRoller func(severity string) string
+
+	// StatsGenerator creates a full-minutes stat line for a single game (default: generateBasketballGameStats)
+	StatsGenerator func(player Player) BasketballStats
+}
+
+// BasketballCareerSimulator simulates basketball seasons with injectable dependencies
+type BasketballCareerSimulator struct {
+	clock          Clock
+	rng            *rand.Rand
+	gamesPerSeason int
+	weeksPerSeason int
+	injuryRoller   func(int, string) (bool, int)
+	injuryTyper    func(string) string
+	statsGenerator func(Player) BasketballStats
+}
+
+// NewBasketballCareerSimulator creates a BasketballCareerSimulator with the given config
+// Any zero/nil values in config will use production defaults
+func NewBasketballCareerSimulator(cfg BasketballSimulatorConfig) *BasketballCareerSimulator {
+	sim := &BasketballCareerSimulator{
+		clock:          cfg.Clock,
+		rng:            cfg.Rand,
+		gamesPerSeason: cfg.GamesPerSeason,
+		weeksPerSeason: cfg.WeeksPerSeason,
+		injuryRoller:   cfg.InjuryRoller,
+		injuryTyper:    cfg.InjuryTypeRoller,
+		statsGenerator: cfg.StatsGenerator,
+	}
+
+	// Apply defaults for any unset dependencies
+	if sim.clock == nil {
+		sim.clock = RealClock{}
+	}
+	if sim.rng == nil {
+		sim.rng = clockSeededRandom()
+	}
+	if sim.gamesPerSeason == 0 {
+		sim.gamesPerSeason = fantasy.BasketballGamesPerSeason
+	}
+	if sim.weeksPerSeason == 0 {
+		sim.weeksPerSeason = 24
+	}
+	if sim.injuryRoller == nil {
+		sim.injuryRoller = func(age int, position string) (bool, int) {
+			return rollForBasketballInjury(sim.rng, age, position)
+		}
+	}
+	if sim.injuryTyper == nil {
+		sim.injuryTyper = func(severity string) string {
+			return rollInjuryType(sim.rng, severity)
+		}
+	}
+	if sim.statsGenerator == nil {
+		sim.statsGenerator = func(player Player) BasketballStats {
+			return generateBasketballGameStats(sim.rng, player)
+		}
+	}
+
+	return sim
+}
+
+// walker builds the career walker the simulator plays its seasons on. A player's full-minutes
+// game is shrunk to their skill and share of the minutes, and the season's games are spread over
+// its fantasy weeks.
+func (sim *BasketballCareerSimulator) walker() careerWalker[BasketballStats, BasketballYearlyStats, PlayerYearlyStatsBasketball] {
+	return careerWalker[BasketballStats, BasketballYearlyStats, PlayerYearlyStatsBasketball]{
+		clock:          sim.clock,
+		gamesPerSeason: sim.gamesPerSeason,
+		injuryRoller:   sim.injuryRoller,
+		injuryTyper:    sim.injuryTyper,
+		playingTime:    func() playingTimeRule { return depthChartPlayingTime },
+		weekOfGame: func(gameIndex int, _ Player) int {
+			return spreadOverWeeks(gameIndex, sim.gamesPerSeason, sim.weeksPerSeason)
+		},
+		playGame: func(player Player, turn appearance) BasketballStats {
+			return scaleBasketballStats(sim.statsGenerator(player), player.Skill*turn.Share)
+		},
+		seasonStats: func(season walkedSeason[BasketballStats]) BasketballYearlyStats {
+			weeks, total := sumByWeek(season.Games, addBasketballStats)
+			return BasketballYearlyStats{
+				Total:          total,
+				GamesPlayed:    len(season.Games),
+				Weeks:          weeks,
+				Injuries:       season.Injuries,
+				OutAtSeasonEnd: season.OutAtSeasonEnd,
+			}
+		},
+		record: func(player Player, year int, seasonPlayer Player, stats BasketballYearlyStats) PlayerYearlyStatsBasketball {
+			return PlayerYearlyStatsBasketball{PlayerID: player.ID, Year: year, Age: seasonPlayer.Age, Skill: seasonPlayer.Skill, Stats: stats}
+		},
+	}
+}
+
+// CreateRotationCareers generates the careers of a position group together, so each season's
+// minutes are shared out down the rotation. group is ordered starter first, and the result holds
+// each player's career at the same index. A season only includes the players drafted by then.
+func (sim *BasketballCareerSimulator) CreateRotationCareers(group []Player) [][]PlayerYearlyStatsBasketball {
+	return sim.walker().careers(group)
+}
+
+// SimulateRotationYear walks through each game in a season for a position group ordered by
+// rotation, starter first. Healthy players take the minutes of their place in the rotation, so a
+// reserve moves up while a starter is injured. Game lines are summed into fantasy weeks.
+func (sim *BasketballCareerSimulator) SimulateRotationYear(group []Player, year int) []BasketballYearlyStats {
+	return sim.walker().season(group, year)
+}
+
+// rollForBasketballInjury rolls for an injury in a single game
+func rollForBasketballInjury(rng *rand.Rand, playerAge int, playerPosition string) (bool, int) {
+	injuryRate := fantasy.BasketballGameInjuryRate(playerAge, playerPosition)
+
+	if rng.Float64() >= injuryRate {
+		return false, 0
+	}
+	return true, normalIntInRangeWithMeanBias(rng, 1, 30, 0.2)
+}
+
+// basketballGameProfile holds the ranges of a full-minutes game for a position
+type basketballGameProfile struct {
+	twos, threes, freeThrows [2]int
+	rebounds, assists        [2]int
+	steals, blocks           [2]int
+	turnovers                [2]int
+}
+
+// basketballGameProfiles shape each position's box score: guards shoot threes and pass, bigs rebound and block
+var basketballGameProfiles = map[string]basketballGameProfile{
+	"PG": {twos: [2]int{3, 9}, threes: [2]int{1, 5}, freeThrows: [2]int{2, 8}, rebounds: [2]int{2, 7}, assists: [2]int{5, 12}, steals: [2]int{0, 3}, blocks: [2]int{0, 1}, turnovers: [2]int{1, 5}},
+	"SG": {twos: [2]int{4, 9}, threes: [2]int{1, 6}, freeThrows: [2]int{2, 8}, rebounds: [2]int{3, 7}, assists: [2]int{2, 7}, steals: [2]int{0, 3}, blocks: [2]int{0, 1}, turnovers: [2]int{1, 4}},
+	"SF": {twos: [2]int{4, 9}, threes: [2]int{1, 4}, freeThrows: [2]int{2, 7}, rebounds: [2]int{4, 9}, assists: [2]int{2, 6}, steals: [2]int{0, 2}, blocks: [2]int{0, 2}, turnovers: [2]int{1, 4}},
+	"PF": {twos: [2]int{5, 10}, threes: [2]int{0, 3}, freeThrows: [2]int{2, 7}, rebounds: [2]int{6, 12}, assists: [2]int{1, 5}, steals: [2]int{0, 2}, blocks: [2]int{0, 3}, turnovers: [2]int{1, 3}},
+	"C":  {twos: [2]int{5, 11}, threes: [2]int{0, 1}, freeThrows: [2]int{2, 7}, rebounds: [2]int{8, 15}, assists: [2]int{1, 4}, steals: [2]int{0, 2}, blocks: [2]int{1, 4}, turnovers: [2]int{1, 4}},
+}
+
+// generateBasketballGameStats creates a full-minutes stat line for a player's position.
+// Points are built from the made shots, so the line always adds up.
+func generateBasketballGameStats(rng *rand.Rand, player Player) BasketballStats {
+	profile, ok := basketballGameProfiles[player.Position]
+	if !ok {
+		return BasketballStats{}
+	}
+	between := func(r [2]int) int { return normalIntInRange(rng, r[0], r[1]) }
+
+	twos := between(profile.twos)
+	threes := between(profile.threes)
+	freeThrowsMade := between(profile.freeThrows)
+	fieldGoalsMade := twos + threes
+
+	return BasketballStats{
+		Points:              2*twos + 3*threes + freeThrowsMade,
+		Rebounds:            between(profile.rebounds),
+		Assists:             between(profile.assists),
+		Steals:              between(profile.steals),
+		Blocks:              between(profile.blocks),
+		ThreePointersMade:   threes,
+		Turnovers:           between(profile.turnovers),
+		FieldGoalsMade:      fieldGoalsMade,
+		FieldGoalsAttempted: roundToNearestInt(float64(fieldGoalsMade) / normalInRange(rng, 0.40, 0.58)),
+		FreeThrowsMade:      freeThrowsMade,
+		FreeThrowsAttempted: freeThrowsMade + normalIntInRange(rng, 0, 3),
+	}
+}
+
+// scaleBasketballStats shrinks a full-minutes game to a player's skill and share of the minutes.
+// Shots are scaled first and points rebuilt from them, so makes never pass attempts and points still add up.
+func scaleBasketballStats(stats BasketballStats, factor float64) BasketballStats {
+	scale := func(value int) int {
+		return int(math.Round(float64(value) * factor))
+	}
+	threes := scale(stats.ThreePointersMade)
+	fieldGoalsMade := scale(stats.FieldGoalsMade)
+	freeThrowsMade := scale(stats.FreeThrowsMade)
+
+	return BasketballStats{
+		Points:              2*(fieldGoalsMade-threes) + 3*threes + freeThrowsMade,
+		Rebounds:            scale(stats.Rebounds),
+		Assists:             scale(stats.Assists),
+		Steals:              scale(stats.Steals),
+		Blocks:              scale(stats.Blocks),
+		ThreePointersMade:   threes,
+		Turnovers:           scale(stats.Turnovers),
+		FieldGoalsMade:      fieldGoalsMade,
+		FieldGoalsAttempted: scale(stats.FieldGoalsAttempted),
+		FreeThrowsMade:      freeThrowsMade,
+		FreeThrowsAttempted: scale(stats.FreeThrowsAttempted),
+	}
+}
+
+func addBasketballStats(a, b BasketballStats) BasketballStats {
+	return BasketballStats{
+		Points:              a.Points + b.Points,
+		Rebounds:            a.Rebounds + b.Rebounds,
+		Assists:             a.Assists + b.Assists,
+		Steals:              a.Steals + b.Steals,
+		Blocks:              a.Blocks + b.Blocks,
+		ThreePointersMade:   a.ThreePointersMade + b.ThreePointersMade,
+		Turnovers:           a.Turnovers + b.Turnovers,
+		FieldGoalsMade:      a.FieldGoalsMade + b.FieldGoalsMade,
+		FieldGoalsAttempted: a.FieldGoalsAttempted + b.FieldGoalsAttempted,
+		FreeThrowsMade:      a.FreeThrowsMade + b.FreeThrowsMade,
+		FreeThrowsAttempted: a.FreeThrowsAttempted + b.FreeThrowsAttempted,
+	}
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func newTestBasketballSimulator(injuryRoller func(int, string) (bool, int)) *BasketballCareerSimulator {
+	return NewBasketballCareerSimulator(BasketballSimulatorConfig{
+		Clock:          MockClock{mockTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		GamesPerSeason: 8,
+		WeeksPerSeason: 4,
+		InjuryRoller:   injuryRoller,
+		StatsGenerator: func(player Player) BasketballStats {
+			return BasketballStats{Points: 20, Rebounds: 10, FieldGoalsMade: 8, FieldGoalsAttempted: 16, FreeThrowsMade: 4, FreeThrowsAttempted: 5}
+		},
+	})
+}
+
+func TestNewBasketballCareerSimulator(t *testing.T) {
+	sim := NewBasketballCareerSimulator(BasketballSimulatorConfig{})
+
+	if sim.gamesPerSeason != 82 || sim.weeksPerSeason != 24 {
+		t.Errorf("Expected an 82 game season over 24 weeks, got %d games over %d weeks", sim.gamesPerSeason, sim.weeksPerSeason)
+	}
+	if sim.clock == nil || sim.injuryRoller == nil || sim.injuryTyper == nil || sim.statsGenerator == nil {
+		t.Error("Expected every dependency to have a default")
+	}
+}
+
+func TestBasketballWeekOfGame(t *testing.T) {
+	sim := NewBasketballCareerSimulator(BasketballSimulatorConfig{})
+
+	if week := sim.walker().weekOfGame(0, Player{}); week != 1 {
+		t.Errorf("Expected the first game in week 1, got %d", week)
+	}
+	if week := sim.walker().weekOfGame(81, Player{}); week != 24 {
+		t.Errorf("Expected the last game in week 24, got %d", week)
+	}
+}
+
+func TestBasketballCareerSimulatorSimulateRotationYear(t *testing.T) {
+	starter := Player{ID: "c-1", Position: "C", DraftYear: 2020, Age: 27, Skill: 0.8}
+	backup := Player{ID: "c-2", Position: "C", DraftYear: 2022, Age: 25, Skill: 0.8}
+	third := Player{ID: "c-3", Position: "C", DraftYear: 2024, Age: 23, Skill: 0.8}
+
+	t.Run("minutes fall off down the rotation", func(t *testing.T) {
+		sim := newTestBasketballSimulator(func(age int, position string) (bool, int) { return false, 0 })
+
+		results := sim.SimulateRotationYear([]Player{starter, backup, third}, 2024)
+
+		if results[0].GamesPlayed != 8 || len(results[0].Weeks) != 4 {
+			t.Errorf("Expected the starter to play 8 games over 4 weeks, got %d games over %d weeks", results[0].GamesPlayed, len(results[0].Weeks))
+		}
+		if results[0].Weeks[0].Games != 2 {
+			t.Errorf("Expected 2 games a week, got %d", results[0].Weeks[0].Games)
+		}
+		if results[1].Total.Rebounds >= results[0].Total.Rebounds || results[2].Total.Rebounds >= results[1].Total.Rebounds {
+			t.Errorf("Expected rebounds to fall off down the rotation, got %d, %d and %d",
+				results[0].Total.Rebounds, results[1].Total.Rebounds, results[2].Total.Rebounds)
+		}
+	})
+
+	t.Run("reserves move up while the starter is injured", func(t *testing.T) {
+		rolls := 0
+		sim := newTestBasketballSimulator(func(age int, position string) (bool, int) {
+			rolls++
+			// The starter is hurt in the first game and misses the rest of the season
+			return rolls == 1, 20
+		})
+
+		results := sim.SimulateRotationYear([]Player{starter, backup}, 2024)
+
+		if results[0].GamesPlayed != 1 || len(results[0].Injuries) != 1 {
+			t.Errorf("Expected the starter to play once and be injured once, got %d games and %d injuries", results[0].GamesPlayed, len(results[0].Injuries))
+		}
+		if !results[0].OutAtSeasonEnd {
+			t.Error("Expected the starter to still be out at the end of the season")
+		}
+		if results[1].Weeks[3].Stats.Rebounds <= results[1].Weeks[0].Stats.Rebounds {
+			t.Errorf("Expected the backup to rebound more as the starter, got %d in week 1 and %d in week 4",
+				results[1].Weeks[0].Stats.Rebounds, results[1].Weeks[3].Stats.Rebounds)
+		}
+	})
+}
+
+func TestBasketballCareerSimulatorCreateRotationCareers(t *testing.T) {
+	sim := newTestBasketballSimulator(func(age int, position string) (bool, int) { return false, 0 })
+	group := []Player{
+		{ID: "pg-1", Position: "PG", DraftYear: 2021, Age: 28, YearsOfExperience: 4, Skill: 0.8},
+		{ID: "pg-2", Position: "PG", DraftYear: 2025, Age: 21, Skill: 0.5},
+	}
+
+	careers := sim.CreateRotationCareers(group)
+
+	if len(careers[0]) != 4 {
+		t.Errorf("Expected 4 seasons for the veteran, got %d", len(careers[0]))
+	}
+	if len(careers[1]) != 1 || careers[1][0].Year != 2025 || careers[1][0].Stats.GamesPlayed != 0 {
+		t.Errorf("Expected an empty first season for the rookie, got %+v", careers[1])
+	}
+	if careers[0][0].Age != 24 {
+		t.Errorf("Expected the veteran to play their first season at 24, got %d", careers[0][0].Age)
+	}
+}
+
+func TestGenerateBasketballGameStats(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, position := range basketballPositions {
+		for range 50 {
+			stats := generateBasketballGameStats(rng, Player{Position: string(position)})
+
+			twos := stats.FieldGoalsMade - stats.ThreePointersMade
+			if stats.Points != 2*twos+3*stats.ThreePointersMade+stats.FreeThrowsMade {
+				t.Fatalf("Expected a %s's points to add up from their makes, got %+v", position, stats)
+			}
+			if stats.FieldGoalsMade > stats.FieldGoalsAttempted || stats.FreeThrowsMade > stats.FreeThrowsAttempted {
+				t.Fatalf("Expected a %s's makes not to pass their attempts, got %+v", position, stats)
+			}
+		}
+	}
+
+	if stats := generateBasketballGameStats(rng, Player{Position: "QB"}); stats != (BasketballStats{}) {
+		t.Errorf("Expected no stats for a football position, got %+v", stats)
+	}
+}
+
+func TestScaleBasketballStats(t *testing.T) {
+	stats := BasketballStats{Points: 29, ThreePointersMade: 3, FieldGoalsMade: 10, FieldGoalsAttempted: 20, FreeThrowsMade: 6, FreeThrowsAttempted: 8, Rebounds: 10}
+
+	scaled := scaleBasketballStats(stats, 0.5)
+
+	expected := BasketballStats{Points: 2*(5-2) + 3*2 + 3, ThreePointersMade: 2, FieldGoalsMade: 5, FieldGoalsAttempted: 10, FreeThrowsMade: 3, FreeThrowsAttempted: 4, Rebounds: 5}
+	if scaled != expected {
+		t.Errorf("Expected %+v, got %+v", expected, scaled)
+	}
+}
+
+func TestRollForBasketballInjury(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	injuries := 0
+	for range 10000 {
+		injured, gamesOut := rollForBasketballInjury(rng, 25, "PG")
+		if injured {
+			injuries++
+			if gamesOut < 1 || gamesOut > 30 {
+				t.Fatalf("Expected 1 to 30 games out, got %d", gamesOut)
+			}
+		}
+	}
+	// 0.8% a game, so roughly 80 injuries in 10000 games
+	if injuries < 30 || injuries > 150 {
+		t.Errorf("Expected around 80 injuries in 10000 games, got %d", injuries)
+	}
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"math/rand"
+)
+
+// Basketball positions
+const (
+	PG Position = "PG"
+	SG Position = "SG"
+	SF Position = "SF"
+	PF Position = "PF"
+	C  Position = "C"
+)
+
+// basketballPositions lists the positions a basketball roster carries, guards to bigs
+var basketballPositions = []Position{PG, SG, SF, PF, C}
+
+// BasketballRosterComposition is the standard 13 man basketball roster
+var BasketballRosterComposition = RosterComposition{
+	"PG": 3,
+	"SG": 3,
+	"SF": 2,
+	"PF": 2,
+	"C":  3,
+}
+
+// A curated list of 30 synthetic basketball teams
+var allAvailableBasketballFranchises = []Franchise{
+	{"Austin", "TX", "Bats", "AUS"},
+	{"Portland", "OR", "Rain", "POR"},
+	{"Salt Lake", "UT", "Flats", "SLC"},
+	{"Orlando", "FL", "Comets", "ORL"},
+	{"San Diego", "CA", "Surf", "SD"},
+	{"Columbus", "OH", "Foundry", "COL"},
+	{"Sacramento", "CA", "Gold", "SAC"},
+	{"San Antonio", "TX", "Missions", "SA"},
+	{"Memphis", "TN", "Blues", "MEM"},
+	{"Oklahoma City", "OK", "Storm", "OKC"},
+	{"Las Vegas", "NV", "Jackpot", "LV"},
+	{"Raleigh", "NC", "Oaks", "RAL"},
+	{"Louisville", "KY", "Sluggers", "LOU"},
+	{"Omaha", "NE", "Stampede", "OMA"},
+	{"Brooklyn", "NY", "Bridges", "BKN"},
+	{"Boston", "MA", "Harbor", "BOS"},
+	{"Philadelphia", "PA", "Bells", "PHI"},
+	{"Washington", "DC", "Monuments", "DC"},
+	{"Chicago", "IL", "Blaze", "CHI"},
+	{"Detroit", "MI", "Motors", "DET"},
+	{"Milwaukee", "WI", "Brewhouse", "MIL"},
+	{"Minneapolis", "MN", "North Stars", "MIN"},
+	{"Atlanta", "GA", "Peaches", "ATL"},
+	{"Miami", "FL", "Heatwave", "MIA"},
+	{"New Orleans", "LA", "Second Line", "NO"},
+	{"Nashville", "TN", "Encore", "NSH"},
+	{"Seattle", "WA", "Sound", "SEA"},
+	{"San Francisco", "CA", "Bay Lights", "SF"},
+	{"Los Angeles", "CA", "Waves", "LA"},
+	{"Denver", "CO", "Altitude", "DEN"},
+}
+
+// basketballBodies holds the height (inches) and weight (lbs) ranges of each basketball position
+var basketballBodies = map[Position]struct {
+	minHeight, maxHeight int
+	minWeight, maxWeight int
+}{
+	PG: {72, 77, 175, 205},
+	SG: {75, 79, 190, 215},
+	SF: {78, 81, 210, 235},
+	PF: {80, 83, 225, 250},
+	C:  {82, 86, 240, 275},
+}
+
+// generateBasketballLeagueFlat creates a 30 team basketball league: two conferences of three five-team divisions
+func generateBasketballLeagueFlat(uuidGenerator UUIDGenerator, rng *rand.Rand) LeagueFlat {
+	league := LeagueFlat{}
+
+	for _, confName := range []string{"Summit Conference", "Harbor Conference"} {
+		league.Conferences = append(league.Conferences, generateConference(confName, uuidGenerator))
+	}
+
+	divisionNames := []string{"North", "Central", "South"}
+	for _, conference := range league.Conferences {
+		for _, divName := range divisionNames {
+			league.Divisions = append(league.Divisions, generateDivision(divName, conference.ID, uuidGenerator))
+		}
+	}
+
+	// Deal the franchises out to the divisions in a random order
+	divisionSize := len(allAvailableBasketballFranchises) / len(league.Divisions)
+	for i, franchiseIndex := range rng.Perm(len(allAvailableBasketballFranchises)) {
+		division := league.Divisions[i/divisionSize]
+		team := generateTeam(allAvailableBasketballFranchises[franchiseIndex], division.ID, uuidGenerator)
+		team.SportType = SportBasketball
+		league.Teams = append(league.Teams, team)
+	}
+
+	return league
+}
+
+// createBasketballPlayer generates a basketball player. Names come from the same real data as
+// football players; bodies, ages and experience are drawn for the position.
+func createBasketballPlayer(position Position, teamID string, generators PlayerGenerators, clock Clock, uuidGenerator UUIDGenerator) Player {
+	rng := generators.Rand
+	body := basketballBodies[position]
+	age := normalIntInRangeWithMeanBias(rng, 19, 37, 0.4)
+	yoe := clampInt(age-normalIntInRange(rng, 19, 22), 0, age-19)
+
+	return Player{
+		ID:                uuidGenerator(),
+		DraftYear:         clock.Now().Year() - yoe,
+		FirstName:         generators.FirstNameGenerator(),
+		LastName:          generators.LastNameGenerator(),
+		Position:          string(position),
+		Jersey:            normalIntInRange(rng, 0, 55),
+		Height:            normalIntInRange(rng, body.minHeight, body.maxHeight),
+		Weight:            normalIntInRange(rng, body.minWeight, body.maxWeight),
+		Age:               age,
+		YearsOfExperience: yoe,
+		Status:            "ACTIVE",
+		Skill:             generators.SkillGenerator(),
+		TeamID:            teamID,
+	}
+}
+
+// createBasketballProspect generates a college prospect entering the basketball draft in draftYear, with a
+// hidden true skill and the noisier skill scouts see
+func createBasketballProspect(position Position, draftYear int, rng *rand.Rand) Player {
+	generators := getPlayerGenerators(collectAndAggregatePlayerAttributes, rng)
+	prospect := createBasketballPlayer(position, "", generators, yearClock(draftYear), uuidsFrom(rng))
+	prospect.Age = normalIntInRange(rng, 19, 22)
+	prospect.YearsOfExperience = 0
+	prospect.DraftYear = draftYear
+	prospect.Skill = clampFloat(generators.SkillGenerator(), 0.15, 0.95)
+	prospect.ScoutedSkill = scoutSkill(prospect.Skill, rng)
+	return prospect
+}
+
+// createBasketballRoster fills a team to BasketballRosterComposition, each position ordered from
+// starter down with skill falling off down the rotation
+func createBasketballRoster(teamID string, rng *rand.Rand, clock Clock) []Player {
+	generators := getPlayerGenerators(collectAndAggregatePlayerAttributes, rng)
+	uuidGenerator := uuidsFrom(rng)
+
+	var roster []Player
+	for _, position := range basketballPositions {
+		count := BasketballRosterComposition[string(position)]
+		for depthIndex := range count {
+			player := createBasketballPlayer(position, teamID, generators, clock, uuidGenerator)
+			player.Skill = createSkillForDepthPosition(rng, depthIndex, count)
+			roster = append(roster, player)
+		}
+	}
+	return roster
+}
+
+// basketballRotations splits a basketball roster into its position groups, keeping each group's order
+func basketballRotations(roster []Player) [][]Player {
+	var groups [][]Player
+	for _, position := range basketballPositions {
+		var group []Player
+		for _, player := range roster {
+			if player.Position == string(position) {
+				group = append(group, player)
+			}
+		}
+		if len(group) > 0 {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestGenerateBasketballLeagueFlat(t *testing.T) {
+	counter := 0
+	league := generateBasketballLeagueFlat(mockUUIDGenerator("bb", &counter), rand.New(rand.NewSource(1)))
+
+	if len(league.Conferences) != 2 {
+		t.Errorf("Expected 2 conferences, got %d", len(league.Conferences))
+	}
+	if len(league.Divisions) != 6 {
+		t.Errorf("Expected 6 divisions, got %d", len(league.Divisions))
+	}
+	if len(league.Teams) != len(allAvailableBasketballFranchises) {
+		t.Fatalf("Expected %d teams, got %d", len(allAvailableBasketballFranchises), len(league.Teams))
+	}
+
+	teamsPerDivision := make(map[string]int)
+	abbreviations := make(map[string]bool)
+	for _, team := range league.Teams {
+		teamsPerDivision[team.DivisionID]++
+		if team.SportType != SportBasketball {
+			t.Errorf("Expected %s to be a basketball team, got %q", team.Abbr, team.SportType)
+		}
+		if team.ByeWeek != 0 {
+			t.Errorf("Expected basketball teams to have no bye week, got %d for %s", team.ByeWeek, team.Abbr)
+		}
+		if abbreviations[team.Abbr] {
+			t.Errorf("Expected unique abbreviations, %s appears twice", team.Abbr)
+		}
+		abbreviations[team.Abbr] = true
+	}
+	for _, division := range league.Divisions {
+		if teamsPerDivision[division.ID] != 5 {
+			t.Errorf("Expected 5 teams in %s, got %d", division.Name, teamsPerDivision[division.ID])
+		}
+	}
+}
+
+func TestCreateBasketballPlayer(t *testing.T) {
+	counter := 0
+	generators := PlayerGenerators{
+		FirstNameGenerator: func() string { return "Test" },
+		LastNameGenerator:  func() string { return "Player" },
+		SkillGenerator:     func() float64 { return 0.5 },
+		Rand:               rand.New(rand.NewSource(1)),
+	}
+	clock := yearClock(2025)
+
+	for _, position := range basketballPositions {
+		player := createBasketballPlayer(position, "team-1", generators, clock, mockUUIDGenerator("p", &counter))
+		body := basketballBodies[position]
+
+		if player.Height < body.minHeight || player.Height > body.maxHeight {
+			t.Errorf("Expected a %s between %d and %d inches, got %d", position, body.minHeight, body.maxHeight, player.Height)
+		}
+		if player.Age < 19 || player.YearsOfExperience > player.Age-19 {
+			t.Errorf("Expected a %s to enter the league at 19 or older, got age %d with %d years", position, player.Age, player.YearsOfExperience)
+		}
+		if player.DraftYear+player.YearsOfExperience != 2025 {
+			t.Errorf("Expected draft year plus experience to be 2025, got %d + %d", player.DraftYear, player.YearsOfExperience)
+		}
+	}
+}
+
+func TestBasketballRotations(t *testing.T) {
+	roster := []Player{
+		{ID: "c-1", Position: "C"},
+		{ID: "pg-1", Position: "PG"},
+		{ID: "c-2", Position: "C"},
+		{ID: "pg-2", Position: "PG"},
+	}
+
+	groups := basketballRotations(roster)
+
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 position groups, got %d", len(groups))
+	}
+	if groups[0][0].ID != "pg-1" || groups[0][1].ID != "pg-2" {
+		t.Errorf("Expected point guards first in roster order, got %v", groups[0])
+	}
+	if groups[1][0].ID != "c-1" || groups[1][1].ID != "c-2" {
+		t.Errorf("Expected centers last in roster order, got %v", groups[1])
+	}
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"math/rand"
+)
+
+// defenseHistorySeasons is how many past seasons a team's defense is simulated for when the league is seeded
+const defenseHistorySeasons = 5
+
+// Team defense rates. A defense sacks the quarterback on a share of the opponent's pass attempts
+// that grows with its skill, and returns some of its takeaways for touchdowns.
+const (
+	baseSackRate       = 0.04
+	skillSackRate      = 0.05
+	takeawayReturnRate = 0.08
+)
+
+// createDefenseUnit creates a team's defense/special teams unit. It is named after the team and
+// carries a simulated history of defenseHistorySeasons seasons going into currentYear.
+func createDefenseUnit(team Team, currentYear int, rng *rand.Rand, uuidGenerator UUIDGenerator) Player {
+	return Player{
+		ID:                uuidGenerator(),
+		FirstName:         team.City,
+		LastName:          team.Name,
+		Position:          string(DST),
+		TeamID:            team.ID,
+		DraftYear:         currentYear - defenseHistorySeasons,
+		YearsOfExperience: defenseHistorySeasons,
+		Skill:             clampFloat(0.6+rng.NormFloat64()*0.12, 0.3, 0.95),
+		Status:            "ACTIVE",
+	}
+}
+
+// addFootballStats sums two football stat lines
+func addFootballStats(a, b FootballStats) FootballStats {
+	return FootballStats{
+		PassingAttempts:       a.PassingAttempts + b.PassingAttempts,
+		PassingCompletions:    a.PassingCompletions + b.PassingCompletions,
+		PassingInterceptions:  a.PassingInterceptions + b.PassingInterceptions,
+		PassingTDs:            a.PassingTDs + b.PassingTDs,
+		PassingYards:          a.PassingYards + b.PassingYards,
+		RushingAttempts:       a.RushingAttempts + b.RushingAttempts,
+		RushingYards:          a.RushingYards + b.RushingYards,
+		ReceivingYards:        a.ReceivingYards + b.ReceivingYards,
+		RushingTDs:            a.RushingTDs + b.RushingTDs,
+		ReceivingReceptions:   a.ReceivingReceptions + b.ReceivingReceptions,
+		ReceivingTDs:          a.ReceivingTDs + b.ReceivingTDs,
+		ReceivingTargets:      a.ReceivingTargets + b.ReceivingTargets,
+		Fumbles:               a.Fumbles + b.Fumbles,
+		FumblesLost:           a.FumblesLost + b.FumblesLost,
+		FieldGoals:            a.FieldGoals + b.FieldGoals,
+		FieldGoalsMade:        a.FieldGoalsMade + b.FieldGoalsMade,
+		FieldGoalsMissed:      a.FieldGoalsMissed + b.FieldGoalsMissed,
+		FieldGoalsBlocked:     a.FieldGoalsBlocked + b.FieldGoalsBlocked,
+		FieldGoalsBlockedMade: a.FieldGoalsBlockedMade + b.FieldGoalsBlockedMade,
+		FieldGoalsUnder40:     a.FieldGoalsUnder40 + b.FieldGoalsUnder40,
+		FieldGoalsMadeUnder40: a.FieldGoalsMadeUnder40 + b.FieldGoalsMadeUnder40,
+		FieldGoals40To49:      a.FieldGoals40To49 + b.FieldGoals40To49,
+		FieldGoalsMade40To49:  a.FieldGoalsMade40To49 + b.FieldGoalsMade40To49,
+		FieldGoals50Plus:      a.FieldGoals50Plus + b.FieldGoals50Plus,
+		FieldGoalsMade50Plus:  a.FieldGoalsMade50Plus + b.FieldGoalsMade50Plus,
+		ExtraPoints:           a.ExtraPoints + b.ExtraPoints,
+		ExtraPointsMade:       a.ExtraPointsMade + b.ExtraPointsMade,
+		ExtraPointsMissed:     a.ExtraPointsMissed + b.ExtraPointsMissed,
+		Tackles:               a.Tackles + b.Tackles,
+		AssistedTackles:       a.AssistedTackles + b.AssistedTackles,
+		Sacks:                 a.Sacks + b.Sacks,
+		Interceptions:         a.Interceptions + b.Interceptions,
+		PassesDefended:        a.PassesDefended + b.PassesDefended,
+		ForcedFumbles:         a.ForcedFumbles + b.ForcedFumbles,
+		FumbleRecoveries:      a.FumbleRecoveries + b.FumbleRecoveries,
+		DefensiveTDs:          a.DefensiveTDs + b.DefensiveTDs,
+		PointsAllowed:         a.PointsAllowed + b.PointsAllowed,
+		YardsAllowed:          a.YardsAllowed + b.YardsAllowed,
+	}
+}
+
+// weeklyOffense sums each team's stat lines by week for one season. teamOf maps the IDs of the
+// offensive players to their team; everyone else is left out.
+func weeklyOffense(seasons []PlayerYearlyStatsFootball, teamOf map[string]string) map[string]map[int]FootballStats {
+	offense := make(map[string]map[int]FootballStats)
+	for _, season := range seasons {
+		teamID, ok := teamOf[season.PlayerID]
+		if !ok {
+			continue
+		}
+		if offense[teamID] == nil {
+			offense[teamID] = make(map[int]FootballStats)
+		}
+		for _, week := range season.Stats.Weeks {
+			offense[teamID][week.Week] = addFootballStats(offense[teamID][week.Week], week.Stats)
+		}
+	}
+	return offense
+}
+
+// generateDefenseGameStats derives a defense's game from the offense it faced: it allows the
+// opponent's points and yards, and its takeaways are the opponent's interceptions and lost
+// fumbles. Sacks and return touchdowns depend on the defense's skill.
+func generateDefenseGameStats(opponent FootballStats, skill float64, rng *rand.Rand) FootballStats {
+	stats := FootballStats{
+		Interceptions:    opponent.PassingInterceptions,
+		FumbleRecoveries: opponent.FumblesLost,
+		PointsAllowed:    gamePoints(opponent),
+		YardsAllowed:     opponent.PassingYards + opponent.RushingYards,
+	}
+
+	sackRate := baseSackRate + skillSackRate*skill
+	for range opponent.PassingAttempts {
+		if rng.Float64() < sackRate {
+			stats.Sacks++
+		}
+	}
+	for range stats.Interceptions + stats.FumbleRecoveries {
+		if rng.Float64() < takeawayReturnRate {
+			stats.DefensiveTDs++
+		}
+	}
+	return stats
+}
+
+// simulateDefenseSeason plays a season's games for each team's defense against the offense of
+// the team it faced. units maps team IDs to their DST unit and offense maps team IDs to their
+// offense's stat line each week. Teams without a unit are skipped.
+func simulateDefenseSeason(units map[string]Player, games []ProGame, offense map[string]map[int]FootballStats, rng *rand.Rand) map[string]FootballYearlyStats {
+	seasons := make(map[string]FootballYearlyStats, len(units))
+	play := func(defenseTeamID, offenseTeamID string, week int) {
+		unit, ok := units[defenseTeamID]
+		if !ok {
+			return
+		}
+		stats := generateDefenseGameStats(offense[offenseTeamID][week], unit.Skill, rng)
+		season := seasons[defenseTeamID]
+		season.Weeks = append(season.Weeks, FootballWeekStats{Week: week, Stats: stats})
+		season.Total = addFootballStats(season.Total, stats)
+		seasons[defenseTeamID] = season
+	}
+
+	for _, game := range games {
+		play(game.HomeTeamID, game.AwayTeamID, game.Week)
+		play(game.AwayTeamID, game.HomeTeamID, game.Week)
+	}
+	return seasons
+}
+
+// scheduleWeeks is the number of weeks a season of gamesPerSeason games spans, counting the bye
+func scheduleWeeks(teams []Team, gamesPerSeason int) int {
+	if len(teams) > 0 && teams[0].ByeWeek > 0 {
+		return gamesPerSeason + 1
+	}
+	return gamesPerSeason
+}
+
+// simulateDefenseCareers simulates the history of every DST unit among players, from the unit's
+// first season up to currentYear, against the offenses in careers. Each season is played on a
+// new schedule.
+func simulateDefenseCareers(teams []Team, players []Player, careers []PlayerYearlyStatsFootball, currentYear, gamesPerSeason int, rng *rand.Rand) []PlayerYearlyStatsFootball {
+	units := make(map[string]Player)
+	teamOf := make(map[string]string, len(players))
+	firstYear := currentYear
+	for _, player := range players {
+		if player.Position == string(DST) {
+			units[player.TeamID] = player
+			firstYear = min(firstYear, player.DraftYear)
+			continue
+		}
+		if !isDefender(player.Position) {
+			teamOf[player.ID] = player.TeamID
+		}
+	}
+
+	seasonsByYear := make(map[int][]PlayerYearlyStatsFootball)
+	for _, season := range careers {
+		seasonsByYear[season.Year] = append(seasonsByYear[season.Year], season)
+	}
+
+	var results []PlayerYearlyStatsFootball
+	weeks := scheduleWeeks(teams, gamesPerSeason)
+	for year := firstYear; year < currentYear; year++ {
+		games := scheduleSeason(teams, weeks, rng)
+		seasons := simulateDefenseSeason(units, games, weeklyOffense(seasonsByYear[year], teamOf), rng)
+		for _, team := range teams {
+			unit, ok := units[team.ID]
+			if !ok || unit.DraftYear > year {
+				continue
+			}
+			results = append(results, defenseSeasonRecord(unit, year, seasons[team.ID]))
+		}
+	}
+	return results
+}
+
+// defenseSeasonRecord pairs a DST unit's season with its skill; a unit doesn't age
+func defenseSeasonRecord(unit Player, year int, stats FootballYearlyStats) PlayerYearlyStatsFootball {
+	return PlayerYearlyStatsFootball{
+		PlayerID: unit.ID,
+		Year:     year,
+		Skill:    unit.Skill,
+		Stats:    stats,
+	}
+}
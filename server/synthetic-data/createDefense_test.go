@@ -0,0 +1,88 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestCreateDefenseUnit(t *testing.T) {
+	team := Team{ID: "team-1", City: "Test City", Name: "Testers"}
+
+	unit := createDefenseUnit(team, 2025, rand.New(rand.NewSource(1)), func() string { return "dst-1" })
+
+	if unit.Position != "DST" || unit.TeamID != "team-1" {
+		t.Errorf("Expected a DST unit for team-1, got %s for %s", unit.Position, unit.TeamID)
+	}
+	if unit.FirstName != "Test City" || unit.LastName != "Testers" {
+		t.Errorf("Expected the unit to be named after the team, got %s %s", unit.FirstName, unit.LastName)
+	}
+	if unit.DraftYear+unit.YearsOfExperience != 2025 {
+		t.Errorf("Expected the unit to describe the 2025 season, got draft year %d with %d years", unit.DraftYear, unit.YearsOfExperience)
+	}
+}
+
+func TestGenerateDefenseGameStats(t *testing.T) {
+	opponent := FootballStats{
+		PassingAttempts:      35,
+		PassingYards:         240,
+		PassingInterceptions: 2,
+		RushingYards:         110,
+		RushingTDs:           1,
+		ReceivingTDs:         2,
+		FumblesLost:          1,
+		FieldGoalsMade:       1,
+		ExtraPointsMade:      3,
+	}
+
+	stats := generateDefenseGameStats(opponent, 0.8, rand.New(rand.NewSource(1)))
+
+	if stats.PointsAllowed != 24 {
+		t.Errorf("Expected 24 points allowed, got %d", stats.PointsAllowed)
+	}
+	if stats.YardsAllowed != 350 {
+		t.Errorf("Expected 350 yards allowed, got %d", stats.YardsAllowed)
+	}
+	if stats.Interceptions != 2 || stats.FumbleRecoveries != 1 {
+		t.Errorf("Expected the opponent's 2 interceptions and 1 lost fumble, got %d and %d", stats.Interceptions, stats.FumbleRecoveries)
+	}
+	if stats.Sacks > opponent.PassingAttempts || stats.DefensiveTDs > 3 {
+		t.Errorf("Expected sacks and return TDs within the opponent's plays, got %d and %d", stats.Sacks, stats.DefensiveTDs)
+	}
+}
+
+func TestSimulateDefenseCareers(t *testing.T) {
+	teams := []Team{{ID: "team-1"}, {ID: "team-2"}}
+	players := []Player{
+		{ID: "qb-1", Position: "QB", TeamID: "team-1"},
+		{ID: "qb-2", Position: "QB", TeamID: "team-2"},
+		{ID: "dst-1", Position: "DST", TeamID: "team-1", DraftYear: 2023, Skill: 0.7},
+		{ID: "dst-2", Position: "DST", TeamID: "team-2", DraftYear: 2024, Skill: 0.5},
+	}
+	var careers []PlayerYearlyStatsFootball
+	for _, id := range []string{"qb-1", "qb-2"} {
+		for year := 2023; year < 2025; year++ {
+			season := PlayerYearlyStatsFootball{PlayerID: id, Year: year}
+			for week := 1; week <= 3; week++ {
+				season.Stats.Weeks = append(season.Stats.Weeks, FootballWeekStats{Week: week, Stats: FootballStats{PassingYards: 200, ReceivingTDs: 1}})
+			}
+			careers = append(careers, season)
+		}
+	}
+
+	results := simulateDefenseCareers(teams, players, careers, 2025, 3, rand.New(rand.NewSource(1)))
+
+	seasons := make(map[string][]int)
+	for _, season := range results {
+		seasons[season.PlayerID] = append(seasons[season.PlayerID], season.Year)
+		if len(season.Stats.Weeks) != 3 {
+			t.Errorf("Expected %s to play 3 games in %d, got %d", season.PlayerID, season.Year, len(season.Stats.Weeks))
+		}
+		if season.Stats.Total.YardsAllowed != 600 || season.Stats.Total.PointsAllowed != 18 {
+			t.Errorf("Expected %s to allow 600 yards and 18 points in %d, got %d and %d",
+				season.PlayerID, season.Year, season.Stats.Total.YardsAllowed, season.Stats.Total.PointsAllowed)
+		}
+	}
+	if len(seasons["dst-1"]) != 2 || len(seasons["dst-2"]) != 1 {
+		t.Errorf("Expected seasons from each unit's first year up to 2025, got %v", seasons)
+	}
+}
@@ -0,0 +1,119 @@
+package main
+
+import "math/rand"
+
+// Individual defensive player (IDP) positions
+const (
+	DL Position = "DL"
+	LB Position = "LB"
+	DB Position = "DB"
+)
+
+// isDefender reports whether a football position is an individual defensive player
+func isDefender(position string) bool {
+	switch Position(position) {
+	case DL, LB, DB:
+		return true
+	}
+	return false
+}
+
+// defenderBodies holds the height (inches) and weight (lbs) ranges of each defensive position.
+// The real player data only profiles the offense, so defenders are built from these instead.
+var defenderBodies = map[Position]struct {
+	minHeight, maxHeight int
+	minWeight, maxWeight int
+}{
+	DL: {73, 79, 255, 330},
+	LB: {72, 76, 225, 255},
+	DB: {69, 74, 180, 210},
+}
+
+// createDefensivePlayer generates a defensive player, taking names from the real data like
+// everyone else
+func createDefensivePlayer(position Position, teamID string, generators PlayerGenerators, clock Clock, uuidGenerator UUIDGenerator) Player {
+	rng := generators.Rand
+	body := defenderBodies[position]
+	age := normalIntInRangeWithMeanBias(rng, 21, 35, 0.35)
+	yoe := clampInt(age-normalIntInRange(rng, 21, 23), 0, age-21)
+
+	return Player{
+		ID:                uuidGenerator(),
+		DraftYear:         clock.Now().Year() - yoe,
+		FirstName:         generators.FirstNameGenerator(),
+		LastName:          generators.LastNameGenerator(),
+		Position:          string(position),
+		Jersey:            normalIntInRange(rng, 20, 99),
+		Height:            normalIntInRange(rng, body.minHeight, body.maxHeight),
+		Weight:            normalIntInRange(rng, body.minWeight, body.maxWeight),
+		Age:               age,
+		YearsOfExperience: yoe,
+		Status:            "ACTIVE",
+		Skill:             generators.SkillGenerator(),
+		TeamID:            teamID,
+	}
+}
+
+// createFootballPlayer generates a football player at any position on the depth chart
+func createFootballPlayer(position Position, teamID string, generators PlayerGenerators, clock Clock, uuidGenerator UUIDGenerator) Player {
+	if isDefender(string(position)) {
+		return createDefensivePlayer(position, teamID, generators, clock, uuidGenerator)
+	}
+	return createNewPlayer(position, teamID, generators, clock, uuidGenerator)
+}
+
+// playmakingBias is the mean bias of a defender's big plays (sacks, interceptions, forced
+// fumbles): better defenders make them more often
+func playmakingBias(player Player) float64 {
+	return 0.04 + 0.12*clampFloat(player.Skill, 0, 1)
+}
+
+type defensiveLineGenerator struct{}
+
+func (d defensiveLineGenerator) generate(rng *rand.Rand, player Player, yearsOfExperience int) FootballStats {
+	return FootballStats{
+		Tackles:         normalIntInRange(rng, 1, 5),
+		AssistedTackles: normalIntInRange(rng, 0, 3),
+		Sacks:           normalIntInRangeWithMeanBias(rng, 0, 2, playmakingBias(player)),
+		PassesDefended:  normalIntInRangeWithMeanBias(rng, 0, 1, 0.1),
+		ForcedFumbles:   normalIntInRangeWithMeanBias(rng, 0, 1, playmakingBias(player)),
+	}
+}
+
+func DefensiveLineGameStatsGenerator() PlayerGameStatsGenerator {
+	return defensiveLineGenerator{}
+}
+
+type linebackerGenerator struct{}
+
+func (l linebackerGenerator) generate(rng *rand.Rand, player Player, yearsOfExperience int) FootballStats {
+	return FootballStats{
+		Tackles:         normalIntInRange(rng, 3, 10),
+		AssistedTackles: normalIntInRange(rng, 1, 5),
+		Sacks:           normalIntInRangeWithMeanBias(rng, 0, 1, playmakingBias(player)),
+		Interceptions:   normalIntInRangeWithMeanBias(rng, 0, 1, playmakingBias(player)/2),
+		PassesDefended:  normalIntInRangeWithMeanBias(rng, 0, 1, 0.2),
+		ForcedFumbles:   normalIntInRangeWithMeanBias(rng, 0, 1, playmakingBias(player)),
+	}
+}
+
+func LinebackerGameStatsGenerator() PlayerGameStatsGenerator {
+	return linebackerGenerator{}
+}
+
+type defensiveBackGenerator struct{}
+
+func (d defensiveBackGenerator) generate(rng *rand.Rand, player Player, yearsOfExperience int) FootballStats {
+	return FootballStats{
+		Tackles:         normalIntInRange(rng, 2, 7),
+		AssistedTackles: normalIntInRange(rng, 0, 3),
+		Sacks:           normalIntInRangeWithMeanBias(rng, 0, 1, 0.05),
+		Interceptions:   normalIntInRangeWithMeanBias(rng, 0, 1, playmakingBias(player)),
+		PassesDefended:  normalIntInRangeWithMeanBias(rng, 0, 3, 0.1+playmakingBias(player)),
+		ForcedFumbles:   normalIntInRangeWithMeanBias(rng, 0, 1, playmakingBias(player)/2),
+	}
+}
+
+func DefensiveBackGameStatsGenerator() PlayerGameStatsGenerator {
+	return defensiveBackGenerator{}
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestIsDefender(t *testing.T) {
+	for _, position := range []string{"DL", "LB", "DB"} {
+		if !isDefender(position) {
+			t.Errorf("Expected %s to be a defender", position)
+		}
+	}
+	for _, position := range []string{"QB", "PK", "DST", "SS"} {
+		if isDefender(position) {
+			t.Errorf("Expected %s not to be a defender", position)
+		}
+	}
+}
+
+func TestCreateDefensivePlayer(t *testing.T) {
+	generators := PlayerGenerators{
+		FirstNameGenerator: func() string { return "Test" },
+		LastNameGenerator:  func() string { return "Defender" },
+		SkillGenerator:     func() float64 { return 0.6 },
+		Rand:               rand.New(rand.NewSource(1)),
+	}
+	clock := MockClock{mockTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	for position, body := range defenderBodies {
+		player := createFootballPlayer(position, "team-1", generators, clock, func() string { return "id" })
+
+		if player.Position != string(position) || player.TeamID != "team-1" {
+			t.Errorf("Expected a %s on team-1, got %s on %s", position, player.Position, player.TeamID)
+		}
+		if player.Height < body.minHeight || player.Height > body.maxHeight {
+			t.Errorf("%s height %d outside [%d, %d]", position, player.Height, body.minHeight, body.maxHeight)
+		}
+		if player.Weight < body.minWeight || player.Weight > body.maxWeight {
+			t.Errorf("%s weight %d outside [%d, %d]", position, player.Weight, body.minWeight, body.maxWeight)
+		}
+		if player.DraftYear+player.YearsOfExperience != 2025 {
+			t.Errorf("Expected %s draft year and experience to add up to 2025, got %d + %d", position, player.DraftYear, player.YearsOfExperience)
+		}
+	}
+}
+
+func TestDefensiveGameStats(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	tests := []struct {
+		position   string
+		minTackles int
+		maxTackles int
+	}{
+		{"DL", 1, 5},
+		{"LB", 3, 10},
+		{"DB", 2, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.position, func(t *testing.T) {
+			player := Player{Position: tt.position, Skill: 0.9}
+			sacks := 0
+			for range 200 {
+				stats := generatePlayerGameStats(rng, player, 5)
+				if stats.Tackles < tt.minTackles || stats.Tackles > tt.maxTackles {
+					t.Fatalf("Expected %d to %d tackles, got %d", tt.minTackles, tt.maxTackles, stats.Tackles)
+				}
+				if stats.PassingYards != 0 || stats.RushingYards != 0 || stats.FieldGoals != 0 {
+					t.Fatalf("Expected a defender to record no offense, got %+v", stats)
+				}
+				sacks += stats.Sacks
+			}
+			// Even a great defender only gets to the quarterback now and then
+			if sacks > 200 {
+				t.Errorf("Expected well under a sack a game, got %d in 200 games", sacks)
+			}
+		})
+	}
+}
+
+func TestMultiplyYearlyStatsKeepsDefensiveBigPlays(t *testing.T) {
+	player := Player{Position: "LB", Skill: 0.5}
+	stats := FootballStats{Tackles: 8, AssistedTackles: 4, PassesDefended: 2, Sacks: 1, Interceptions: 1, ForcedFumbles: 1}
+
+	adjusted := multiplyYearlyStatsByPlayerSkill(player, stats)
+
+	if adjusted.Tackles != 4 || adjusted.AssistedTackles != 2 || adjusted.PassesDefended != 1 {
+		t.Errorf("Expected tackles and passes defended scaled by skill, got %+v", adjusted)
+	}
+	if adjusted.Sacks != 1 || adjusted.Interceptions != 1 || adjusted.ForcedFumbles != 1 {
+		t.Errorf("Expected big plays left as generated, got %+v", adjusted)
+	}
+}
+
+func TestSimulateDepthChartYearAccumulatesDefense(t *testing.T) {
+	sim := NewCareerSimulator(YearSimulatorConfig{
+		Clock:          MockClock{mockTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		GamesPerSeason: 4,
+		InjuryRoller:   func(age int, position string) (bool, int) { return false, 0 },
+		StatsGenerator: func(player Player, yoe int) FootballStats {
+			return FootballStats{Tackles: 5, AssistedTackles: 2, Sacks: 1, Interceptions: 1, PassesDefended: 2, ForcedFumbles: 1}
+		},
+		StatMultiplier: func(player Player, stats FootballStats) FootballStats { return stats },
+	})
+
+	totals := sim.SimulateYear(Player{Position: "DB", DraftYear: 2020, Age: 27, YearsOfExperience: 5, Skill: 0.8}, 2024).Total
+
+	expected := FootballStats{Tackles: 20, AssistedTackles: 8, Sacks: 4, Interceptions: 4, PassesDefended: 8, ForcedFumbles: 4}
+	if totals != expected {
+		t.Errorf("Expected season totals %+v, got %+v", expected, totals)
+	}
+}
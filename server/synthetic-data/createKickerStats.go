@@ -0,0 +1,152 @@
+package main
+
+import "math/rand"
+
+// Team drive rates. A team gets a handful of drives a game: some end in the end zone and set up an
+// extra point, and of the others some stall within range and set up a field goal try while the
+// rest end in a punt or a turnover. A few field goal tries are blocked, which counts as a miss.
+// Only kickers simulated without their team's offense draw touchdowns at touchdownDriveRate.
+const (
+	minTeamDrives      = 9
+	maxTeamDrives      = 13
+	touchdownDriveRate = 0.22
+	fieldGoalDriveRate = 0.21
+	fieldGoalBlockRate = 0.01
+)
+
+// Extra point accuracy. An average kicker makes extraPointAccuracy of their tries, and the best
+// and worst kickers are extraPointSkillSwing apart.
+const (
+	extraPointAccuracy   = 0.94
+	extraPointSkillSwing = 0.06
+)
+
+// fieldGoalRange is a distance field goals are tried from, with the stats it's recorded in
+type fieldGoalRange struct {
+	name string
+	// share is the share of field goal tries taken from this distance
+	share float64
+	// accuracy is how many tries an average kicker makes from this distance, and skillSwing how
+	// far apart the best and worst kickers are. Long kicks separate kickers the most.
+	accuracy   float64
+	skillSwing float64
+	// stats points at the range's attempts and makes in a stat line
+	stats func(stats *FootballStats) (attempts, made *int)
+}
+
+// fieldGoalRanges are the distance buckets field goals are recorded in, shortest first
+var fieldGoalRanges = []fieldGoalRange{
+	{"under 40", 0.5, 0.92, 0.08, func(s *FootballStats) (*int, *int) { return &s.FieldGoalsUnder40, &s.FieldGoalsMadeUnder40 }},
+	{"40 to 49", 0.3, 0.80, 0.16, func(s *FootballStats) (*int, *int) { return &s.FieldGoals40To49, &s.FieldGoalsMade40To49 }},
+	{"50 plus", 0.2, 0.62, 0.30, func(s *FootballStats) (*int, *int) { return &s.FieldGoals50Plus, &s.FieldGoalsMade50Plus }},
+}
+
+// kickAccuracy is the chance a kicker of the given skill makes a kick an average kicker makes at
+// accuracy
+func kickAccuracy(accuracy, skillSwing, skill float64) float64 {
+	return clampFloat(accuracy+(skill-0.5)*skillSwing, 0, 0.99)
+}
+
+// generateKickerGameStats has a kicker try the kicks their team's offense set up in a game: an
+// extra point after each of its touchdowns, and a field goal, from one of the fieldGoalRanges, on
+// each of its other drives that stalls in range
+func generateKickerGameStats(skill float64, touchdowns int, rng *rand.Rand) FootballStats {
+	var stats FootballStats
+	for range touchdowns {
+		stats.ExtraPoints++
+		if rng.Float64() < kickAccuracy(extraPointAccuracy, extraPointSkillSwing, skill) {
+			stats.ExtraPointsMade++
+		}
+	}
+	drives := max(minTeamDrives+rng.Intn(maxTeamDrives-minTeamDrives+1), touchdowns)
+	for range drives - touchdowns {
+		if rng.Float64() < fieldGoalDriveRate {
+			kickFieldGoal(&stats, skill, rng)
+		}
+	}
+	stats.FieldGoalsMissed = stats.FieldGoals - stats.FieldGoalsMade
+	stats.ExtraPointsMissed = stats.ExtraPoints - stats.ExtraPointsMade
+	return stats
+}
+
+// averageTeamTouchdowns draws the touchdowns of an average offense's game, for kickers simulated
+// without their team's offense
+func averageTeamTouchdowns(rng *rand.Rand) int {
+	touchdowns := 0
+	for range minTeamDrives + rng.Intn(maxTeamDrives-minTeamDrives+1) {
+		if rng.Float64() < touchdownDriveRate {
+			touchdowns++
+		}
+	}
+	return touchdowns
+}
+
+// offenseTouchdowns is the touchdowns a team's offense scored in a game, counted as gamePoints
+// counts them
+func offenseTouchdowns(offense FootballStats) int {
+	return offense.RushingTDs + offense.ReceivingTDs
+}
+
+// kickTeamSeasons has a team's kickers try the kicks the rest of its offense set up in the games
+// they played, replacing the kicks they were simulated with. seasons are the seasons of the
+// roster's players, over any number of years; the kickers' seasons are updated in place.
+func kickTeamSeasons(roster FootballTeamRoster, seasons []PlayerYearlyStatsFootball, rng *rand.Rand) {
+	kickers := make(map[string]bool, len(roster.PK))
+	for _, kicker := range roster.PK {
+		kickers[kicker.ID] = true
+	}
+	// The seasons are all one team's, so weeklyOffense sums them under a single key
+	offense := make(map[string]string)
+	for _, group := range [][]Player{roster.QB, roster.RB, roster.WR, roster.TE} {
+		for _, player := range group {
+			offense[player.ID] = ""
+		}
+	}
+
+	seasonsByYear := make(map[int][]PlayerYearlyStatsFootball)
+	for _, season := range seasons {
+		seasonsByYear[season.Year] = append(seasonsByYear[season.Year], season)
+	}
+	weekly := make(map[int]map[int]FootballStats, len(seasonsByYear))
+	for year, yearSeasons := range seasonsByYear {
+		weekly[year] = weeklyOffense(yearSeasons, offense)[""]
+	}
+
+	for i, season := range seasons {
+		if !kickers[season.PlayerID] {
+			continue
+		}
+		var total FootballStats
+		for j, week := range season.Stats.Weeks {
+			stats := generateKickerGameStats(season.Skill, offenseTouchdowns(weekly[season.Year][week.Week]), rng)
+			seasons[i].Stats.Weeks[j].Stats = stats
+			total = addFootballStats(total, stats)
+		}
+		seasons[i].Stats.Total = total
+	}
+}
+
+// kickFieldGoal tries a field goal from a distance drawn from the fieldGoalRanges and records it
+// in stats
+func kickFieldGoal(stats *FootballStats, skill float64, rng *rand.Rand) {
+	kick := fieldGoalRanges[len(fieldGoalRanges)-1]
+	roll := rng.Float64()
+	for _, r := range fieldGoalRanges {
+		if roll < r.share {
+			kick = r
+			break
+		}
+		roll -= r.share
+	}
+
+	attempts, made := kick.stats(stats)
+	stats.FieldGoals++
+	*attempts++
+	switch {
+	case rng.Float64() < fieldGoalBlockRate:
+		stats.FieldGoalsBlocked++
+	case rng.Float64() < kickAccuracy(kick.accuracy, kick.skillSwing, skill):
+		stats.FieldGoalsMade++
+		*made++
+	}
+}
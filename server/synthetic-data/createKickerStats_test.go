@@ -0,0 +1,149 @@
+package main
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+)
+
+func TestGenerateKickerGameStatsFollowsDrives(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	const games = 2000
+
+	var season FootballStats
+	for range games {
+		touchdowns := averageTeamTouchdowns(rng)
+		stats := generateKickerGameStats(0.6, touchdowns, rng)
+		if stats.ExtraPoints != touchdowns {
+			t.Fatalf("Expected an extra point try for each of the %d touchdowns, got %d", touchdowns, stats.ExtraPoints)
+		}
+		if stats.FieldGoals+stats.ExtraPoints > maxTeamDrives {
+			t.Fatalf("Expected no more kicks than drives, got %d field goals and %d extra points", stats.FieldGoals, stats.ExtraPoints)
+		}
+		if broken := footballViolations(stats); broken != nil {
+			t.Fatalf("Expected a consistent kicking line, got %v in %+v", broken, stats)
+		}
+		season = addFootballStats(season, stats)
+	}
+
+	// An average offense's 9-13 drives set up about 2.4 extra points and 1.8 field goal tries a game
+	if perGame := float64(season.ExtraPoints) / games; perGame < 2.1 || perGame > 2.7 {
+		t.Errorf("Expected about 2.4 extra points a game, got %.2f", perGame)
+	}
+	if perGame := float64(season.FieldGoals) / games; perGame < 1.5 || perGame > 2.1 {
+		t.Errorf("Expected about 1.8 field goal tries a game, got %.2f", perGame)
+	}
+	if season.FieldGoals50Plus >= season.FieldGoals40To49 || season.FieldGoals40To49 >= season.FieldGoalsUnder40 {
+		t.Errorf("Expected fewer tries the longer the kick, got %d, %d and %d",
+			season.FieldGoalsUnder40, season.FieldGoals40To49, season.FieldGoals50Plus)
+	}
+
+	rate := func(made, attempts int) float64 { return float64(made) / float64(attempts) }
+	short := rate(season.FieldGoalsMadeUnder40, season.FieldGoalsUnder40)
+	long := rate(season.FieldGoalsMade50Plus, season.FieldGoals50Plus)
+	if long >= short {
+		t.Errorf("Expected long kicks to be missed more, made %.2f from 50+ and %.2f from under 40", long, short)
+	}
+}
+
+func TestGenerateKickerGameStatsRewardsSkill(t *testing.T) {
+	longMakeRate := func(skill float64) float64 {
+		rng := rand.New(rand.NewSource(1))
+		var season FootballStats
+		for range 3000 {
+			season = addFootballStats(season, generateKickerGameStats(skill, 2, rng))
+		}
+		return float64(season.FieldGoalsMade50Plus) / float64(season.FieldGoals50Plus)
+	}
+
+	if strong, weak := longMakeRate(0.9), longMakeRate(0.3); strong <= weak {
+		t.Errorf("Expected a skilled kicker to make more long kicks, made %.2f against %.2f", strong, weak)
+	}
+}
+
+func TestGenerateKickerGameStatsFewerTriesForBetterOffenses(t *testing.T) {
+	tries := func(touchdowns int) int {
+		rng := rand.New(rand.NewSource(1))
+		total := 0
+		for range 1000 {
+			total += generateKickerGameStats(0.5, touchdowns, rng).FieldGoals
+		}
+		return total
+	}
+
+	// Drives that end in the end zone aren't there to stall in field goal range
+	if scoring, stalling := tries(5), tries(1); scoring >= stalling {
+		t.Errorf("Expected fewer field goal tries behind an offense scoring more touchdowns, got %d against %d", scoring, stalling)
+	}
+	if stats := generateKickerGameStats(0.5, maxTeamDrives+2, rand.New(rand.NewSource(1))); stats.ExtraPoints != maxTeamDrives+2 || stats.FieldGoals != 0 {
+		t.Errorf("Expected a try after every touchdown and no drives left for field goals, got %+v", stats)
+	}
+}
+
+func TestGeneratedKickersKickForTheirOffense(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+	generatorsOnce = sync.Once{}
+	getPlayerGenerators(seededTestAggregator, rng)
+
+	generator := NewLeagueDataGenerator(DefaultLeagueDefinition, rng, yearClock(2025))
+	league := generator.GenerateLeague()
+	for _, team := range league.Teams[:2] {
+		roster := generator.GenerateRoster(team.ID)
+		careers := generator.GenerateCareers(roster)
+
+		kickers := make(map[string]bool)
+		for _, kicker := range roster.PK {
+			kickers[kicker.ID] = true
+		}
+		touchdowns := make(map[[2]int]int)
+		for _, season := range careers {
+			for _, week := range season.Stats.Weeks {
+				touchdowns[[2]int{season.Year, week.Week}] += offenseTouchdowns(week.Stats)
+			}
+		}
+
+		kicked := 0
+		for _, season := range careers {
+			if !kickers[season.PlayerID] {
+				continue
+			}
+			for _, week := range season.Stats.Weeks {
+				kicked++
+				if scored := touchdowns[[2]int{season.Year, week.Week}]; week.Stats.ExtraPoints != scored {
+					t.Errorf("Expected %s's kicker to try %d extra points in week %d of %d, one per touchdown, got %d",
+						team.ID, scored, week.Week, season.Year, week.Stats.ExtraPoints)
+				}
+			}
+		}
+		if kicked == 0 {
+			t.Errorf("Expected %s's kicker to have played", team.ID)
+		}
+	}
+}
+
+func TestKickAccuracy(t *testing.T) {
+	if got := kickAccuracy(0.8, 0.2, 0.5); got != 0.8 {
+		t.Errorf("Expected an average kicker to make 0.8, got %v", got)
+	}
+	if got := kickAccuracy(0.95, 0.2, 1.5); got != 0.99 {
+		t.Errorf("Expected no kicker to be perfect, got %v", got)
+	}
+}
+
+func TestSimulatedKickerSeasonTotalsKicks(t *testing.T) {
+	sim := NewCareerSimulator(YearSimulatorConfig{
+		Rand:         rand.New(rand.NewSource(3)),
+		InjuryRoller: func(int, string) (bool, int) { return false, 0 },
+	})
+	kicker := Player{ID: "k-1", Position: "PK", Age: 28, YearsOfExperience: 5, DraftYear: 2019, Skill: 0.7}
+
+	season := sim.SimulateDepthChartYear([]Player{kicker}, 2024)[0]
+
+	var weeks FootballStats
+	for _, week := range season.Weeks {
+		weeks = addFootballStats(weeks, week.Stats)
+	}
+	if season.Total != weeks || season.Total.FieldGoals == 0 || season.Total.ExtraPoints == 0 {
+		t.Errorf("Expected the season to total the weeks' kicks\ngot:  %+v\nwant: %+v", season.Total, weeks)
+	}
+}
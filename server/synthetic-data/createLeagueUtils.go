@@ -6,10 +6,10 @@ import (
 
 // Franchise represents a team identity
 type Franchise struct {
-	City  string
-	State string
-	Name  string
-	Abbr  string
+	City  string `json:"city"`
+	State string `json:"state"`
+	Name  string `json:"name"`
+	Abbr  string `json:"abbr"`
 }
 
 // A curated list of 32 synthetic teams
@@ -52,6 +52,12 @@ var allAvailableFranchises = []Franchise{
 	{"Denver", "CO", "Summits", "DEN"},
 }
 
+// Bye weeks are spread across the middle of the season
+const (
+	firstByeWeek = 5
+	lastByeWeek  = 14
+)
+
 func generateConference(name string, uuidGenerator UUIDGenerator) Conference {
 	return Conference{
 		ID:   uuidGenerator(),
@@ -85,18 +91,23 @@ type LeagueFlat struct {
 }
 
 func generateLeagueFlat(uuidGenerator UUIDGenerator, clock Clock, rng *rand.Rand) LeagueFlat {
+	return generateDefinedLeagueFlat(DefaultLeagueDefinition, uuidGenerator, rng)
+}
+
+// generateDefinedLeagueFlat creates the conferences, divisions and teams a league definition
+// describes, dealing each division its teams from the definition's franchises at random
+func generateDefinedLeagueFlat(definition LeagueDefinition, uuidGenerator UUIDGenerator, rng *rand.Rand) LeagueFlat {
 	returnValue := LeagueFlat{}
 
 	// Generate Conferences
-	confNames := []string{"Union Conference", "Alliance Conference"}
-	generatedConferences := make([]Conference, len(confNames))
-	for confIndex, confName := range confNames {
+	generatedConferences := make([]Conference, len(definition.Conferences))
+	for confIndex, confName := range definition.Conferences {
 		generatedConferences[confIndex] = generateConference(confName, uuidGenerator)
 	}
 	returnValue.Conferences = generatedConferences
 
 	// Generate Divisions
-	divisionNames := []string{"North", "South", "East", "West"}
+	divisionNames := definition.Divisions
 	generatedDivisions := make([]Division, len(divisionNames)*len(generatedConferences))
 	for confIndex, generatedConference := range generatedConferences {
 		for divIndex, divName := range divisionNames {
@@ -106,23 +117,52 @@ func generateLeagueFlat(uuidGenerator UUIDGenerator, clock Clock, rng *rand.Rand
 	returnValue.Divisions = generatedDivisions
 
 	// Generate Teams
-	// Create a copy of allAvailableFranchises to avoid mutating the global slice
-	availableFranchises := make([]Franchise, len(allAvailableFranchises))
-	copy(availableFranchises, allAvailableFranchises)
-	
-	generatedTeams := make([]Team, len(availableFranchises))
+	// Create a copy of the franchises to avoid mutating the definition's slice
+	availableFranchises := make([]Franchise, len(definition.Franchises))
+	copy(availableFranchises, definition.Franchises)
+
+	divisionSize := definition.TeamsPerDivision
+	generatedTeams := make([]Team, len(generatedDivisions)*divisionSize)
 	for divisionIndex, generatedDivision := range generatedDivisions {
-		// each division has 4 teams
-		divisionSize := 4
 		for teamIndex := range divisionSize {
 			randomIndex := rng.Intn(len(availableFranchises))
 			generatedTeams[divisionIndex*divisionSize+teamIndex] = generateTeam(availableFranchises[randomIndex], generatedDivision.ID, uuidGenerator)
 			// remove the franchise from the list
 			availableFranchises = append(availableFranchises[:randomIndex], availableFranchises[randomIndex+1:]...)
 		}
-
 	}
+	for i := range generatedTeams {
+		generatedTeams[i].SportType = SportFootball
+	}
+	first, last := byeWeekWindow(definition.GamesPerSeason)
+	assignByeWeeksWithin(generatedTeams, first, last, rng)
 	returnValue.Teams = generatedTeams
 
 	return returnValue
 }
+
+// assignByeWeeks gives every team a bye, spreading teams as evenly as possible across the bye weeks
+func assignByeWeeks(teams []Team, rng *rand.Rand) {
+	assignByeWeeksWithin(teams, firstByeWeek, lastByeWeek, rng)
+}
+
+// assignByeWeeksWithin spreads the teams' byes across weeks first to last. With no weeks in
+// between, no one gets a bye.
+func assignByeWeeksWithin(teams []Team, first, last int, rng *rand.Rand) {
+	byeWeeks := last - first + 1
+	if byeWeeks < 1 {
+		return
+	}
+	for i, teamIndex := range rng.Perm(len(teams)) {
+		teams[teamIndex].ByeWeek = first + i%byeWeeks
+	}
+}
+
+// byeWeekWindow scales the bye weeks of a standard season to one of gamesPerSeason games, keeping
+// them out of the first and last weeks. Seasons too short for that have no byes.
+func byeWeekWindow(gamesPerSeason int) (first, last int) {
+	if gamesPerSeason == defaultGamesPerSeason {
+		return firstByeWeek, lastByeWeek
+	}
+	return max(firstByeWeek*gamesPerSeason/defaultGamesPerSeason, 2), lastByeWeek * gamesPerSeason / defaultGamesPerSeason
+}
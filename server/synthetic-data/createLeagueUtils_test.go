@@ -213,7 +213,7 @@ func TestGenerateLeagueFlatRandomness(t *testing.T) {
 	// Collect team names from both leagues
 	teams1 := make(map[string]bool)
 	teams2 := make(map[string]bool)
-	
+
 	for _, team := range league1.Teams {
 		teams1[team.Name] = true
 	}
@@ -240,7 +240,7 @@ func TestGenerateLeagueFlatRandomness(t *testing.T) {
 func TestAllAvailableFranchises(t *testing.T) {
 	// Count franchises in source code (since the slice might be modified by other tests)
 	expectedCount := 32
-	
+
 	// Create a fresh copy for validation
 	franchises := []Franchise{
 		{"Austin", "TX", "Desperados", "AUS"},
@@ -284,7 +284,7 @@ func TestAllAvailableFranchises(t *testing.T) {
 	// Verify all franchises have required fields
 	abbrs := make(map[string]bool)
 	names := make(map[string]bool)
-	
+
 	for i, franchise := range franchises {
 		if franchise.City == "" {
 			t.Errorf("Franchise at index %d has empty city", i)
@@ -313,3 +313,24 @@ func TestAllAvailableFranchises(t *testing.T) {
 	}
 }
 
+func TestAssignByeWeeks(t *testing.T) {
+	teams := make([]Team, 32)
+	rng := rand.New(rand.NewSource(12345))
+
+	assignByeWeeks(teams, rng)
+
+	byesPerWeek := make(map[int]int)
+	for i, team := range teams {
+		if team.ByeWeek < firstByeWeek || team.ByeWeek > lastByeWeek {
+			t.Errorf("Team %d has bye week %d outside %d-%d", i, team.ByeWeek, firstByeWeek, lastByeWeek)
+		}
+		byesPerWeek[team.ByeWeek]++
+	}
+
+	// 32 teams over 10 bye weeks puts 3 or 4 teams on bye each week
+	for week, count := range byesPerWeek {
+		if count < 3 || count > 4 {
+			t.Errorf("Expected 3 or 4 teams on bye in week %d, got %d", week, count)
+		}
+	}
+}
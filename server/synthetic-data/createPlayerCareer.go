@@ -5,6 +5,8 @@ import (
 	"math"
 	"math/rand"
 	"time"
+
+	"fantasy-draft/fantasy"
 )
 
 // =============================================================================
@@ -24,32 +26,52 @@ type RealClock struct{}
 
 func (RealClock) Now() time.Time { return time.Now() }
 
+// yearClock is a Clock fixed at the start of a year, for simulating a league whose current season isn't this year's
+type yearClock int
+
+func (y yearClock) Now() time.Time { return time.Date(int(y), time.January, 1, 0, 0, 0, 0, time.UTC) }
+
+// defaultGamesPerSeason is the length of a pro football regular season
+const defaultGamesPerSeason = fantasy.FootballGamesPerSeason
+
 // YearSimulatorConfig holds all injectable dependencies for simulating player years
 // Any nil fields will use production defaults when passed to NewCareerSimulator
 type YearSimulatorConfig struct {
 	// Clock for getting current time (default: RealClock)
 	Clock Clock
 
+	// Rand is the source the default rollers and stats generator draw from (default: seeded from the clock)
+	Rand *rand.Rand
+
 	// GamesPerSeason is number of games in a season (default: 18)
 	GamesPerSeason int
 
+	// ByeWeeks maps pro team IDs to the week they don't play (default: no byes)
+	ByeWeeks map[string]int
+
 	// InjuryRoller determines if a player gets injured (default: rollForInjury)
 	InjuryRoller func(age int, position string) (injured bool, gamesOut int)
 
+	// InjuryTypeRoller names an injury of the given severity (default: rollInjuryType)
+	InjuryTypeRoller func(severity string) string
+
 	// StatsGenerator creates stats for a single game (default: generatePlayerGameStats)
 	StatsGenerator func(player Player, yearsOfExperience int) FootballStats
 
 	// StatMultiplier adjusts stats based on player skill (default: multiplyYearlyStatsByPlayerSkill)
-	StatMultiplier func(player Player, yearsOfExperience int, stats FootballStats) FootballStats
+	StatMultiplier func(player Player, stats FootballStats) FootballStats
 }
 
 // CareerSimulator handles all year/career simulation with injectable dependencies
 type CareerSimulator struct {
 	clock          Clock
+	rng            *rand.Rand
 	gamesPerSeason int
+	byeWeeks       map[string]int
 	injuryRoller   func(int, string) (bool, int)
+	injuryTyper    func(string) string
 	statsGenerator func(Player, int) FootballStats
-	statMultiplier func(Player, int, FootballStats) FootballStats
+	statMultiplier func(Player, FootballStats) FootballStats
 }
 
 // NewCareerSimulator creates a CareerSimulator with the given config
@@ -57,8 +79,11 @@ type CareerSimulator struct {
 func NewCareerSimulator(cfg YearSimulatorConfig) *CareerSimulator {
 	sim := &CareerSimulator{
 		clock:          cfg.Clock,
+		rng:            cfg.Rand,
 		gamesPerSeason: cfg.GamesPerSeason,
+		byeWeeks:       cfg.ByeWeeks,
 		injuryRoller:   cfg.InjuryRoller,
+		injuryTyper:    cfg.InjuryTypeRoller,
 		statsGenerator: cfg.StatsGenerator,
 		statMultiplier: cfg.StatMultiplier,
 	}
@@ -67,14 +92,26 @@ func NewCareerSimulator(cfg YearSimulatorConfig) *CareerSimulator {
 	if sim.clock == nil {
 		sim.clock = RealClock{}
 	}
+	if sim.rng == nil {
+		sim.rng = clockSeededRandom()
+	}
 	if sim.gamesPerSeason == 0 {
-		sim.gamesPerSeason = 18
+		sim.gamesPerSeason = defaultGamesPerSeason
 	}
 	if sim.injuryRoller == nil {
-		sim.injuryRoller = rollForInjury
+		sim.injuryRoller = func(age int, position string) (bool, int) {
+			return rollForInjury(sim.rng, age, position)
+		}
+	}
+	if sim.injuryTyper == nil {
+		sim.injuryTyper = func(severity string) string {
+			return rollInjuryType(sim.rng, severity)
+		}
 	}
 	if sim.statsGenerator == nil {
-		sim.statsGenerator = generatePlayerGameStats
+		sim.statsGenerator = func(player Player, yearsOfExperience int) FootballStats {
+			return generatePlayerGameStats(sim.rng, player, yearsOfExperience)
+		}
 	}
 	if sim.statMultiplier == nil {
 		sim.statMultiplier = multiplyYearlyStatsByPlayerSkill
@@ -85,80 +122,167 @@ func NewCareerSimulator(cfg YearSimulatorConfig) *CareerSimulator {
 
 // CreateCareer generates stats for a player's entire career up to current year
 func (sim *CareerSimulator) CreateCareer(player Player) []PlayerYearlyStatsFootball {
-	currentYear := sim.clock.Now().Year()
-	draftYear := player.DraftYear
-
-	// Player is a rookie about to start their first year
-	if draftYear == currentYear {
-		rookieYear := PlayerYearlyStatsFootball{
-			PlayerID: player.ID,
-			Year:     currentYear,
-			Stats:    FootballYearlyStats{Total: FootballStats{}},
-		}
-		return []PlayerYearlyStatsFootball{rookieYear}
-	}
-
-	careerYears := currentYear - draftYear
-	careerStats := make([]PlayerYearlyStatsFootball, careerYears)
-	for i := range careerYears {
-		careerStats[i] = sim.CreateYear(player, draftYear)
-		draftYear++
-	}
-	return careerStats
+	return sim.CreateDepthChartCareers([]Player{player})[0]
 }
 
 // CreateYear generates stats for a single season
 func (sim *CareerSimulator) CreateYear(player Player, year int) PlayerYearlyStatsFootball {
-	return PlayerYearlyStatsFootball{
-		PlayerID: player.ID,
-		Year:     year,
-		Stats:    sim.SimulateYear(player, year),
-	}
+	return sim.walker().seasonRecord(player, year, sim.SimulateYear(player, year))
 }
 
-// SimulateYear walks through each game in a season, handling injuries and accumulating stats
-func (sim *CareerSimulator) SimulateYear(player Player, year int) FootballYearlyStats {
-	playerYearsOfExperience := player.DraftYear - year
-	isInjured := false
-	injuryGameCount := 0
-	yearlyStats := FootballStats{}
-
-	for range sim.gamesPerSeason {
-		if isInjured {
-			injuryGameCount--
-			if injuryGameCount <= 0 {
-				isInjured = false
+// walker builds the career walker the simulator plays its seasons on. Each player's game is
+// generated, scaled to their skill and their share of the snaps, and played in its own week,
+// with games after the team's bye played a week later.
+func (sim *CareerSimulator) walker() careerWalker[FootballStats, FootballYearlyStats, PlayerYearlyStatsFootball] {
+	return careerWalker[FootballStats, FootballYearlyStats, PlayerYearlyStatsFootball]{
+		clock:          sim.clock,
+		gamesPerSeason: sim.gamesPerSeason,
+		injuryRoller:   sim.injuryRoller,
+		injuryTyper:    sim.injuryTyper,
+		playingTime:    func() playingTimeRule { return depthChartPlayingTime },
+		weekOfGame: func(gameIndex int, player Player) int {
+			week := gameIndex + 1
+			if byeWeek := sim.byeWeeks[player.TeamID]; byeWeek > 0 && week >= byeWeek {
+				week++
 			}
-			continue
-		}
+			return week
+		},
+		playGame: func(player Player, turn appearance) FootballStats {
+			gameStats := sim.statsGenerator(player, player.YearsOfExperience)
+			gameStats = sim.statMultiplier(player, gameStats)
+			return enforceFootballInvariants(scaleGameStats(gameStats, turn.Share))
+		},
+		seasonStats: func(season walkedSeason[FootballStats]) FootballYearlyStats {
+			stats := FootballYearlyStats{
+				Weeks:          make([]FootballWeekStats, len(season.Games)),
+				Injuries:       season.Injuries,
+				OutAtSeasonEnd: season.OutAtSeasonEnd,
+			}
+			for i, game := range season.Games {
+				stats.Weeks[i] = FootballWeekStats{Week: game.Week, Stats: game.Stats}
+				stats.Total = addFootballStats(stats.Total, game.Stats)
+			}
+			return stats
+		},
+		record: func(player Player, year int, seasonPlayer Player, stats FootballYearlyStats) PlayerYearlyStatsFootball {
+			return PlayerYearlyStatsFootball{PlayerID: player.ID, Year: year, Age: seasonPlayer.Age, Skill: seasonPlayer.Skill, Stats: stats}
+		},
+	}
+}
 
-		wasInjured, injuryGamesAffected := sim.injuryRoller(player.Age, player.Position)
-		if wasInjured {
-			isInjured = true
-			injuryGameCount = injuryGamesAffected
-		}
+// snapShares is the share of a starter's workload each healthy player at a position gets,
+// indexed by their place among the healthy players on the depth chart.
+// Players past the end of the list are inactive and don't record a game.
+var snapShares = map[string][]float64{
+	"QB": {1.0, 0.05},
+	"RB": {1.0, 0.35, 0.1},
+	"WR": {1.0, 1.0, 1.0, 0.3, 0.1},
+	"TE": {1.0, 0.3},
+	"PK": {1.0},
+	// Defenses rotate their linemen and bring on a nickel back in passing situations
+	"DL": {1.0, 1.0, 1.0, 1.0, 0.4, 0.2},
+	"LB": {1.0, 1.0, 0.5, 0.1},
+	"DB": {1.0, 1.0, 1.0, 1.0, 0.6, 0.1},
+	// Basketball rotations share minutes rather than snaps
+	"PG": {1.0, 0.5, 0.15},
+	"SG": {1.0, 0.45, 0.1},
+	"SF": {1.0, 0.5},
+	"PF": {1.0, 0.5},
+	"C":  {1.0, 0.45, 0.1},
+	// Baseball shares are the chance of appearing in a game: the bullpen splits the relief
+	// innings and bench players spell the everyday starters
+	"RP":      {0.4, 0.45, 0.4, 0.35, 0.3, 0.3, 0.25, 0.2},
+	"CATCHER": {0.85, 0.2},
+	"1B":      {0.95, 0.1},
+	"2B":      {0.95, 0.1},
+	"3B":      {0.95, 0.1},
+	"SS":      {0.95, 0.1},
+	"LF":      {0.95, 0.1},
+	"CF":      {0.95, 0.15},
+	"RF":      {0.95, 0.1},
+	"DH":      {0.95},
+}
 
-		gameStats := sim.statsGenerator(player, playerYearsOfExperience)
-		gameStats = sim.statMultiplier(player, playerYearsOfExperience, gameStats)
-
-		// Accumulate stats
-		yearlyStats.PassingAttempts += gameStats.PassingAttempts
-		yearlyStats.PassingCompletions += gameStats.PassingCompletions
-		yearlyStats.PassingInterceptions += gameStats.PassingInterceptions
-		yearlyStats.PassingTDs += gameStats.PassingTDs
-		yearlyStats.PassingYards += gameStats.PassingYards
-		yearlyStats.RushingAttempts += gameStats.RushingAttempts
-		yearlyStats.RushingYards += gameStats.RushingYards
-		yearlyStats.ReceivingYards += gameStats.ReceivingYards
-		yearlyStats.RushingTDs += gameStats.RushingTDs
-		yearlyStats.ReceivingReceptions += gameStats.ReceivingReceptions
-		yearlyStats.ReceivingTDs += gameStats.ReceivingTDs
-		yearlyStats.ReceivingTargets += gameStats.ReceivingTargets
-		yearlyStats.Fumbles += gameStats.Fumbles
-		yearlyStats.FumblesLost += gameStats.FumblesLost
+// snapShare returns the workload of the healthy player at depth index healthyRank (0 = starter).
+// Positions without a profile give every player a full workload.
+func snapShare(position string, healthyRank int) float64 {
+	shares, ok := snapShares[position]
+	if !ok {
+		return 1.0
 	}
+	if healthyRank >= len(shares) {
+		return 0
+	}
+	return shares[healthyRank]
+}
+
+// scaleGameStats shrinks a full game's stat line to a partial workload.
+// Every stat is scaled by the same share, so related stats keep their order (e.g. completions <= attempts).
+func scaleGameStats(stats FootballStats, share float64) FootballStats {
+	if share >= 1.0 {
+		return stats
+	}
+	scale := func(value int) int {
+		return int(math.Round(float64(value) * share))
+	}
+	return FootballStats{
+		PassingAttempts:       scale(stats.PassingAttempts),
+		PassingCompletions:    scale(stats.PassingCompletions),
+		PassingInterceptions:  scale(stats.PassingInterceptions),
+		PassingTDs:            scale(stats.PassingTDs),
+		PassingYards:          scale(stats.PassingYards),
+		RushingAttempts:       scale(stats.RushingAttempts),
+		RushingYards:          scale(stats.RushingYards),
+		ReceivingYards:        scale(stats.ReceivingYards),
+		RushingTDs:            scale(stats.RushingTDs),
+		ReceivingReceptions:   scale(stats.ReceivingReceptions),
+		ReceivingTDs:          scale(stats.ReceivingTDs),
+		ReceivingTargets:      scale(stats.ReceivingTargets),
+		Fumbles:               scale(stats.Fumbles),
+		FumblesLost:           scale(stats.FumblesLost),
+		FieldGoals:            scale(stats.FieldGoals),
+		FieldGoalsMade:        scale(stats.FieldGoalsMade),
+		FieldGoalsMissed:      scale(stats.FieldGoalsMissed),
+		FieldGoalsBlocked:     scale(stats.FieldGoalsBlocked),
+		FieldGoalsBlockedMade: scale(stats.FieldGoalsBlockedMade),
+		FieldGoalsUnder40:     scale(stats.FieldGoalsUnder40),
+		FieldGoalsMadeUnder40: scale(stats.FieldGoalsMadeUnder40),
+		FieldGoals40To49:      scale(stats.FieldGoals40To49),
+		FieldGoalsMade40To49:  scale(stats.FieldGoalsMade40To49),
+		FieldGoals50Plus:      scale(stats.FieldGoals50Plus),
+		FieldGoalsMade50Plus:  scale(stats.FieldGoalsMade50Plus),
+		ExtraPoints:           scale(stats.ExtraPoints),
+		ExtraPointsMade:       scale(stats.ExtraPointsMade),
+		ExtraPointsMissed:     scale(stats.ExtraPointsMissed),
+		Tackles:               scale(stats.Tackles),
+		AssistedTackles:       scale(stats.AssistedTackles),
+		Sacks:                 scale(stats.Sacks),
+		Interceptions:         scale(stats.Interceptions),
+		PassesDefended:        scale(stats.PassesDefended),
+		ForcedFumbles:         scale(stats.ForcedFumbles),
+	}
+}
 
-	return FootballYearlyStats{Total: yearlyStats}
+// CreateDepthChartCareers generates the careers of a position group together, so each season's
+// playing time is shared out by depth chart. group is ordered starter first, and the result holds
+// each player's career at the same index. A season only includes the players drafted by then.
+func (sim *CareerSimulator) CreateDepthChartCareers(group []Player) [][]PlayerYearlyStatsFootball {
+	return sim.walker().careers(group)
+}
+
+// SimulateYear walks through each game in a season for a player who is the only one at their position
+func (sim *CareerSimulator) SimulateYear(player Player, year int) FootballYearlyStats {
+	return sim.SimulateDepthChartYear([]Player{player}, year)[0]
+}
+
+// SimulateDepthChartYear walks through each game in a season for a position group ordered by
+// depth chart, starter first, handling injuries and accumulating each player's stats.
+// Every game the healthy players take the workload of their place on the depth chart, so a
+// backup steps into the starter's role while the starter is injured.
+// Each player plays at the age, experience and skill their aging curve gives them that season.
+// Games after the team's bye are played a week later.
+func (sim *CareerSimulator) SimulateDepthChartYear(group []Player, year int) []FootballYearlyStats {
+	return sim.walker().season(group, year)
 }
 
 // createPlayerCareer generates a player's full career using default settings
@@ -170,76 +294,91 @@ func createPlayerCareer(player Player) []PlayerYearlyStatsFootball {
 	return simulatedCareer
 }
 
-func rollForInjury(playerAge int, playerPosition string) (bool, int) {
-	injuryRate := 0.0
-	if playerAge < 25 {
-		injuryRate = 0.04
-	} else if playerAge < 30 {
-		injuryRate = 0.06
-	} else if playerAge < 35 {
-		injuryRate = 0.10
-	} else {
-		injuryRate = 0.12
-	}
+func rollForInjury(rng *rand.Rand, playerAge int, playerPosition string) (bool, int) {
+	injuryRate := fantasy.GameInjuryRate(playerAge, playerPosition)
 
-	switch playerPosition {
-	case "QB":
-		injuryRate = injuryRate * 0.5
-	case "RB":
-		injuryRate = injuryRate * 1
-	case "WR":
-		injuryRate = injuryRate * 0.8
-	case "TE":
-		injuryRate = injuryRate * 0.8
-	case "PK":
-		injuryRate = injuryRate * 0.25
-	}
-
-	wasInjured := rand.Float64() < injuryRate
+	wasInjured := rng.Float64() < injuryRate
 
 	injuryGameCount := 0
 	if wasInjured {
-		injuryGameCount = normalIntInRange(1, 20)
+		injuryGameCount = normalIntInRange(rng, 1, 20)
 	}
 
 	return wasInjured, injuryGameCount
 }
 
-func generatePlayerGameStats(player Player, yearsOfExperience int) FootballStats {
+// Injury severities, matching injury_severity_enum
+const (
+	InjuryMinor    = "MINOR"
+	InjuryModerate = "MODERATE"
+	InjurySevere   = "SEVERE"
+)
+
+// injurySeverity grades an injury by how many games it costs
+func injurySeverity(gamesMissed int) string {
+	switch {
+	case gamesMissed <= 2:
+		return InjuryMinor
+	case gamesMissed <= 6:
+		return InjuryModerate
+	default:
+		return InjurySevere
+	}
+}
+
+// injuryTypes lists the injuries that typically cost each severity's number of games
+var injuryTypes = map[string][]string{
+	InjuryMinor:    {"Ankle sprain", "Hamstring strain", "Concussion", "Back spasms", "Shoulder bruise", "Quad strain"},
+	InjuryModerate: {"High ankle sprain", "MCL sprain", "Broken hand", "Rib fracture", "Groin strain", "Turf toe"},
+	InjurySevere:   {"ACL tear", "Achilles tear", "Broken leg", "Lisfranc fracture", "Torn pectoral"},
+}
+
+func rollInjuryType(rng *rand.Rand, severity string) string {
+	types := injuryTypes[severity]
+	return types[rng.Intn(len(types))]
+}
+
+func generatePlayerGameStats(rng *rand.Rand, player Player, yearsOfExperience int) FootballStats {
 	switch player.Position {
 	case "QB":
-		return QuarterBackGameStatsGenerator().generate(player, yearsOfExperience)
+		return QuarterBackGameStatsGenerator().generate(rng, player, yearsOfExperience)
 	case "RB":
-		return RunningBackGameStatsGenerator().generate(player, yearsOfExperience)
+		return RunningBackGameStatsGenerator().generate(rng, player, yearsOfExperience)
 	case "WR":
-		return WideReceiverGameStatsGenerator().generate(player, yearsOfExperience)
+		return WideReceiverGameStatsGenerator().generate(rng, player, yearsOfExperience)
 	case "TE":
-		return TightEndGameStatsGenerator().generate(player, yearsOfExperience)
+		return TightEndGameStatsGenerator().generate(rng, player, yearsOfExperience)
 	case "PK":
-		return KickerGameStatsGenerator().generate(player, yearsOfExperience)
+		return KickerGameStatsGenerator().generate(rng, player, yearsOfExperience)
+	case "DL":
+		return DefensiveLineGameStatsGenerator().generate(rng, player, yearsOfExperience)
+	case "LB":
+		return LinebackerGameStatsGenerator().generate(rng, player, yearsOfExperience)
+	case "DB":
+		return DefensiveBackGameStatsGenerator().generate(rng, player, yearsOfExperience)
 	default:
 		return FootballStats{}
 	}
 }
 
 type PlayerGameStatsGenerator interface {
-	generate(player Player, yearsOfExperience int) FootballStats
+	generate(rng *rand.Rand, player Player, yearsOfExperience int) FootballStats
 }
 
 type quarterBackGenerator struct{}
 
-func (q quarterBackGenerator) generate(player Player, yearsOfExperience int) FootballStats {
-	passingTouchdowns := normalIntInRangeWithMeanBias(0, 4, TD_MULTIPLIER)
-	passingInterceptions := normalIntInRange(0, 2)
-	passingAttempts := normalIntInRange(25, 45)
-	passingCompletions := normalIntInRange(15, 32)
-	passingAverage := normalIntInRange(8, 14)
+func (q quarterBackGenerator) generate(rng *rand.Rand, player Player, yearsOfExperience int) FootballStats {
+	passingTouchdowns := normalIntInRangeWithMeanBias(rng, 0, 4, TD_MULTIPLIER)
+	passingInterceptions := normalIntInRange(rng, 0, 2)
+	passingAttempts := normalIntInRange(rng, 25, 45)
+	passingCompletions := roundToNearestInt(float64(passingAttempts) * normalInRange(rng, 0.55, 0.75))
+	passingAverage := normalIntInRange(rng, 8, 14)
 	passingYards := nornmalizeYardsPerPlay(passingCompletions*passingAverage, passingCompletions, 99)
-	rushingAttempts := normalIntInRange(1, 6)
-	rushingYards := nornmalizeYardsPerPlay(normalIntInRange(5, 35), rushingAttempts, 99)
-	rushingTDs := normalIntInRangeWithMeanBias(0, 1, TD_MULTIPLIER)
-	fumbles := normalIntInRangeWithMeanBias(0, 2, FUMBLES_LOST_MULTIPLIER)
-	fumblesLost := normalIntInRange(0, fumbles)
+	rushingAttempts := normalIntInRange(rng, 1, 6)
+	rushingYards := nornmalizeYardsPerPlay(normalIntInRange(rng, 5, 35), rushingAttempts, 99)
+	rushingTDs := normalIntInRangeWithMeanBias(rng, 0, 1, TD_MULTIPLIER)
+	fumbles := normalIntInRangeWithMeanBias(rng, 0, 2, FUMBLES_LOST_MULTIPLIER)
+	fumblesLost := normalIntInRange(rng, 0, fumbles)
 
 	return FootballStats{
 		PassingAttempts:       passingAttempts,
@@ -272,18 +411,18 @@ func QuarterBackGameStatsGenerator() PlayerGameStatsGenerator {
 
 type runningBackGenerator struct{}
 
-func (r runningBackGenerator) generate(player Player, yearsOfExperience int) FootballStats {
-	rushingAttempts := normalIntInRange(12, 25)
-	rushingAverage := normalIntInRange(4, 6)
+func (r runningBackGenerator) generate(rng *rand.Rand, player Player, yearsOfExperience int) FootballStats {
+	rushingAttempts := normalIntInRange(rng, 12, 25)
+	rushingAverage := normalIntInRange(rng, 4, 6)
 	rushingYards := nornmalizeYardsPerPlay(rushingAttempts*rushingAverage, rushingAttempts, 99)
-	rushingTDs := normalIntInRangeWithMeanBias(0, 2, TD_MULTIPLIER)
-	fumbles := normalIntInRangeWithMeanBias(0, 1, FUMBLES_LOST_MULTIPLIER)
-	fumblesLost := normalIntInRange(0, fumbles)
-	receivingReceptions := normalIntInRange(2, 6)
-	receivingTargets := normalIntInRange(3, 8)
-	receivingAverage := normalIntInRange(6, 12)
+	rushingTDs := normalIntInRangeWithMeanBias(rng, 0, 2, TD_MULTIPLIER)
+	fumbles := normalIntInRangeWithMeanBias(rng, 0, 1, FUMBLES_LOST_MULTIPLIER)
+	fumblesLost := normalIntInRange(rng, 0, fumbles)
+	receivingTargets := normalIntInRange(rng, 3, 8)
+	receivingReceptions := roundToNearestInt(float64(receivingTargets) * normalInRange(rng, 0.6, 0.9))
+	receivingAverage := normalIntInRange(rng, 6, 12)
 	receivingYards := nornmalizeYardsPerPlay(receivingReceptions*receivingAverage, receivingReceptions, 99)
-	receivingTDs := normalIntInRangeWithMeanBias(0, 1, TD_MULTIPLIER)
+	receivingTDs := normalIntInRangeWithMeanBias(rng, 0, 1, TD_MULTIPLIER)
 
 	return FootballStats{
 		PassingAttempts:       0,
@@ -317,18 +456,18 @@ func RunningBackGameStatsGenerator() PlayerGameStatsGenerator {
 
 type wideReceiverGenerator struct{}
 
-func (w wideReceiverGenerator) generate(player Player, yearsOfExperience int) FootballStats {
-	receivingReceptions := normalIntInRange(4, 10)
-	receivingTargets := normalIntInRange(6, 14)
-	receivingAverage := normalIntInRange(12, 18)
+func (w wideReceiverGenerator) generate(rng *rand.Rand, player Player, yearsOfExperience int) FootballStats {
+	receivingTargets := normalIntInRange(rng, 6, 14)
+	receivingReceptions := roundToNearestInt(float64(receivingTargets) * normalInRange(rng, 0.55, 0.8))
+	receivingAverage := normalIntInRange(rng, 12, 18)
 	receivingYards := nornmalizeYardsPerPlay(receivingReceptions*receivingAverage, receivingReceptions, 99)
-	rushingAttempts := normalIntInRange(0, 2)
-	rushingAverage := normalIntInRange(5, 14)
+	rushingAttempts := normalIntInRange(rng, 0, 2)
+	rushingAverage := normalIntInRange(rng, 5, 14)
 	rushingYards := nornmalizeYardsPerPlay(rushingAttempts*rushingAverage, rushingAttempts, 99)
-	rushingTDs := normalIntInRangeWithMeanBias(0, 1, TD_MULTIPLIER)
-	receivingTDs := normalIntInRangeWithMeanBias(0, 2, TD_MULTIPLIER)
-	fumbles := normalIntInRangeWithMeanBias(0, 1, FUMBLES_LOST_MULTIPLIER)
-	fumblesLost := normalIntInRange(0, fumbles)
+	rushingTDs := min(normalIntInRangeWithMeanBias(rng, 0, 1, TD_MULTIPLIER), rushingAttempts)
+	receivingTDs := normalIntInRangeWithMeanBias(rng, 0, 2, TD_MULTIPLIER)
+	fumbles := normalIntInRangeWithMeanBias(rng, 0, 1, FUMBLES_LOST_MULTIPLIER)
+	fumblesLost := normalIntInRange(rng, 0, fumbles)
 
 	return FootballStats{
 		PassingAttempts:       0,
@@ -362,18 +501,18 @@ func WideReceiverGameStatsGenerator() PlayerGameStatsGenerator {
 
 type tightEndGenerator struct{}
 
-func (te tightEndGenerator) generate(player Player, yearsOfExperience int) FootballStats {
-	receivingReceptions := normalIntInRange(3, 8)
-	receivingTargets := normalIntInRange(5, 11)
-	receivingAverage := normalIntInRange(10, 14)
+func (te tightEndGenerator) generate(rng *rand.Rand, player Player, yearsOfExperience int) FootballStats {
+	receivingTargets := normalIntInRange(rng, 5, 11)
+	receivingReceptions := roundToNearestInt(float64(receivingTargets) * normalInRange(rng, 0.6, 0.85))
+	receivingAverage := normalIntInRange(rng, 10, 14)
 	receivingYards := nornmalizeYardsPerPlay(receivingReceptions*receivingAverage, receivingReceptions, 99)
-	rushingAttempts := normalIntInRange(0, 1)
-	rushingAverage := normalIntInRange(4, 10)
+	rushingAttempts := normalIntInRange(rng, 0, 1)
+	rushingAverage := normalIntInRange(rng, 4, 10)
 	rushingYards := nornmalizeYardsPerPlay(rushingAttempts*rushingAverage, rushingAttempts, 99)
-	rushingTDs := normalIntInRangeWithMeanBias(0, 1, TD_MULTIPLIER)
-	receivingTDs := normalIntInRangeWithMeanBias(0, 1, TD_MULTIPLIER)
-	fumbles := normalIntInRangeWithMeanBias(0, 1, FUMBLES_LOST_MULTIPLIER)
-	fumblesLost := normalIntInRange(0, fumbles)
+	rushingTDs := min(normalIntInRangeWithMeanBias(rng, 0, 1, TD_MULTIPLIER), rushingAttempts)
+	receivingTDs := normalIntInRangeWithMeanBias(rng, 0, 1, TD_MULTIPLIER)
+	fumbles := normalIntInRangeWithMeanBias(rng, 0, 1, FUMBLES_LOST_MULTIPLIER)
+	fumblesLost := normalIntInRange(rng, 0, fumbles)
 
 	return FootballStats{
 		PassingAttempts:       0,
@@ -407,110 +546,94 @@ func TightEndGameStatsGenerator() PlayerGameStatsGenerator {
 
 type kickerGenerator struct{}
 
-func (k kickerGenerator) generate(player Player, yearsOfExperience int) FootballStats {
-	fieldGoals := normalIntInRange(0, 50)
-	fieldGoalsMade := normalIntInRange(0, fieldGoals)
-	fieldGoalsMissed := fieldGoals - fieldGoalsMade
-	fieldGoalsBlocked := normalIntInRange(0, 5)
-	fieldGoalsBlockedMade := normalIntInRange(0, fieldGoalsBlocked)
-	extraPoints := normalIntInRange(0, 2)
-	extraPointsMade := normalIntInRange(0, extraPoints)
-	extraPointsMissed := extraPoints - extraPointsMade
-
-	return FootballStats{
-		PassingAttempts:       0,
-		PassingCompletions:    0,
-		PassingInterceptions:  0,
-		PassingTDs:            0,
-		PassingYards:          0,
-		RushingAttempts:       0,
-		RushingYards:          0,
-		RushingTDs:            0,
-		ReceivingReceptions:   0,
-		ReceivingTDs:          0,
-		ReceivingTargets:      0,
-		ReceivingYards:        0,
-		Fumbles:               0,
-		FumblesLost:           0,
-		FieldGoals:            fieldGoals,
-		FieldGoalsMade:        fieldGoalsMade,
-		FieldGoalsMissed:      fieldGoalsMissed,
-		FieldGoalsBlocked:     fieldGoalsBlocked,
-		FieldGoalsBlockedMade: fieldGoalsBlockedMade,
-		ExtraPoints:           extraPoints,
-		ExtraPointsMade:       extraPointsMade,
-		ExtraPointsMissed:     extraPointsMissed,
-	}
+// generate kicks for an average offense. A team's kickers are given the kicks their own offense set
+// up once it has played; see kickTeamSeasons.
+func (k kickerGenerator) generate(rng *rand.Rand, player Player, yearsOfExperience int) FootballStats {
+	return generateKickerGameStats(player.Skill, averageTeamTouchdowns(rng), rng)
 }
 
 func KickerGameStatsGenerator() PlayerGameStatsGenerator {
 	return kickerGenerator{}
 }
 
-func multiplyStatByPlayerSkill(player Player, yearsOfExperience int, stat int) int {
-	return int(float64(stat) * (1 + float64(yearsOfExperience)/100) * player.Skill)
+// multiplyStatByPlayerSkill scales a stat by the player's skill. The skill is expected to already
+// reflect the player's age and experience in the season being simulated (see playerInSeason).
+func multiplyStatByPlayerSkill(player Player, stat int) int {
+	return int(float64(stat) * player.Skill)
 }
 
-func multiplyYearlyStatsByPlayerSkill(player Player, yearsofExperience int, stats FootballStats) FootballStats {
+func multiplyYearlyStatsByPlayerSkill(player Player, stats FootballStats) FootballStats {
 	adjustedStats := FootballStats{
-		PassingAttempts:       multiplyStatByPlayerSkill(player, yearsofExperience, stats.PassingAttempts),
-		PassingCompletions:    multiplyStatByPlayerSkill(player, yearsofExperience, stats.PassingCompletions),
-		PassingInterceptions:  multiplyStatByPlayerSkill(player, yearsofExperience, stats.PassingInterceptions),
-		PassingTDs:            multiplyStatByPlayerSkill(player, yearsofExperience, stats.PassingTDs),
-		PassingYards:          multiplyStatByPlayerSkill(player, yearsofExperience, stats.PassingYards),
-		RushingAttempts:       multiplyStatByPlayerSkill(player, yearsofExperience, stats.RushingAttempts),
-		RushingYards:          multiplyStatByPlayerSkill(player, yearsofExperience, stats.RushingYards),
-		RushingTDs:            multiplyStatByPlayerSkill(player, yearsofExperience, stats.RushingTDs),
-		ReceivingReceptions:   multiplyStatByPlayerSkill(player, yearsofExperience, stats.ReceivingReceptions),
-		ReceivingTDs:          multiplyStatByPlayerSkill(player, yearsofExperience, stats.ReceivingTDs),
-		ReceivingTargets:      multiplyStatByPlayerSkill(player, yearsofExperience, stats.ReceivingTargets),
-		ReceivingYards:        multiplyStatByPlayerSkill(player, yearsofExperience, stats.ReceivingYards),
+		PassingAttempts:       multiplyStatByPlayerSkill(player, stats.PassingAttempts),
+		PassingCompletions:    multiplyStatByPlayerSkill(player, stats.PassingCompletions),
+		PassingInterceptions:  multiplyStatByPlayerSkill(player, stats.PassingInterceptions),
+		PassingTDs:            multiplyStatByPlayerSkill(player, stats.PassingTDs),
+		PassingYards:          multiplyStatByPlayerSkill(player, stats.PassingYards),
+		RushingAttempts:       multiplyStatByPlayerSkill(player, stats.RushingAttempts),
+		RushingYards:          multiplyStatByPlayerSkill(player, stats.RushingYards),
+		RushingTDs:            multiplyStatByPlayerSkill(player, stats.RushingTDs),
+		ReceivingReceptions:   multiplyStatByPlayerSkill(player, stats.ReceivingReceptions),
+		ReceivingTDs:          multiplyStatByPlayerSkill(player, stats.ReceivingTDs),
+		ReceivingTargets:      multiplyStatByPlayerSkill(player, stats.ReceivingTargets),
+		ReceivingYards:        multiplyStatByPlayerSkill(player, stats.ReceivingYards),
 		Fumbles:               stats.Fumbles,     // Don't scale fumbles by skill - they're random events
 		FumblesLost:           stats.FumblesLost, // Don't scale fumbles lost by skill
-		FieldGoals:            multiplyStatByPlayerSkill(player, yearsofExperience, stats.FieldGoals),
-		FieldGoalsMade:        multiplyStatByPlayerSkill(player, yearsofExperience, stats.FieldGoalsMade),
-		FieldGoalsMissed:      multiplyStatByPlayerSkill(player, yearsofExperience, stats.FieldGoalsMissed),
-		FieldGoalsBlocked:     multiplyStatByPlayerSkill(player, yearsofExperience, stats.FieldGoalsBlocked),
-		FieldGoalsBlockedMade: multiplyStatByPlayerSkill(player, yearsofExperience, stats.FieldGoalsBlockedMade),
-		ExtraPoints:           multiplyStatByPlayerSkill(player, yearsofExperience, stats.ExtraPoints),
-		ExtraPointsMade:       multiplyStatByPlayerSkill(player, yearsofExperience, stats.ExtraPointsMade),
-		ExtraPointsMissed:     multiplyStatByPlayerSkill(player, yearsofExperience, stats.ExtraPointsMissed),
+		FieldGoals:            stats.FieldGoals,
+		FieldGoalsMade:        stats.FieldGoalsMade,
+		FieldGoalsMissed:      stats.FieldGoalsMissed,
+		FieldGoalsBlocked:     stats.FieldGoalsBlocked,
+		FieldGoalsBlockedMade: stats.FieldGoalsBlockedMade,
+		FieldGoalsUnder40:     stats.FieldGoalsUnder40,
+		FieldGoalsMadeUnder40: stats.FieldGoalsMadeUnder40,
+		FieldGoals40To49:      stats.FieldGoals40To49,
+		FieldGoalsMade40To49:  stats.FieldGoalsMade40To49,
+		FieldGoals50Plus:      stats.FieldGoals50Plus,
+		FieldGoalsMade50Plus:  stats.FieldGoalsMade50Plus,
+		ExtraPoints:           stats.ExtraPoints,
+		ExtraPointsMade:       stats.ExtraPointsMade,
+		ExtraPointsMissed:     stats.ExtraPointsMissed, // Kicks are already weighted by skill in the kicker model
+		Tackles:               multiplyStatByPlayerSkill(player, stats.Tackles),
+		AssistedTackles:       multiplyStatByPlayerSkill(player, stats.AssistedTackles),
+		PassesDefended:        multiplyStatByPlayerSkill(player, stats.PassesDefended),
+		Sacks:                 stats.Sacks,         // Big plays are already weighted by skill in the generators
+		Interceptions:         stats.Interceptions, // so scaling them again would all but erase them
+		ForcedFumbles:         stats.ForcedFumbles,
 	}
 	return adjustedStats
 }
 
 // stats utils
 
-func normalInRange(low, high float64) float64 {
+func normalInRange(rng *rand.Rand, low, high float64) float64 {
 	mean := (low + high) / 2
 	// Use 3 standard deviations to cover the range (99.7% of values)
 	stdDev := (high - low) / 6
-	result := rand.NormFloat64()*stdDev + mean
+	result := rng.NormFloat64()*stdDev + mean
 	return clampFloat(result, low, high)
 }
 
-func normalIntInRange(low, high int) int {
-	result := roundToNearestInt(normalInRange(float64(low), float64(high)+0.5))
+func normalIntInRange(rng *rand.Rand, low, high int) int {
+	result := roundToNearestInt(normalInRange(rng, float64(low), float64(high)+0.5))
 	return clampInt(result, low, high)
 
 }
 
 // normalInRangeWithMeanBias generates a normally distributed value with a custom mean
 // meanBias: 0.0 = mean at low, 0.5 = mean at middle (default), 1.0 = mean at high
-func normalInRangeWithMeanBias(low, high float64, meanBias float64) float64 {
+func normalInRangeWithMeanBias(rng *rand.Rand, low, high float64, meanBias float64) float64 {
 	meanBias = clampFloat(meanBias, 0, 1)
 
 	mean := low + (high-low)*meanBias
 	// Use 3 standard deviations to cover the range (99.7% of values)
 	stdDev := (high - low) / 6
-	result := rand.NormFloat64()*stdDev + mean
+	result := rng.NormFloat64()*stdDev + mean
 	return clampFloat(result, low, high)
 }
 
 // normalIntInRangeWithMeanBias generates a normally distributed int with a custom mean bias
 // meanBias: 0.0 = mean at low, 0.5 = mean at middle (default), 1.0 = mean at high
-func normalIntInRangeWithMeanBias(low, high int, meanBias float64) int {
-	result := roundToNearestInt(normalInRangeWithMeanBias(float64(low), float64(high)+0.5, meanBias))
+func normalIntInRangeWithMeanBias(rng *rand.Rand, low, high int, meanBias float64) int {
+	result := roundToNearestInt(normalInRangeWithMeanBias(rng, float64(low), float64(high)+0.5, meanBias))
 	// Clamp to ensure we stay within the specified integer bounds
 	return clampInt(result, low, high)
 }
@@ -1,11 +1,14 @@
 package main
 
 import (
+	"math/rand"
+	"slices"
 	"testing"
 	"time"
 )
 
 func TestRollForInjury(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
 	tests := []struct {
 		name           string
 		playerAge      int
@@ -26,7 +29,7 @@ func TestRollForInjury(t *testing.T) {
 			totalGames := 0
 
 			for range tt.iterations {
-				injured, games := rollForInjury(tt.playerAge, tt.playerPosition)
+				injured, games := rollForInjury(rng, tt.playerAge, tt.playerPosition)
 				if injured {
 					injuredCount++
 					totalGames += games
@@ -59,6 +62,7 @@ func TestRollForInjury(t *testing.T) {
 }
 
 func TestRollForInjuryPositionRates(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
 	// Test that position affects injury rates correctly
 	iterations := 10000
 	age := 28 // Middle age for consistent comparison
@@ -69,7 +73,7 @@ func TestRollForInjuryPositionRates(t *testing.T) {
 	for _, pos := range positions {
 		injuredCount := 0
 		for range iterations {
-			injured, _ := rollForInjury(age, pos)
+			injured, _ := rollForInjury(rng, age, pos)
 			if injured {
 				injuredCount++
 			}
@@ -101,7 +105,7 @@ func TestNewCareerSimulator(t *testing.T) {
 		mockStatsGenerator := func(player Player, yoe int) FootballStats {
 			return FootballStats{PassingYards: 100}
 		}
-		mockStatMultiplier := func(player Player, yoe int, stats FootballStats) FootballStats {
+		mockStatMultiplier := func(player Player, stats FootballStats) FootballStats {
 			return stats
 		}
 
@@ -154,7 +158,7 @@ func TestCareerSimulatorCreateCareer(t *testing.T) {
 			GamesPerSeason: 18,
 			InjuryRoller:   func(age int, position string) (bool, int) { return false, 0 },
 			StatsGenerator: func(player Player, yoe int) FootballStats { return FootballStats{} },
-			StatMultiplier: func(player Player, yoe int, stats FootballStats) FootballStats { return stats },
+			StatMultiplier: func(player Player, stats FootballStats) FootballStats { return stats },
 		}
 
 		sim := NewCareerSimulator(cfg)
@@ -191,7 +195,7 @@ func TestCareerSimulatorCreateCareer(t *testing.T) {
 			GamesPerSeason: 18,
 			InjuryRoller:   func(age int, position string) (bool, int) { return false, 0 },
 			StatsGenerator: func(player Player, yoe int) FootballStats { return FootballStats{} },
-			StatMultiplier: func(player Player, yoe int, stats FootballStats) FootballStats { return stats },
+			StatMultiplier: func(player Player, stats FootballStats) FootballStats { return stats },
 		}
 
 		sim := NewCareerSimulator(cfg)
@@ -232,9 +236,9 @@ func TestCareerSimulatorSimulateYear(t *testing.T) {
 			InjuryRoller:   func(age int, position string) (bool, int) { return false, 0 },
 			StatsGenerator: func(player Player, yoe int) FootballStats {
 				gamesPlayed++
-				return FootballStats{PassingYards: 250, PassingTDs: 2}
+				return FootballStats{PassingAttempts: 32, PassingCompletions: 21, PassingYards: 250, PassingTDs: 2}
 			},
-			StatMultiplier: func(player Player, yoe int, stats FootballStats) FootballStats {
+			StatMultiplier: func(player Player, stats FootballStats) FootballStats {
 				return stats
 			},
 		}
@@ -267,6 +271,19 @@ func TestCareerSimulatorSimulateYear(t *testing.T) {
 		if yearStats.Total.PassingTDs != expectedTDs {
 			t.Errorf("Expected %d passing TDs, got %d", expectedTDs, yearStats.Total.PassingTDs)
 		}
+
+		// Every game should have its own weekly line, numbered from 1
+		if len(yearStats.Weeks) != 18 {
+			t.Fatalf("Expected 18 weekly stat lines, got %d", len(yearStats.Weeks))
+		}
+		for i, week := range yearStats.Weeks {
+			if week.Week != i+1 {
+				t.Errorf("Expected week %d at index %d, got %d", i+1, i, week.Week)
+			}
+			if week.Stats.PassingYards != 250 {
+				t.Errorf("Expected 250 passing yards in week %d, got %d", week.Week, week.Stats.PassingYards)
+			}
+		}
 	})
 
 	t.Run("season with injury", func(t *testing.T) {
@@ -288,7 +305,7 @@ func TestCareerSimulatorSimulateYear(t *testing.T) {
 				gamesPlayed++
 				return FootballStats{PassingYards: 250}
 			},
-			StatMultiplier: func(player Player, yoe int, stats FootballStats) FootballStats {
+			StatMultiplier: func(player Player, stats FootballStats) FootballStats {
 				return stats
 			},
 		}
@@ -315,7 +332,296 @@ func TestCareerSimulatorSimulateYear(t *testing.T) {
 		if yearStats.Total.PassingYards != expectedYards {
 			t.Errorf("Expected %d passing yards, got %d", expectedYards, yearStats.Total.PassingYards)
 		}
+
+		// Missed games should leave gaps in the weekly lines
+		if len(yearStats.Weeks) != 13 {
+			t.Fatalf("Expected 13 weekly stat lines, got %d", len(yearStats.Weeks))
+		}
+		for _, week := range yearStats.Weeks {
+			if week.Week > 11 && week.Week <= 16 {
+				t.Errorf("Expected no stat line for injured week %d", week.Week)
+			}
+		}
+	})
+
+	t.Run("season with bye week", func(t *testing.T) {
+		cfg := YearSimulatorConfig{
+			Clock:          MockClock{mockTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+			GamesPerSeason: 17,
+			ByeWeeks:       map[string]int{"team-1": 7},
+			InjuryRoller:   func(age int, position string) (bool, int) { return false, 0 },
+			StatsGenerator: func(player Player, yoe int) FootballStats {
+				return FootballStats{PassingYards: 250}
+			},
+			StatMultiplier: func(player Player, stats FootballStats) FootballStats {
+				return stats
+			},
+		}
+
+		sim := NewCareerSimulator(cfg)
+		player := Player{ID: "player-1", TeamID: "team-1", DraftYear: 2020, Age: 27, Position: "QB", Skill: 0.8}
+
+		yearStats := sim.SimulateYear(player, 2025)
+
+		if len(yearStats.Weeks) != 17 {
+			t.Fatalf("Expected 17 weekly stat lines, got %d", len(yearStats.Weeks))
+		}
+		// Games before the bye keep their week, later games shift back a week
+		for i, week := range yearStats.Weeks {
+			expected := i + 1
+			if expected >= 7 {
+				expected++
+			}
+			if week.Week != expected {
+				t.Errorf("Expected week %d at index %d, got %d", expected, i, week.Week)
+			}
+		}
+	})
+}
+
+func TestSnapShare(t *testing.T) {
+	tests := []struct {
+		name        string
+		position    string
+		healthyRank int
+		expected    float64
+	}{
+		{"starting QB", "QB", 0, 1.0},
+		{"backup QB mop-up", "QB", 1, 0.05},
+		{"third QB inactive", "QB", 2, 0},
+		{"third WR starts", "WR", 2, 1.0},
+		{"unknown position", "XX", 5, 1.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := snapShare(tt.position, tt.healthyRank); result != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestScaleGameStats(t *testing.T) {
+	stats := FootballStats{PassingAttempts: 40, PassingCompletions: 25, PassingYards: 300, PassingTDs: 2}
+
+	if full := scaleGameStats(stats, 1.0); full != stats {
+		t.Errorf("Expected a full share to keep the stat line, got %+v", full)
+	}
+
+	scaled := scaleGameStats(stats, 0.1)
+	expected := FootballStats{PassingAttempts: 4, PassingCompletions: 3, PassingYards: 30, PassingTDs: 0}
+	if scaled != expected {
+		t.Errorf("Expected %+v, got %+v", expected, scaled)
+	}
+}
+
+func TestCareerSimulatorSimulateDepthChartYear(t *testing.T) {
+	starter := Player{ID: "qb-1", Position: "QB", DraftYear: 2020, Age: 27}
+	backup := Player{ID: "qb-2", Position: "QB", DraftYear: 2022, Age: 25}
+	third := Player{ID: "qb-3", Position: "QB", DraftYear: 2024, Age: 23}
+
+	newSim := func(injuryRoller func(int, string) (bool, int)) *CareerSimulator {
+		return NewCareerSimulator(YearSimulatorConfig{
+			Clock:          MockClock{mockTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+			GamesPerSeason: 10,
+			InjuryRoller:   injuryRoller,
+			StatsGenerator: func(player Player, yoe int) FootballStats {
+				return FootballStats{PassingAttempts: 40, PassingYards: 300}
+			},
+			StatMultiplier: func(player Player, stats FootballStats) FootballStats {
+				return stats
+			},
+		})
+	}
+
+	t.Run("healthy depth chart", func(t *testing.T) {
+		sim := newSim(func(age int, position string) (bool, int) { return false, 0 })
+
+		results := sim.SimulateDepthChartYear([]Player{starter, backup, third}, 2024)
+
+		if results[0].Total.PassingAttempts != 400 {
+			t.Errorf("Expected the starter to throw 400 passes, got %d", results[0].Total.PassingAttempts)
+		}
+		if results[1].Total.PassingAttempts != 20 {
+			t.Errorf("Expected the backup to throw 20 mop-up passes, got %d", results[1].Total.PassingAttempts)
+		}
+		if len(results[2].Weeks) != 0 {
+			t.Errorf("Expected the third QB to be inactive, got %d games", len(results[2].Weeks))
+		}
+	})
+
+	t.Run("backup steps in for an injured starter", func(t *testing.T) {
+		rolls := 0
+		sim := newSim(func(age int, position string) (bool, int) {
+			rolls++
+			// The starter is hurt in the first game and misses the next three
+			return rolls == 1, 3
+		})
+
+		results := sim.SimulateDepthChartYear([]Player{starter, backup, third}, 2024)
+
+		if len(results[0].Weeks) != 7 {
+			t.Errorf("Expected the starter to play 7 games, got %d", len(results[0].Weeks))
+		}
+		for _, week := range results[1].Weeks {
+			expected := 2
+			if week.Week >= 2 && week.Week <= 4 {
+				expected = 40
+			}
+			if week.Stats.PassingAttempts != expected {
+				t.Errorf("Expected the backup to throw %d passes in week %d, got %d", expected, week.Week, week.Stats.PassingAttempts)
+			}
+		}
+		if len(results[2].Weeks) != 3 {
+			t.Errorf("Expected the third QB to play mop-up while the starter was out, got %d games", len(results[2].Weeks))
+		}
+	})
+}
+
+func TestCareerSimulatorRecordsInjuries(t *testing.T) {
+	rolls := 0
+	sim := NewCareerSimulator(YearSimulatorConfig{
+		Clock:          MockClock{mockTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		GamesPerSeason: 10,
+		ByeWeeks:       map[string]int{"team-1": 3},
+		InjuryRoller: func(age int, position string) (bool, int) {
+			rolls++
+			switch rolls {
+			case 2:
+				return true, 1
+			case 8:
+				return true, 8
+			}
+			return false, 0
+		},
+		InjuryTypeRoller: func(severity string) string { return severity + " injury" },
+		StatsGenerator:   func(player Player, yoe int) FootballStats { return FootballStats{} },
+		StatMultiplier:   func(player Player, stats FootballStats) FootballStats { return stats },
+	})
+	player := Player{ID: "rb-1", TeamID: "team-1", Position: "RB", DraftYear: 2020, Age: 26}
+
+	stats := sim.SimulateYear(player, 2024)
+
+	// Hurt in game 2 (week 2) and game 9 (week 10, after the bye), the second one lasting past the season
+	expected := []Injury{
+		{Week: 2, GamesMissed: 1, Type: "MINOR injury", Severity: InjuryMinor},
+		{Week: 10, GamesMissed: 8, Type: "SEVERE injury", Severity: InjurySevere},
+	}
+	if len(stats.Injuries) != len(expected) {
+		t.Fatalf("Expected %d injuries, got %+v", len(expected), stats.Injuries)
+	}
+	for i, injury := range stats.Injuries {
+		if injury != expected[i] {
+			t.Errorf("Expected injury %d to be %+v, got %+v", i, expected[i], injury)
+		}
+	}
+	if !stats.OutAtSeasonEnd {
+		t.Error("Expected the player to still be out at the end of the season")
+	}
+}
+
+func TestInjurySeverity(t *testing.T) {
+	tests := []struct {
+		gamesMissed int
+		expected    string
+	}{
+		{1, InjuryMinor},
+		{2, InjuryMinor},
+		{3, InjuryModerate},
+		{6, InjuryModerate},
+		{7, InjurySevere},
+		{20, InjurySevere},
+	}
+
+	for _, tt := range tests {
+		if result := injurySeverity(tt.gamesMissed); result != tt.expected {
+			t.Errorf("Expected %d games missed to be %s, got %s", tt.gamesMissed, tt.expected, result)
+		}
+	}
+}
+
+func TestRollInjuryType(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for severity, types := range injuryTypes {
+		injuryType := rollInjuryType(rng, severity)
+		if !slices.Contains(types, injuryType) {
+			t.Errorf("Expected a %s injury type, got %q", severity, injuryType)
+		}
+	}
+}
+
+func TestCareerSimulatorSeasonExperienceAndSkill(t *testing.T) {
+	var seen []Player
+	sim := NewCareerSimulator(YearSimulatorConfig{
+		Clock:          MockClock{mockTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		GamesPerSeason: 1,
+		InjuryRoller:   func(age int, position string) (bool, int) { return false, 0 },
+		StatsGenerator: func(player Player, yoe int) FootballStats {
+			seen = append(seen, player)
+			return FootballStats{}
+		},
+		StatMultiplier: func(player Player, stats FootballStats) FootballStats { return stats },
+	})
+	player := Player{ID: "rb-1", Position: "RB", Age: 29, DraftYear: 2021, Skill: 0.6}
+
+	career := sim.CreateCareer(player)
+
+	if len(seen) != 4 {
+		t.Fatalf("Expected 4 simulated seasons, got %d", len(seen))
+	}
+	for i, seasonPlayer := range seen {
+		if seasonPlayer.YearsOfExperience != i {
+			t.Errorf("Expected %d years of experience in season %d, got %d", i, i, seasonPlayer.YearsOfExperience)
+		}
+		if career[i].Age != seasonPlayer.Age || career[i].Skill != seasonPlayer.Skill {
+			t.Errorf("Expected season %d snapshot to match the simulated player, got age %d skill %v", i, career[i].Age, career[i].Skill)
+		}
+	}
+
+	// A running back past his peak was better when he was younger
+	if career[0].Age != 25 || career[0].Skill <= player.Skill {
+		t.Errorf("Expected a better 25 year old, got age %d skill %v", career[0].Age, career[0].Skill)
+	}
+}
+
+func TestCareerSimulatorCreateDepthChartCareers(t *testing.T) {
+	sim := NewCareerSimulator(YearSimulatorConfig{
+		Clock:          MockClock{mockTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		GamesPerSeason: 4,
+		InjuryRoller:   func(age int, position string) (bool, int) { return false, 0 },
+		StatsGenerator: func(player Player, yoe int) FootballStats {
+			return FootballStats{RushingAttempts: 20}
+		},
+		StatMultiplier: func(player Player, stats FootballStats) FootballStats {
+			return stats
+		},
 	})
+	group := []Player{
+		{ID: "rb-1", Position: "RB", DraftYear: 2023},
+		{ID: "rb-2", Position: "RB", DraftYear: 2021},
+		{ID: "rb-3", Position: "RB", DraftYear: 2025},
+	}
+
+	careers := sim.CreateDepthChartCareers(group)
+
+	if len(careers[0]) != 2 || careers[0][0].Year != 2023 {
+		t.Fatalf("Expected rb-1 to have the 2023 and 2024 seasons, got %+v", careers[0])
+	}
+	if len(careers[1]) != 4 || careers[1][0].Year != 2021 {
+		t.Fatalf("Expected rb-2 to have the 2021 to 2024 seasons, got %+v", careers[1])
+	}
+	if len(careers[2]) != 1 || careers[2][0].Year != 2025 || careers[2][0].Stats.Total != (FootballStats{}) {
+		t.Fatalf("Expected rb-3 to have an empty rookie season, got %+v", careers[2])
+	}
+
+	// rb-2 started alone until rb-1 arrived and took over the lead role
+	if attempts := careers[1][0].Stats.Total.RushingAttempts; attempts != 80 {
+		t.Errorf("Expected rb-2 to carry 80 times in 2021, got %d", attempts)
+	}
+	if attempts := careers[1][2].Stats.Total.RushingAttempts; attempts != 28 {
+		t.Errorf("Expected rb-2 to carry 28 times as a backup in 2023, got %d", attempts)
+	}
 }
 
 func TestCreatePlayerCareer(t *testing.T) {
@@ -358,6 +664,7 @@ func TestRealClockNow(t *testing.T) {
 }
 
 func TestGeneratePlayerGameStats(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
 	player := Player{
 		ID:                "player-1",
 		DraftYear:         2020,
@@ -421,11 +728,11 @@ func TestGeneratePlayerGameStats(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.position, func(t *testing.T) {
 			player.Position = tt.position
-			stats := generatePlayerGameStats(player, 5)
+			stats := generatePlayerGameStats(rng, player, 5)
 
 			// Run the check multiple times to ensure consistency
 			for range 10 {
-				stats = generatePlayerGameStats(player, 5)
+				stats = generatePlayerGameStats(rng, player, 5)
 				if !tt.checkStat(stats) {
 					t.Errorf("%s failed: stats = %+v", tt.description, stats)
 				}
@@ -440,39 +747,20 @@ func TestMultiplyStatByPlayerSkill(t *testing.T) {
 	}
 
 	tests := []struct {
-		name              string
-		yearsOfExperience int
-		stat              int
-		description       string
+		name     string
+		stat     int
+		expected int
 	}{
-		{"rookie", 0, 100, "rookie with base stat 100"},
-		{"veteran", 5, 100, "5-year veteran with base stat 100"},
-		{"star", 10, 200, "10-year star with base stat 200"},
-		{"zero stat", 0, 0, "zero stat should remain zero"},
+		{"base stat", 100, 80},
+		{"large stat", 200, 160},
+		{"rounds down", 7, 5},
+		{"zero stat", 0, 0},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := multiplyStatByPlayerSkill(player, tt.yearsOfExperience, tt.stat)
-
-			if tt.stat == 0 {
-				if result != 0 {
-					t.Errorf("Zero stat should remain zero, got %d", result)
-				}
-				return
-			}
-
-			// Result should be less than or equal to original stat * (1 + yoe/100) * skill
-			maxExpected := int(float64(tt.stat) * (1.0 + float64(tt.yearsOfExperience)/100.0))
-
-			if result > maxExpected {
-				t.Errorf("Result %d is higher than expected maximum %d", result, maxExpected)
-			}
-
-			// Result should generally be less than original for 0.8 skill
-			// (unless years of experience compensates)
-			if tt.yearsOfExperience == 0 && result > tt.stat {
-				t.Errorf("Rookie with 0.8 skill should have stat <= original. Got %d from %d", result, tt.stat)
+			if result := multiplyStatByPlayerSkill(player, tt.stat); result != tt.expected {
+				t.Errorf("Expected %d scaled by skill 0.8 to be %d, got %d", tt.stat, tt.expected, result)
 			}
 		})
 	}
@@ -480,7 +768,7 @@ func TestMultiplyStatByPlayerSkill(t *testing.T) {
 	// Test with very low skill player
 	t.Run("low skill player", func(t *testing.T) {
 		lowSkillPlayer := Player{Skill: 0.2}
-		result := multiplyStatByPlayerSkill(lowSkillPlayer, 0, 100)
+		result := multiplyStatByPlayerSkill(lowSkillPlayer, 100)
 
 		// Should be significantly reduced
 		if result >= 100 {
@@ -491,7 +779,7 @@ func TestMultiplyStatByPlayerSkill(t *testing.T) {
 	// Test with high skill player
 	t.Run("high skill player", func(t *testing.T) {
 		highSkillPlayer := Player{Skill: 0.95}
-		result := multiplyStatByPlayerSkill(highSkillPlayer, 0, 100)
+		result := multiplyStatByPlayerSkill(highSkillPlayer, 100)
 
 		// Should be close to original or slightly less
 		if result < 50 {
@@ -514,7 +802,7 @@ func TestMultiplyYearlyStatsByPlayerSkill(t *testing.T) {
 		RushingYards:       100,
 	}
 
-	adjusted := multiplyYearlyStatsByPlayerSkill(player, 5, stats)
+	adjusted := multiplyYearlyStatsByPlayerSkill(player, stats)
 
 	// All stats should be adjusted
 	if adjusted.PassingAttempts == stats.PassingAttempts {
@@ -532,6 +820,7 @@ func TestMultiplyYearlyStatsByPlayerSkill(t *testing.T) {
 }
 
 func TestNormalInRange(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
 	tests := []struct {
 		name string
 		low  float64
@@ -545,7 +834,7 @@ func TestNormalInRange(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			for range 100 {
-				result := normalInRange(tt.low, tt.high)
+				result := normalInRange(rng, tt.low, tt.high)
 
 				// Result should be clamped within bounds
 				if result < tt.low {
@@ -560,6 +849,7 @@ func TestNormalInRange(t *testing.T) {
 }
 
 func TestNormalIntInRange(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
 	tests := []struct {
 		name string
 		low  int
@@ -573,7 +863,7 @@ func TestNormalIntInRange(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			for range 100 {
-				result := normalIntInRange(tt.low, tt.high)
+				result := normalIntInRange(rng, tt.low, tt.high)
 
 				// Result should be within bounds
 				if result < tt.low {
@@ -13,6 +13,9 @@ import (
 // T must be 'ordered' (int, string, float64) to be sorted for CDF.
 type Distribution[T cmp.Ordered] map[T]int
 
+// minDraftAge is the youngest a football player enters the league
+const minDraftAge = 21
+
 func createNewPlayer(position Position, teamId string, generators PlayerGenerators, clock Clock, uuidGenerator UUIDGenerator) Player {
 	firstName := generators.FirstNameGenerator()
 	lastName := generators.LastNameGenerator()
@@ -26,8 +29,10 @@ func createNewPlayer(position Position, teamId string, generators PlayerGenerato
 	jersey := positionGenerators[positionIndex].Generators.JerseyGenerator()
 	height := positionGenerators[positionIndex].Generators.HeightGenerator()
 	weight := positionGenerators[positionIndex].Generators.WeightGenerator()
-	age := positionGenerators[positionIndex].Generators.AgeGenerator()
-	yoe := positionGenerators[positionIndex].Generators.YoeGenerator()
+	// Age and experience are drawn separately, so experience is capped at the years since the
+	// player was old enough to be drafted; otherwise their career would start in their teens
+	age := max(positionGenerators[positionIndex].Generators.AgeGenerator(), minDraftAge)
+	yoe := min(positionGenerators[positionIndex].Generators.YoeGenerator(), age-minDraftAge)
 	thisYear := clock.Now().Year()
 
 	player := Player{
@@ -57,13 +62,21 @@ const (
 	WR Position = "WR"
 	TE Position = "TE"
 	PK Position = "PK"
+	// DST is a team's defense/special teams unit, rostered and drafted like a player
+	DST Position = "DST"
 )
 
+// footballPositions lists the player positions a football roster carries, in depth chart order,
+// offense first. The DST unit isn't among them: it never retires and isn't drafted.
+var footballPositions = []Position{QB, RB, WR, TE, PK, DL, LB, DB}
+
 type PlayerGenerators struct {
 	FirstNameGenerator func() string
 	LastNameGenerator  func() string
 	PositionGenerators []LabeledPositionGenerators
 	SkillGenerator     func() float64
+	// Rand is the source the generators draw from, for the attributes a sport draws without real data
+	Rand *rand.Rand
 }
 
 type UUIDGenerator func() string
@@ -82,35 +95,51 @@ type LabeledPositionGenerators struct {
 }
 
 var (
-	firstNameGeneratorSingleton func() string
-	lastNameGeneratorSingleton  func() string
-	positionGeneratorsSingleton []LabeledPositionGenerators
-	generatorsOnce              sync.Once
+	playerStatsSingleton AggregatedPlayerStats
+	playerStatsErr       error
+	generatorsOnce       sync.Once
 )
 
-func getPlayerGenerators(statsAggregator StatsAggregator, rand *rand.Rand) PlayerGenerators {
+// loadPlayerStats aggregates the real player stats the generators draw from, once per process
+func loadPlayerStats(statsAggregator StatsAggregator) (AggregatedPlayerStats, error) {
 	generatorsOnce.Do(func() {
-		firstNameGeneratorSingleton, lastNameGeneratorSingleton, positionGeneratorsSingleton = createPlayerGeneratorsFromStats(statsAggregator, rand)
+		fmt.Println("Aggregating player stats...")
+		playerStatsSingleton, playerStatsErr = statsAggregator()
 	})
+	return playerStatsSingleton, playerStatsErr
+}
+
+// checkPlayerData makes sure the player data the generators draw from can be used, so a command
+// reports a bad PLAYER_PROFILE or REAL_DATA_FILE before it generates anything
+func checkPlayerData() error {
+	_, err := loadPlayerStats(collectAndAggregatePlayerAttributes)
+	return err
+}
+
+// getPlayerGenerators creates generators that draw from rng. The real player stats they're drawn
+// from are aggregated once per process.
+func getPlayerGenerators(statsAggregator StatsAggregator, rand *rand.Rand) PlayerGenerators {
+	stats, err := loadPlayerStats(statsAggregator)
+	if err != nil {
+		// Commands run checkPlayerData before generating, so only a caller that skipped it gets here
+		panic(fmt.Sprintf("the player data can't be used: %v", err))
+	}
+	firstNameGenerator, lastNameGenerator, positionGenerators := createPlayerGeneratorsFromStats(stats, rand)
 	return PlayerGenerators{
-		FirstNameGenerator: firstNameGeneratorSingleton,
-		LastNameGenerator:  lastNameGeneratorSingleton,
-		PositionGenerators: positionGeneratorsSingleton,
-		SkillGenerator:     createRandomSkillFactorWithBellCurve,
+		FirstNameGenerator: firstNameGenerator,
+		LastNameGenerator:  lastNameGenerator,
+		PositionGenerators: positionGenerators,
+		SkillGenerator: func() float64 {
+			return createRandomSkillFactorWithBellCurve(rand)
+		},
+		Rand: rand,
 	}
 }
 
-func createPlayerGeneratorsFromStats(statsAggregator StatsAggregator, rand *rand.Rand) (func() string, func() string, []LabeledPositionGenerators) {
-	fmt.Println("Creating player generators from real player stats...")
-	fmt.Println("Aggregating player stats...")
-	stats := statsAggregator()
-	fmt.Println("Creating first name generator...")
+func createPlayerGeneratorsFromStats(stats AggregatedPlayerStats, rand *rand.Rand) (func() string, func() string, []LabeledPositionGenerators) {
 	firstNameGenerator := createGenerateValueFromStat(stats.FirstNames, rand)
-	fmt.Println("Creating last name generator...")
 	lastNameGenerator := createGenerateValueFromStat(stats.LastNames, rand)
-	fmt.Println("Creating position generators...")
 	positionGenerators := createPositionsGeneratorsFromStats(stats, rand)
-	fmt.Println("Player generators created successfully.")
 	return firstNameGenerator, lastNameGenerator, positionGenerators
 }
 
@@ -205,18 +234,18 @@ func createGenerateValueFromStat[T cmp.Ordered, M ~map[T]int](stats M, rand *ran
 	}
 }
 
-func createRandomSkillFactorWithBellCurve() float64 {
+func createRandomSkillFactorWithBellCurve(rng *rand.Rand) float64 {
 	// Generate random number from bell curve
 	// Return value between 0.0 and 1.0
 	desiredMean := 0.5
 	desiredStdDev := 0.2
-	return rand.NormFloat64()*desiredStdDev + desiredMean
+	return rng.NormFloat64()*desiredStdDev + desiredMean
 }
 
 // createSkillForDepthPosition generates a skill value based on depth chart position.
 // depthPosition is 0-indexed (0 = starter, 1 = backup, etc.)
 // This creates a natural falloff down the depth chart while allowing some variance.
-func createSkillForDepthPosition(depthPosition int, totalAtPosition int) float64 {
+func createSkillForDepthPosition(rng *rand.Rand, depthPosition int, totalAtPosition int) float64 {
 	// Calculate a base skill that decreases with depth
 	depthRatio := float64(depthPosition) / float64(max(totalAtPosition-1, 1))
 
@@ -224,7 +253,7 @@ func createSkillForDepthPosition(depthPosition int, totalAtPosition int) float64
 
 	variance := 0.08
 
-	skill := rand.NormFloat64()*variance + baseMean
+	skill := rng.NormFloat64()*variance + baseMean
 
 	if skill < 0.15 {
 		skill = 0.15
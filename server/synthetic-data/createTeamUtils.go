@@ -1,48 +1,45 @@
 package main
 
-import (
-	"math/rand"
-	"time"
+import "math/rand"
 
-	"github.com/google/uuid"
-)
-
-func createTeamRoster(teamID string) FootballTeamRoster {
-	qbCount := NFLRosterComposition["QB"]
-	rbCount := NFLRosterComposition["RB"]
-	wrCount := NFLRosterComposition["WR"]
-	teCount := NFLRosterComposition["TE"]
-	pkCount := NFLRosterComposition["PK"]
-
-	// Create players with depth-based skill assignments
-	qbPlayers := createPlayersWithDepthSkills(QB, teamID, qbCount)
-	rbPlayers := createPlayersWithDepthSkills(RB, teamID, rbCount)
-	wrPlayers := createPlayersWithDepthSkills(WR, teamID, wrCount)
-	tePlayers := createPlayersWithDepthSkills(TE, teamID, teCount)
-	pkPlayers := createPlayersWithDepthSkills(PK, teamID, pkCount)
+func createTeamRoster(teamID string, rng *rand.Rand) FootballTeamRoster {
+	return createComposedTeamRoster(teamID, NFLRosterComposition, rng, RealClock{})
+}
 
-	roster := FootballTeamRoster{
-		QB: qbPlayers,
-		RB: rbPlayers,
-		WR: wrPlayers,
-		TE: tePlayers,
-		PK: pkPlayers,
+// createComposedTeamRoster fills every position group of a team to its count in composition,
+// each ordered from starter down and generated going into clock's season. The DST unit is left to the caller.
+func createComposedTeamRoster(teamID string, composition RosterComposition, rng *rand.Rand, clock Clock) FootballTeamRoster {
+	var roster FootballTeamRoster
+	for _, position := range footballPositions {
+		// Create players with depth-based skill assignments
+		*rosterGroup(&roster, string(position)) = createPlayersWithDepthSkills(position, teamID, composition[string(position)], rng, clock)
 	}
-
 	return roster
 }
 
-func createPlayersWithDepthSkills(position Position, teamID string, count int) []Player {
+func createPlayersWithDepthSkills(position Position, teamID string, count int, rng *rand.Rand, clock Clock) []Player {
 	players := make([]Player, count)
-	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
 	generators := getPlayerGenerators(collectAndAggregatePlayerAttributes, rng)
-	clock := RealClock{}
-	uuidGenerator := UUIDGenerator(func() string { return uuid.New().String() })
+	uuidGenerator := uuidsFrom(rng)
 	for depthIndex := range count {
-		player := createNewPlayer(position, teamID, generators, clock, uuidGenerator)
+		player := createFootballPlayer(position, teamID, generators, clock, uuidGenerator)
 		// Override the random skill with depth-based skill
-		player.Skill = createSkillForDepthPosition(depthIndex, count)
+		player.Skill = createSkillForDepthPosition(rng, depthIndex, count)
 		players[depthIndex] = player
 	}
 	return players
 }
+
+// createProspect generates a college prospect entering the draft in draftYear, with attributes drawn
+// from the position's real distributions, a hidden true skill and the noisier skill scouts see
+func createProspect(position Position, draftYear int, rng *rand.Rand) Player {
+	generators := getPlayerGenerators(collectAndAggregatePlayerAttributes, rng)
+	uuidGenerator := uuidsFrom(rng)
+	prospect := createFootballPlayer(position, "", generators, yearClock(draftYear), uuidGenerator)
+	prospect.Age = normalIntInRange(rng, 21, 23)
+	prospect.YearsOfExperience = 0
+	prospect.DraftYear = draftYear
+	prospect.Skill = clampFloat(generators.SkillGenerator(), 0.15, 0.95)
+	prospect.ScoutedSkill = scoutSkill(prospect.Skill, rng)
+	return prospect
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"math/rand"
+	"sync"
 	"testing"
 )
 
@@ -8,8 +10,8 @@ func TestCreateTeamRosterIntegration(t *testing.T) {
 	// Integration test - uses real data generation
 	// This will test the actual roster creation with dependencies
 	teamID := "test-team-123"
-	
-	roster := createTeamRoster(teamID)
+
+	roster := createTeamRoster(teamID, rand.New(rand.NewSource(1)))
 
 	// Verify roster has correct number of players per position
 	if len(roster.QB) != NFLRosterComposition["QB"] {
@@ -27,6 +29,11 @@ func TestCreateTeamRosterIntegration(t *testing.T) {
 	if len(roster.PK) != NFLRosterComposition["PK"] {
 		t.Errorf("Expected %d PKs, got %d", NFLRosterComposition["PK"], len(roster.PK))
 	}
+	for position, group := range map[string][]Player{"DL": roster.DL, "LB": roster.LB, "DB": roster.DB} {
+		if len(group) != NFLRosterComposition[position] {
+			t.Errorf("Expected %d %ss, got %d", NFLRosterComposition[position], position, len(group))
+		}
+	}
 
 	// Verify all players have correct team ID
 	for _, player := range roster.QB {
@@ -55,11 +62,15 @@ func TestCreateTeamRosterIntegration(t *testing.T) {
 func TestCreateTeamRoster(t *testing.T) {
 	// Test NFLRosterComposition structure
 	expectedComposition := map[string]int{
-		"QB": 3,
-		"RB": 4,
-		"WR": 6,
-		"TE": 3,
-		"PK": 1,
+		"QB":  3,
+		"RB":  4,
+		"WR":  6,
+		"TE":  3,
+		"PK":  1,
+		"DL":  6,
+		"LB":  4,
+		"DB":  6,
+		"DST": 1,
 	}
 
 	for position, count := range expectedComposition {
@@ -68,14 +79,14 @@ func TestCreateTeamRoster(t *testing.T) {
 		}
 	}
 
-	// Total roster size should be 17
+	// Total roster size should be 34
 	totalRoster := 0
 	for _, count := range NFLRosterComposition {
 		totalRoster += count
 	}
 
-	if totalRoster != 17 {
-		t.Errorf("Expected total roster size of 17, got %d", totalRoster)
+	if totalRoster != 34 {
+		t.Errorf("Expected total roster size of 34, got %d", totalRoster)
 	}
 }
 
@@ -85,7 +96,7 @@ func TestCreatePlayersWithDepthSkillsIntegration(t *testing.T) {
 	position := QB
 	count := 3
 
-	players := createPlayersWithDepthSkills(position, teamID, count)
+	players := createPlayersWithDepthSkills(position, teamID, count, rand.New(rand.NewSource(1)), RealClock{})
 
 	// Verify correct number of players
 	if len(players) != count {
@@ -223,3 +234,27 @@ func TestRosterCompositionType(t *testing.T) {
 	}
 }
 
+func TestCreateTeamRosterDraftAges(t *testing.T) {
+	// A profile whose young players claim long careers, as independent draws of age and experience can
+	young := func() (AggregatedPlayerStats, error) {
+		stats := seededTestAttributes()
+		for _, profile := range stats.PositionProfile {
+			profile.Ages = map[int]int{19: 3, 22: 5, 26: 2}
+			profile.YearsOfExperience = map[int]int{4: 5, 9: 5}
+		}
+		return stats, nil
+	}
+	generatorsOnce = sync.Once{}
+	defer func() { generatorsOnce = sync.Once{} }()
+	rng := rand.New(rand.NewSource(7))
+	getPlayerGenerators(young, rng)
+
+	roster := createTeamRoster("team-1", rng)
+
+	for _, player := range flattenRoster(roster) {
+		if draftAge := player.Age - player.YearsOfExperience; draftAge < minDraftAge {
+			t.Errorf("Expected every player to be drafted at %d or older, %s %s was drafted at %d (age %d, %d years)",
+				minDraftAge, player.Position, player.ID, draftAge, player.Age, player.YearsOfExperience)
+		}
+	}
+}
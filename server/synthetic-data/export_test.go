@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// newMockSeeder seeds all three mock leagues
+func newMockSeeder() *DatabaseSeeder {
+	return NewDatabaseSeeder(SeederConfig{
+		DataGenerator:       NewMockDataGenerator(),
+		BasketballGenerator: NewMockBasketballGenerator(),
+		BaseballGenerator:   NewMockBaseballGenerator(),
+		Quiet:               true,
+	})
+}
+
+func TestExportRoundTrip(t *testing.T) {
+	for _, format := range []string{exportJSON, exportCSV} {
+		t.Run(format, func(t *testing.T) {
+			universe := newMockSeeder().Generate()
+			universe.League = DefaultLeagueDefinition
+			universe.League.GamesPerSeason = 14
+			dir := t.TempDir()
+
+			if err := writeUniverse(dir, format, universe); err != nil {
+				t.Fatalf("Expected the universe to be written, got %v", err)
+			}
+			if _, err := os.Stat(filepath.Join(dir, "weekly_stats."+format)); err != nil {
+				t.Errorf("Expected a weekly_stats.%s file, got %v", format, err)
+			}
+
+			imported, err := readUniverse(dir)
+			if err != nil {
+				t.Fatalf("Expected the universe to be read back, got %v", err)
+			}
+
+			want, err := universeToTables(universe)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := universeToTables(imported)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Expected the imported universe to match the exported one\ngot:  %+v\nwant: %+v", got, want)
+			}
+			if !reflect.DeepEqual(imported.League, universe.League) {
+				t.Errorf("Expected the league definition to be read back, got %+v", imported.League)
+			}
+
+			if len(imported.Leagues) != 2 || imported.Leagues[0].SportType != SportBasketball || imported.Leagues[1].SportType != SportBaseball {
+				t.Fatalf("Expected the basketball and baseball leagues after football, got %+v", imported.Leagues)
+			}
+			if imported.Leagues[0].Name != "basketball" {
+				t.Errorf("Expected the basketball league to be named for its sport, got %q", imported.Leagues[0].Name)
+			}
+			career := imported.Football.Careers[0]
+			if career.Stats.Total.PassingYards != 4000 || len(career.Stats.Weeks) != 2 || career.Stats.Weeks[1].Stats.PassingTDs != 3 {
+				t.Errorf("Expected the football stats to be decoded, got %+v", career.Stats)
+			}
+		})
+	}
+}
+
+func TestImportInsertsLikeSeed(t *testing.T) {
+	seeder := newMockSeeder()
+	dir := t.TempDir()
+	if err := writeUniverse(dir, exportCSV, seeder.Generate()); err != nil {
+		t.Fatal(err)
+	}
+	universe, err := readUniverse(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seeded, err := newMockSeeder().Seed(context.Background(), &MockTx{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	mockTx := &MockTx{}
+	imported, err := seeder.Insert(context.Background(), mockTx, universe)
+	if err != nil {
+		t.Fatalf("Expected the universe to be inserted, got %v", err)
+	}
+
+	if *imported != *seeded {
+		t.Errorf("Expected importing to insert what seeding does, got %+v, want %+v", imported, seeded)
+	}
+	injured := newUniverseScope(DefaultUniverse).id("c-1")
+	for _, call := range mockTx.ExecCalls {
+		if strings.HasPrefix(call.SQL, "INSERT INTO players") && call.Args[0] == injured && call.Args[11] != "INJURED" {
+			t.Errorf("Expected c-1 to stay INJURED, got %v", call.Args[11])
+		}
+	}
+}
+
+func TestImportIntoSeveralUniverses(t *testing.T) {
+	dir := t.TempDir()
+	if err := writeUniverse(dir, exportJSON, newMockSeeder().Generate()); err != nil {
+		t.Fatal(err)
+	}
+	imported, err := readUniverse(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// importInto imports the directory and returns the IDs of the rows inserted and those referenced
+	importInto := func(universe string) (ids, references map[string]bool) {
+		mockTx := &MockTx{}
+		seeder := NewDatabaseSeeder(SeederConfig{Universe: universe, Quiet: true})
+		if _, err := seeder.Insert(context.Background(), mockTx, imported); err != nil {
+			t.Fatalf("Expected the directory to be imported into %s, got %v", universe, err)
+		}
+		ids, references = make(map[string]bool), make(map[string]bool)
+		for _, call := range mockTx.ExecCalls {
+			switch {
+			case strings.HasPrefix(call.SQL, "INSERT INTO conferences"), strings.HasPrefix(call.SQL, "INSERT INTO universes"):
+				ids[call.Args[0].(string)] = true
+			case strings.HasPrefix(call.SQL, "INSERT INTO divisions"):
+				ids[call.Args[0].(string)] = true
+				references[call.Args[2].(string)] = true
+			case strings.HasPrefix(call.SQL, "INSERT INTO pro_teams"):
+				ids[call.Args[0].(string)] = true
+				references[call.Args[5].(string)] = true
+			case strings.HasPrefix(call.SQL, "INSERT INTO players"):
+				ids[call.Args[0].(string)] = true
+				references[call.Args[4].(string)] = true
+			case strings.HasPrefix(call.SQL, "INSERT INTO team_depth_charts"):
+				references[call.Args[0].(string)] = true
+				references[call.Args[1].(string)] = true
+			case strings.HasPrefix(call.SQL, "INSERT INTO yearly_stats"), strings.HasPrefix(call.SQL, "INSERT INTO weekly_stats"):
+				references[call.Args[0].(string)] = true
+			}
+		}
+		return ids, references
+	}
+
+	sandbox, sandboxReferences := importInto("sandbox")
+	staging, stagingReferences := importInto("staging")
+	for id := range sandbox {
+		if staging[id] {
+			t.Errorf("Expected each import to store its rows under its own IDs, both have %s", id)
+		}
+	}
+	for _, universe := range []struct {
+		ids, references map[string]bool
+	}{{sandbox, sandboxReferences}, {staging, stagingReferences}} {
+		for reference := range universe.references {
+			if !universe.ids[reference] {
+				t.Errorf("Expected %s to reference a row imported with it", reference)
+			}
+		}
+	}
+}
+
+func TestReadUniverseErrors(t *testing.T) {
+	t.Run("empty directory", func(t *testing.T) {
+		if _, err := readUniverse(t.TempDir()); err == nil || !strings.Contains(err.Error(), "no exported universe") {
+			t.Errorf("Expected an empty directory to be rejected, got %v", err)
+		}
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := writeUniverse(dir, exportCSV, newMockSeeder().Generate()); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "divisions.csv"), []byte("id,name,league\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := readUniverse(dir); err == nil || !strings.Contains(err.Error(), `unknown column "league"`) {
+			t.Errorf("Expected the unknown column to be reported, got %v", err)
+		}
+	})
+
+	t.Run("stats without a season", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := writeUniverse(dir, exportJSON, newMockSeeder().Generate()); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "yearly_stats.json"), []byte("[]"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := readUniverse(dir); err == nil || !strings.Contains(err.Error(), "have no yearly stats") {
+			t.Errorf("Expected orphaned weekly stats to be reported, got %v", err)
+		}
+	})
+}
+
+func TestWriteUniverseRejectsUnknownFormat(t *testing.T) {
+	if err := writeUniverse(t.TempDir(), "xml", newMockSeeder().Generate()); err == nil {
+		t.Error("Expected an unknown format to be rejected")
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	"github.com/goccy/go-yaml"
+)
+
+// LeagueDefinition describes the structure of the generated football league. It is read from a
+// YAML or JSON file by the seed command; anything the file leaves out keeps its default.
+type LeagueDefinition struct {
+	// Conferences names each conference
+	Conferences []string `json:"conferences"`
+	// Divisions names the divisions every conference is split into
+	Divisions []string `json:"divisions"`
+	// TeamsPerDivision is how many teams each division holds
+	TeamsPerDivision int `json:"teams_per_division"`
+	// Franchises are the team identities the league draws its teams from at random;
+	// there must be at least one per team
+	Franchises []Franchise `json:"franchises"`
+	// RosterComposition is how many players of each position every team carries, plus a DST of 0 or 1.
+	// Positions left out keep their standard count.
+	RosterComposition RosterComposition `json:"roster_composition"`
+	// GamesPerSeason is the length of a regular season
+	GamesPerSeason int `json:"games_per_season"`
+}
+
+// DefaultLeagueDefinition is the standard 32 team league: two conferences of four divisions
+// named for the compass points, four teams to a division
+var DefaultLeagueDefinition = LeagueDefinition{
+	Conferences:       []string{"Union Conference", "Alliance Conference"},
+	Divisions:         []string{"North", "South", "East", "West"},
+	TeamsPerDivision:  4,
+	Franchises:        allAvailableFranchises,
+	RosterComposition: NFLRosterComposition,
+	GamesPerSeason:    defaultGamesPerSeason,
+}
+
+// Teams is the number of teams the definition's league has
+func (d LeagueDefinition) Teams() int {
+	return len(d.Conferences) * len(d.Divisions) * d.TeamsPerDivision
+}
+
+// loadLeagueDefinition reads a league definition file. YAML is a superset of JSON, so either
+// format is accepted.
+func loadLeagueDefinition(path string) (LeagueDefinition, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return LeagueDefinition{}, fmt.Errorf("failed to read league definition: %w", err)
+	}
+	return parseLeagueDefinition(data)
+}
+
+// parseLeagueDefinition decodes a league definition, fills in the defaults for anything it leaves
+// out and checks that the league it describes can be generated
+func parseLeagueDefinition(data []byte) (LeagueDefinition, error) {
+	var definition LeagueDefinition
+	if err := yaml.Unmarshal(data, &definition); err != nil {
+		return LeagueDefinition{}, fmt.Errorf("invalid league definition: %w", err)
+	}
+
+	if len(definition.Conferences) == 0 {
+		definition.Conferences = DefaultLeagueDefinition.Conferences
+	}
+	if len(definition.Divisions) == 0 {
+		definition.Divisions = DefaultLeagueDefinition.Divisions
+	}
+	if definition.TeamsPerDivision == 0 {
+		definition.TeamsPerDivision = DefaultLeagueDefinition.TeamsPerDivision
+	}
+	if len(definition.Franchises) == 0 {
+		definition.Franchises = DefaultLeagueDefinition.Franchises
+	}
+	// Positions the file doesn't mention keep their standard count; list one as 0 to leave it out
+	composition := maps.Clone(DefaultLeagueDefinition.RosterComposition)
+	maps.Copy(composition, definition.RosterComposition)
+	definition.RosterComposition = composition
+	if definition.GamesPerSeason == 0 {
+		definition.GamesPerSeason = DefaultLeagueDefinition.GamesPerSeason
+	}
+
+	if err := definition.validate(); err != nil {
+		return LeagueDefinition{}, err
+	}
+	return definition, nil
+}
+
+// validate checks that a league can be generated from the definition
+func (d LeagueDefinition) validate() error {
+	if d.TeamsPerDivision < 0 || d.GamesPerSeason < 0 {
+		return errors.New("league definition: teams per division and games per season can't be negative")
+	}
+	if d.Teams() < 2 {
+		return fmt.Errorf("league definition: a league needs at least 2 teams, this one has %d", d.Teams())
+	}
+	if d.Teams() > len(d.Franchises) {
+		return fmt.Errorf("league definition: %d teams need as many franchises, only %d are listed", d.Teams(), len(d.Franchises))
+	}
+
+	abbreviations := make(map[string]bool, len(d.Franchises))
+	for _, franchise := range d.Franchises {
+		if franchise.City == "" || franchise.Name == "" || franchise.Abbr == "" {
+			return fmt.Errorf("league definition: franchise %+v needs a city, name and abbreviation", franchise)
+		}
+		if abbreviations[franchise.Abbr] {
+			return fmt.Errorf("league definition: franchise abbreviation %s is used twice", franchise.Abbr)
+		}
+		abbreviations[franchise.Abbr] = true
+	}
+
+	for position, count := range d.RosterComposition {
+		if position != string(DST) && !slices.Contains(footballPositions, Position(position)) {
+			return fmt.Errorf("league definition: unknown roster position %s", position)
+		}
+		if count < 0 {
+			return fmt.Errorf("league definition: roster can't carry %d %s", count, position)
+		}
+	}
+	if d.RosterComposition[string(DST)] > 1 {
+		return errors.New("league definition: a team has at most one DST unit")
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const tenTeamLeagueYAML = `
+conferences: [Coast]
+divisions: [Pacific, Atlantic]
+teams_per_division: 5
+games_per_season: 14
+franchises:
+  - {city: Portland, state: OR, name: Pines, abbr: POR}
+  - {city: Sacramento, state: CA, name: Rivermen, abbr: SAC}
+  - {city: San Diego, state: CA, name: Surf, abbr: SDS}
+  - {city: Anchorage, state: AK, name: Moose, abbr: ANC}
+  - {city: Honolulu, state: HI, name: Waves, abbr: HON}
+  - {city: Norfolk, state: VA, name: Anchors, abbr: NOR}
+  - {city: Charleston, state: SC, name: Palmettos, abbr: CHS}
+  - {city: Savannah, state: GA, name: Oaks, abbr: SAV}
+  - {city: Portland, state: ME, name: Lobsters, abbr: PME}
+  - {city: Providence, state: RI, name: Friars, abbr: PRO}
+roster_composition:
+  TE: 1
+  DL: 0
+  LB: 0
+  DB: 0
+`
+
+func TestParseLeagueDefinition(t *testing.T) {
+	t.Run("reads a YAML definition", func(t *testing.T) {
+		league, err := parseLeagueDefinition([]byte(tenTeamLeagueYAML))
+		if err != nil {
+			t.Fatalf("Expected the definition to parse, got %v", err)
+		}
+
+		if league.Teams() != 10 {
+			t.Errorf("Expected 10 teams, got %d", league.Teams())
+		}
+		if league.Conferences[0] != "Coast" || league.Divisions[1] != "Atlantic" {
+			t.Errorf("Expected the file's conference and division names, got %v and %v", league.Conferences, league.Divisions)
+		}
+		if league.GamesPerSeason != 14 {
+			t.Errorf("Expected a 14 game season, got %d", league.GamesPerSeason)
+		}
+		if len(league.Franchises) != 10 || league.Franchises[0].City != "Portland" || league.Franchises[0].Abbr != "POR" {
+			t.Errorf("Expected the file's 10 franchises, got %+v", league.Franchises)
+		}
+		if league.RosterComposition["TE"] != 1 || league.RosterComposition["LB"] != 0 {
+			t.Errorf("Expected the file's roster counts, got %v", league.RosterComposition)
+		}
+		if league.RosterComposition["QB"] != NFLRosterComposition["QB"] {
+			t.Errorf("Expected positions the file leaves out to keep their standard count, got %d QBs", league.RosterComposition["QB"])
+		}
+		if NFLRosterComposition["TE"] == 1 {
+			t.Error("Expected the standard roster composition to be left untouched")
+		}
+	})
+
+	t.Run("reads a JSON definition", func(t *testing.T) {
+		franchises := make([]Franchise, 40)
+		for i := range franchises {
+			franchises[i] = Franchise{City: fmt.Sprintf("City %d", i), Name: "Team", Abbr: fmt.Sprintf("T%02d", i)}
+		}
+		data, err := json.Marshal(map[string]any{"teams_per_division": 5, "games_per_season": 17, "franchises": franchises})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		league, err := parseLeagueDefinition(data)
+		if err != nil {
+			t.Fatalf("Expected the definition to parse, got %v", err)
+		}
+
+		if league.Teams() != 40 {
+			t.Errorf("Expected 40 teams, got %d", league.Teams())
+		}
+		if league.GamesPerSeason != 17 {
+			t.Errorf("Expected a 17 game season, got %d", league.GamesPerSeason)
+		}
+		if league.Franchises[39].Abbr != "T39" {
+			t.Errorf("Expected the file's franchises, got %+v", league.Franchises[39])
+		}
+	})
+
+	t.Run("defaults an empty definition to the standard league", func(t *testing.T) {
+		league, err := parseLeagueDefinition([]byte(""))
+		if err != nil {
+			t.Fatalf("Expected the definition to parse, got %v", err)
+		}
+
+		if league.Teams() != 32 || league.GamesPerSeason != defaultGamesPerSeason {
+			t.Errorf("Expected the standard 32 team league, got %d teams playing %d games", league.Teams(), league.GamesPerSeason)
+		}
+	})
+
+	t.Run("rejects leagues that can't be generated", func(t *testing.T) {
+		tests := map[string]string{
+			"one team":              "conferences: [Solo]\ndivisions: [Only]\nteams_per_division: 1",
+			"too few franchises":    "teams_per_division: 2\nfranchises: [{city: A, name: B, abbr: C}]",
+			"missing abbreviation":  "teams_per_division: 1\nfranchises: [{city: A, name: B}, {city: C, name: D, abbr: E}, {city: F, name: G, abbr: H}, {city: I, name: J, abbr: K}, {city: L, name: M, abbr: N}, {city: O, name: P, abbr: Q}, {city: R, name: S, abbr: T}, {city: U, name: V, abbr: W}]",
+			"duplicate franchise":   "conferences: [One]\ndivisions: [Only]\nteams_per_division: 2\nfranchises: [{city: A, name: B, abbr: C}, {city: D, name: E, abbr: C}]",
+			"unknown position":      "roster_composition: {OL: 5}",
+			"negative roster count": "roster_composition: {QB: -1}",
+			"two defenses":          "roster_composition: {DST: 2}",
+			"negative season":       "games_per_season: -1",
+		}
+
+		for name, definition := range tests {
+			if _, err := parseLeagueDefinition([]byte(definition)); err == nil {
+				t.Errorf("Expected %s to be rejected", name)
+			}
+		}
+	})
+}
+
+func TestLoadLeagueDefinition(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "league.yaml")
+	if err := os.WriteFile(path, []byte(tenTeamLeagueYAML), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	league, err := loadLeagueDefinition(path)
+	if err != nil {
+		t.Fatalf("Expected the file to load, got %v", err)
+	}
+	if league.Teams() != 10 {
+		t.Errorf("Expected 10 teams, got %d", league.Teams())
+	}
+
+	if _, err := loadLeagueDefinition(filepath.Join(t.TempDir(), "missing.yaml")); err == nil || !strings.Contains(err.Error(), "failed to read") {
+		t.Errorf("Expected a missing file to fail to read, got %v", err)
+	}
+}
+
+func TestGenerateDefinedLeagueFlat(t *testing.T) {
+	league, err := parseLeagueDefinition([]byte(tenTeamLeagueYAML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	counter := 0
+
+	flat := generateDefinedLeagueFlat(league, mockUUIDGenerator("id-", &counter), rand.New(rand.NewSource(1)))
+
+	if len(flat.Conferences) != 1 || len(flat.Divisions) != 2 || len(flat.Teams) != 10 {
+		t.Fatalf("Expected 1 conference, 2 divisions and 10 teams, got %d, %d and %d", len(flat.Conferences), len(flat.Divisions), len(flat.Teams))
+	}
+	if flat.Divisions[0].Name != "Pacific" || flat.Divisions[1].Name != "Atlantic" {
+		t.Errorf("Expected the definition's division names, got %s and %s", flat.Divisions[0].Name, flat.Divisions[1].Name)
+	}
+
+	perDivision := map[string]int{}
+	abbreviations := map[string]bool{}
+	first, last := byeWeekWindow(league.GamesPerSeason)
+	for _, team := range flat.Teams {
+		perDivision[team.DivisionID]++
+		if abbreviations[team.Abbr] {
+			t.Errorf("Expected every franchise to be used once, %s was used twice", team.Abbr)
+		}
+		abbreviations[team.Abbr] = true
+		if team.ByeWeek < first || team.ByeWeek > last {
+			t.Errorf("Expected %s's bye in weeks %d-%d of a %d game season, got %d", team.Abbr, first, last, league.GamesPerSeason, team.ByeWeek)
+		}
+	}
+	for _, division := range flat.Divisions {
+		if perDivision[division.ID] != 5 {
+			t.Errorf("Expected 5 teams in the %s division, got %d", division.Name, perDivision[division.ID])
+		}
+	}
+	if len(league.Franchises) != 10 {
+		t.Errorf("Expected the definition's franchises to be left untouched, got %d", len(league.Franchises))
+	}
+}
+
+func TestByeWeekWindow(t *testing.T) {
+	tests := []struct {
+		games       int
+		first, last int
+	}{
+		{defaultGamesPerSeason, firstByeWeek, lastByeWeek},
+		{14, 3, 10},
+		{4, 2, 3},
+	}
+
+	for _, tt := range tests {
+		first, last := byeWeekWindow(tt.games)
+		if first != tt.first || last != tt.last {
+			t.Errorf("Expected byes in weeks %d-%d of a %d game season, got %d-%d", tt.first, tt.last, tt.games, first, last)
+		}
+		if last > tt.games {
+			t.Errorf("Expected byes to fall inside a %d game season, got week %d", tt.games, last)
+		}
+	}
+}
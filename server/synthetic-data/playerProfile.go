@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+// A player profile is the AggregatedPlayerStats the generators draw players from, saved as JSON by
+// the profile command. Seeding from a saved profile skips reading and aggregating the player source
+// every run, and a profile only holds counts, so it can be shared where the source data can't.
+
+// anonymizedNameCount is the fewest players a name has to be shared by to stay in an anonymized
+// profile
+const anonymizedNameCount = 3
+
+// profiledPositions are the positions the generators draw from a profile
+var profiledPositions = []Position{QB, RB, WR, TE, PK}
+
+// validateProfile makes sure a profile has names and every attribute of every profiled position.
+// Its errors read as a predicate ("has no QB players") for callers to name the profile.
+func validateProfile(stats AggregatedPlayerStats) error {
+	if len(stats.FirstNames) == 0 || len(stats.LastNames) == 0 {
+		return errors.New("has no names")
+	}
+	for _, position := range profiledPositions {
+		profile := stats.PositionProfile[string(position)]
+		if profile == nil {
+			return fmt.Errorf("has no %s players", position)
+		}
+		for _, attribute := range []struct {
+			name      string
+			frequency AttributeFrequency
+		}{
+			{"jerseys", profile.Jerseys},
+			{"heights", profile.Heights},
+			{"weights", profile.Weights},
+			{"ages", profile.Ages},
+			{"years of experience", profile.YearsOfExperience},
+		} {
+			if len(attribute.frequency) == 0 {
+				return fmt.Errorf("has no %s %s", position, attribute.name)
+			}
+		}
+	}
+	return nil
+}
+
+// anonymizeProfile drops the names fewer than minCount players share, so the names left can't
+// single anyone out. Attributes are kept: they're only counted by position.
+func anonymizeProfile(stats AggregatedPlayerStats, minCount int) AggregatedPlayerStats {
+	common := func(names NameFrequency) NameFrequency {
+		kept := make(NameFrequency)
+		for name, count := range names {
+			if count >= minCount {
+				kept[name] = count
+			}
+		}
+		return kept
+	}
+	return AggregatedPlayerStats{
+		PositionProfile: stats.PositionProfile,
+		FirstNames:      common(stats.FirstNames),
+		LastNames:       common(stats.LastNames),
+	}
+}
+
+// loadPlayerProfile reads a profile written by the profile command
+func loadPlayerProfile(path string) (AggregatedPlayerStats, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return AggregatedPlayerStats{}, fmt.Errorf("failed to read player profile: %w", err)
+	}
+	var stats AggregatedPlayerStats
+	if err := json.Unmarshal(contents, &stats); err != nil {
+		return AggregatedPlayerStats{}, fmt.Errorf("failed to decode player profile %s: %w", path, err)
+	}
+	if err := validateProfile(stats); err != nil {
+		return AggregatedPlayerStats{}, fmt.Errorf("player profile %s %w", path, err)
+	}
+	return stats, nil
+}
+
+// writePlayerProfile saves a profile where loadPlayerProfile can read it
+func writePlayerProfile(path string, stats AggregatedPlayerStats) error {
+	contents, err := json.MarshalIndent(stats, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal player profile: %w", err)
+	}
+	if err := os.WriteFile(path, append(contents, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write player profile: %w", err)
+	}
+	return nil
+}
+
+// BuildPlayerProfile aggregates the player source REAL_DATA_FILE names into a profile and saves it
+// to path, keeping only common names when anonymize is set
+func BuildPlayerProfile(path string, anonymize bool) error {
+	stats, err := aggregatePlayerSource(playerSourceFromEnv())
+	if err != nil {
+		return err
+	}
+	if anonymize {
+		stats = anonymizeProfile(stats, anonymizedNameCount)
+		if err := validateProfile(stats); err != nil {
+			return fmt.Errorf("the anonymized profile %w; the player source is too small to anonymize", err)
+		}
+	}
+	return writePlayerProfile(path, stats)
+}
+
+// RunProfile is the main entry point for the profile command
+func RunProfile(args []string) {
+	flags := flag.NewFlagSet("profile", flag.ExitOnError)
+	out := flags.String("out", "", "the file to write the profile to")
+	anonymize := flags.Bool("anonymize", false, fmt.Sprintf("keep only names shared by at least %d players", anonymizedNameCount))
+	flags.Parse(args)
+	if *out == "" {
+		log.Fatal("❌ profile needs a file to write to: --out FILE")
+	}
+
+	log.Println("📊 Building the player profile...")
+	if err := BuildPlayerProfile(*out, *anonymize); err != nil {
+		log.Fatalf("❌ Profile failed: %v", err)
+	}
+	log.Printf("✅ Player profile written to %s; seed from it with PLAYER_PROFILE=%s", *out, *out)
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPlayerProfileRoundTrip(t *testing.T) {
+	stats, err := aggregatePlayerSource(SamplePlayerSource{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "profile.json")
+
+	if err := writePlayerProfile(path, stats); err != nil {
+		t.Fatalf("Expected the profile to be written, got %v", err)
+	}
+	loaded, err := loadPlayerProfile(path)
+	if err != nil {
+		t.Fatalf("Expected the profile to load, got %v", err)
+	}
+
+	if !reflect.DeepEqual(loaded, stats) {
+		t.Error("Expected the loaded profile to match the one written")
+	}
+}
+
+func TestLoadPlayerProfileErrors(t *testing.T) {
+	tests := map[string]struct {
+		contents string
+		want     string
+	}{
+		"invalid JSON":      {"{", "failed to decode"},
+		"no names":          {`{"position_profile": {}}`, "has no names"},
+		"missing position":  {`{"first_names": {"A": 1}, "last_names": {"B": 1}, "position_profile": {}}`, "has no QB players"},
+		"missing attribute": {`{"first_names": {"A": 1}, "last_names": {"B": 1}, "position_profile": {"QB": {"jerseys": {"12": 1}}}}`, "has no QB heights"},
+	}
+	for name, tt := range tests {
+		path := writeTempFile(t, "profile.json", tt.contents)
+		if _, err := loadPlayerProfile(path); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Expected %s to fail with %q, got %v", name, tt.want, err)
+		}
+	}
+
+	if _, err := loadPlayerProfile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Expected a missing profile to fail")
+	}
+}
+
+func TestAnonymizeProfile(t *testing.T) {
+	stats := AggregatedPlayerStats{
+		PositionProfile: seededTestAttributes().PositionProfile,
+		FirstNames:      NameFrequency{"John": 10, "Jane": 3, "Zebediah": 1},
+		LastNames:       NameFrequency{"Smith": 4, "Quux": 2},
+	}
+
+	anonymized := anonymizeProfile(stats, 3)
+
+	if !reflect.DeepEqual(anonymized.FirstNames, NameFrequency{"John": 10, "Jane": 3}) {
+		t.Errorf("Expected only first names shared by 3 or more players, got %v", anonymized.FirstNames)
+	}
+	if !reflect.DeepEqual(anonymized.LastNames, NameFrequency{"Smith": 4}) {
+		t.Errorf("Expected only last names shared by 3 or more players, got %v", anonymized.LastNames)
+	}
+	if !reflect.DeepEqual(anonymized.PositionProfile, stats.PositionProfile) {
+		t.Error("Expected the position attributes to be kept")
+	}
+	if stats.FirstNames["Zebediah"] != 1 {
+		t.Error("Expected the original profile to be left untouched")
+	}
+}
+
+func TestBuildPlayerProfile(t *testing.T) {
+	t.Run("writes the source's profile", func(t *testing.T) {
+		t.Setenv("REAL_DATA_FILE", "")
+		path := filepath.Join(t.TempDir(), "profile.json")
+
+		if err := BuildPlayerProfile(path, false); err != nil {
+			t.Fatalf("Expected the profile to be built, got %v", err)
+		}
+		if _, err := loadPlayerProfile(path); err != nil {
+			t.Errorf("Expected the built profile to load, got %v", err)
+		}
+	})
+
+	t.Run("refuses to anonymize a tiny source", func(t *testing.T) {
+		t.Setenv("REAL_DATA_FILE", writeTempFile(t, "players.csv", "first_name,last_name,position,height,weight,jersey,age,years_of_experience\nA,B,QB,75,220,12,27,5\nC,D,RB,70,210,28,24,2\nE,F,WR,73,195,88,25,3\nG,H,TE,76,250,87,28,6\nI,J,PK,71,190,4,30,8\n"))
+
+		err := BuildPlayerProfile(filepath.Join(t.TempDir(), "profile.json"), true)
+		if err == nil || !strings.Contains(err.Error(), "too small to anonymize") {
+			t.Errorf("Expected the source to be too small to anonymize, got %v", err)
+		}
+	})
+}
+
+func TestCollectAndAggregateLoadsPlayerProfile(t *testing.T) {
+	profile := seededTestAttributes()
+	profile.FirstNames = NameFrequency{"Profiled": 1}
+	path := filepath.Join(t.TempDir(), "profile.json")
+	if err := writePlayerProfile(path, profile); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PLAYER_PROFILE", path)
+	t.Setenv("REAL_DATA_FILE", filepath.Join(t.TempDir(), "missing.json"))
+
+	stats, err := collectAndAggregatePlayerAttributes()
+	if err != nil {
+		t.Fatalf("Expected the profile to be loaded, got %v", err)
+	}
+
+	if !reflect.DeepEqual(stats.FirstNames, profile.FirstNames) {
+		t.Errorf("Expected the profile's names, got %v", stats.FirstNames)
+	}
+}
+
+func TestCollectAndAggregateRejectsUnusablePlayerProfile(t *testing.T) {
+	// A usable player source doesn't stand in for a profile that was asked for
+	t.Setenv("REAL_DATA_FILE", "")
+	t.Setenv("PLAYER_PROFILE", filepath.Join(t.TempDir(), "missing.json"))
+
+	if _, err := collectAndAggregatePlayerAttributes(); err == nil || !strings.Contains(err.Error(), "PLAYER_PROFILE") {
+		t.Errorf("Expected the missing profile to be reported, got %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// PlayerSource supplies the real players whose names and physical attributes synthetic players
+// are drawn from
+type PlayerSource interface {
+	Players() ([]PlayerStat, error)
+}
+
+// JSONPlayerSource reads a roster dump: an object whose athletes list holds players with
+// firstName, lastName, height, weight, jersey and age, plus position.abbreviation, status.type
+// and draft.year. Free agents are skipped.
+type JSONPlayerSource struct {
+	Path string
+}
+
+func (s JSONPlayerSource) Players() ([]PlayerStat, error) {
+	contents, err := os.ReadFile(s.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", s.Path, err)
+	}
+	var data map[string]any
+	if err := json.Unmarshal(contents, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", s.Path, err)
+	}
+	players, err := collectPlayerAttributes(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", s.Path, err)
+	}
+	return players, nil
+}
+
+// CSVPlayerSource reads a flat CSV with a header row, one player a row. position, first_name
+// and last_name are required; height, weight, jersey, age and years_of_experience are read when
+// present, and other columns are ignored.
+type CSVPlayerSource struct {
+	Path string
+}
+
+func (s CSVPlayerSource) Players() ([]PlayerStat, error) {
+	file, err := os.Open(s.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", s.Path, err)
+	}
+	defer file.Close()
+
+	players, err := readPlayerCSV(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", s.Path, err)
+	}
+	return players, nil
+}
+
+//go:embed sample_players.csv
+var samplePlayersCSV []byte
+
+// SamplePlayerSource is a bundled roster of made-up players, big enough to profile every position
+// the generators draw from. It lets generation run without the real data.
+type SamplePlayerSource struct{}
+
+func (SamplePlayerSource) Players() ([]PlayerStat, error) {
+	return readPlayerCSV(bytes.NewReader(samplePlayersCSV))
+}
+
+// readPlayerCSV reads players laid out as CSVPlayerSource describes
+func readPlayerCSV(r io.Reader) ([]PlayerStat, error) {
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the header: %w", err)
+	}
+	columns := make(map[string]int)
+	for i, column := range header {
+		columns[strings.TrimSpace(column)] = i
+	}
+	for _, required := range []string{"position", "first_name", "last_name"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("missing the %s column", required)
+		}
+	}
+
+	var players []PlayerStat
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return players, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		number := func(column string) (int, error) {
+			i, ok := columns[column]
+			if !ok || record[i] == "" {
+				return 0, nil
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(record[i]))
+			if err != nil {
+				return 0, fmt.Errorf("line %d: invalid %s %q", line, column, record[i])
+			}
+			return n, nil
+		}
+		player := PlayerStat{
+			FirstName: record[columns["first_name"]],
+			LastName:  record[columns["last_name"]],
+			Position:  strings.TrimSpace(record[columns["position"]]),
+		}
+		for _, field := range []struct {
+			column string
+			value  *int
+		}{
+			{"height", &player.Height},
+			{"weight", &player.Weight},
+			{"jersey", &player.Jersey},
+			{"age", &player.Age},
+			{"years_of_experience", &player.YearsOfExperience},
+		} {
+			if *field.value, err = number(field.column); err != nil {
+				return nil, err
+			}
+		}
+		players = append(players, player)
+	}
+}
+
+// playerSourceFromEnv picks the source REAL_DATA_FILE names: a .csv file is read as CSV and anything
+// else as a JSON roster dump. Without REAL_DATA_FILE the bundled sample is used.
+func playerSourceFromEnv() PlayerSource {
+	path := os.Getenv("REAL_DATA_FILE")
+	switch {
+	case path == "":
+		return SamplePlayerSource{}
+	case strings.EqualFold(filepath.Ext(path), ".csv"):
+		return CSVPlayerSource{Path: path}
+	default:
+		return JSONPlayerSource{Path: path}
+	}
+}
+
+// aggregatePlayerSource reads a source's players and aggregates them into a profile the
+// generators can draw from
+func aggregatePlayerSource(source PlayerSource) (AggregatedPlayerStats, error) {
+	players, err := source.Players()
+	if err != nil {
+		return AggregatedPlayerStats{}, err
+	}
+	stats := aggregatePlayerStats(players)
+	if err := validateProfile(stats); err != nil {
+		return AggregatedPlayerStats{}, fmt.Errorf("the player source %w", err)
+	}
+	return stats, nil
+}
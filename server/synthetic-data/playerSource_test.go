@@ -0,0 +1,160 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeTempFile writes contents to a file named name in a fresh temp directory and returns its path
+func writeTempFile(t *testing.T, name, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestJSONPlayerSource(t *testing.T) {
+	t.Run("reads the athletes", func(t *testing.T) {
+		path := writeTempFile(t, "real-data.json", `{"athletes": [
+			{"firstName": "John", "lastName": "Doe", "height": 75, "weight": 220, "jersey": "12", "age": 27,
+			 "position": {"abbreviation": "QB"}, "status": {"type": "active"}, "draft": {"year": 2020}},
+			{"firstName": "Free", "lastName": "Agent", "position": {"abbreviation": "RB"},
+			 "status": {"type": "free-agent"}, "draft": {"year": 2021}}
+		]}`)
+
+		players, err := JSONPlayerSource{Path: path}.Players()
+		if err != nil {
+			t.Fatalf("Expected the file to be read, got %v", err)
+		}
+		if len(players) != 1 || players[0].FirstName != "John" || players[0].Jersey != 12 || players[0].Position != "QB" {
+			t.Errorf("Expected John Doe alone, got %+v", players)
+		}
+	})
+
+	t.Run("reports what it can't read", func(t *testing.T) {
+		tests := map[string]string{
+			"missing file":   filepath.Join(t.TempDir(), "missing.json"),
+			"invalid JSON":   writeTempFile(t, "bad.json", "{"),
+			"wrong shape":    writeTempFile(t, "shape.json", `{"athletes": {}}`),
+			"no athletes":    writeTempFile(t, "empty.json", `{}`),
+			"top level list": writeTempFile(t, "list.json", `[]`),
+		}
+		for name, path := range tests {
+			if _, err := (JSONPlayerSource{Path: path}).Players(); err == nil {
+				t.Errorf("Expected %s to fail", name)
+			}
+		}
+	})
+}
+
+func TestCSVPlayerSource(t *testing.T) {
+	t.Run("reads columns by name", func(t *testing.T) {
+		path := writeTempFile(t, "players.csv", "position,last_name,first_name,college,age,jersey\nWR,Smith,Jane,State,24,88\nPK,Jones,Sam,Tech,,\n")
+
+		players, err := CSVPlayerSource{Path: path}.Players()
+		if err != nil {
+			t.Fatalf("Expected the file to be read, got %v", err)
+		}
+		if len(players) != 2 {
+			t.Fatalf("Expected 2 players, got %d", len(players))
+		}
+		want := PlayerStat{FirstName: "Jane", LastName: "Smith", Position: "WR", Age: 24, Jersey: 88}
+		if players[0] != want {
+			t.Errorf("Expected %+v, got %+v", want, players[0])
+		}
+		if players[1].Age != 0 || players[1].Height != 0 {
+			t.Errorf("Expected empty and missing columns to read as 0, got %+v", players[1])
+		}
+	})
+
+	t.Run("rejects a missing column", func(t *testing.T) {
+		path := writeTempFile(t, "players.csv", "first_name,last_name\nJane,Smith\n")
+		if _, err := (CSVPlayerSource{Path: path}).Players(); err == nil || !strings.Contains(err.Error(), "position") {
+			t.Errorf("Expected the missing position column to be reported, got %v", err)
+		}
+	})
+
+	t.Run("rejects a bad number", func(t *testing.T) {
+		path := writeTempFile(t, "players.csv", "first_name,last_name,position,height\nJane,Smith,WR,tall\n")
+		if _, err := (CSVPlayerSource{Path: path}).Players(); err == nil || !strings.Contains(err.Error(), "line 2") {
+			t.Errorf("Expected the bad height to be reported with its line, got %v", err)
+		}
+	})
+}
+
+func TestSamplePlayerSource(t *testing.T) {
+	stats, err := aggregatePlayerSource(SamplePlayerSource{})
+	if err != nil {
+		t.Fatalf("Expected the sample to cover every position, got %v", err)
+	}
+	for position, profile := range stats.PositionProfile {
+		if len(profile.Heights) < 3 || len(profile.Ages) < 3 {
+			t.Errorf("Expected the sample's %s to vary, got %d heights and %d ages", position, len(profile.Heights), len(profile.Ages))
+		}
+	}
+	if len(stats.FirstNames) < 20 || len(stats.LastNames) < 20 {
+		t.Errorf("Expected a spread of names, got %d first and %d last names", len(stats.FirstNames), len(stats.LastNames))
+	}
+}
+
+func TestAggregatePlayerSourceRequiresEveryPosition(t *testing.T) {
+	path := writeTempFile(t, "players.csv", "first_name,last_name,position\nA,B,QB\nC,D,RB\nE,F,WR\nG,H,TE\n")
+
+	if _, err := aggregatePlayerSource(CSVPlayerSource{Path: path}); err == nil || !strings.Contains(err.Error(), "no PK players") {
+		t.Errorf("Expected the missing kickers to be reported, got %v", err)
+	}
+}
+
+func TestPlayerSourceFromEnv(t *testing.T) {
+	tests := map[string]PlayerSource{
+		"":                     SamplePlayerSource{},
+		"/data/players.CSV":    CSVPlayerSource{Path: "/data/players.CSV"},
+		"/data/real-data.json": JSONPlayerSource{Path: "/data/real-data.json"},
+		"/data/real-data":      JSONPlayerSource{Path: "/data/real-data"},
+	}
+	for path, want := range tests {
+		t.Setenv("REAL_DATA_FILE", path)
+		if got := playerSourceFromEnv(); got != want {
+			t.Errorf("Expected REAL_DATA_FILE=%q to pick %#v, got %#v", path, want, got)
+		}
+	}
+}
+
+func TestCollectAndAggregatePlayerSource(t *testing.T) {
+	t.Run("draws from the sample without REAL_DATA_FILE", func(t *testing.T) {
+		t.Setenv("REAL_DATA_FILE", "")
+
+		stats, err := collectAndAggregatePlayerAttributes()
+		if err != nil {
+			t.Fatalf("Expected the sample to be aggregated, got %v", err)
+		}
+
+		sample, err := aggregatePlayerSource(SamplePlayerSource{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(stats.FirstNames) != len(sample.FirstNames) || len(stats.PositionProfile) != len(sample.PositionProfile) {
+			t.Errorf("Expected the sample's players, got %d first names over %d positions", len(stats.FirstNames), len(stats.PositionProfile))
+		}
+	})
+
+	t.Run("fails on a missing file", func(t *testing.T) {
+		t.Setenv("REAL_DATA_FILE", filepath.Join(t.TempDir(), "missing.json"))
+
+		if _, err := collectAndAggregatePlayerAttributes(); err == nil || !strings.Contains(err.Error(), "REAL_DATA_FILE") {
+			t.Errorf("Expected the missing file to be reported, got %v", err)
+		}
+	})
+
+	t.Run("fails on an unusable file", func(t *testing.T) {
+		t.Setenv("REAL_DATA_FILE", writeTempFile(t, "players.csv", "first_name,last_name,position\nA,B,QB\n"))
+
+		if _, err := collectAndAggregatePlayerAttributes(); err == nil || !strings.Contains(err.Error(), "no RB players") {
+			t.Errorf("Expected the file's missing positions to be reported, got %v", err)
+		}
+	})
+}
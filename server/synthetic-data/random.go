@@ -0,0 +1,28 @@
+package main
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// newRandom creates the source every random draw of a run is made from, from names and skills to
+// injuries and game stats. The generators are handed the one source through their configs, so
+// the same seed always generates the same leagues, players, careers and IDs.
+func newRandom(seed int64) *rand.Rand {
+	return rand.New(rand.NewSource(seed))
+}
+
+// clockSeededRandom creates the source of an unseeded run. It's seeded from the clock, so every
+// run generates a different league.
+func clockSeededRandom() *rand.Rand {
+	return newRandom(time.Now().UnixNano())
+}
+
+// uuidsFrom generates random (version 4) UUIDs from rng, so seeded runs generate the same IDs
+func uuidsFrom(rng *rand.Rand) UUIDGenerator {
+	return func() string {
+		return uuid.Must(uuid.NewRandomFromReader(rng)).String()
+	}
+}
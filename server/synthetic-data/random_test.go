@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sync"
+	"testing"
+)
+
+// seededTestAggregator hands the generators seededTestAttributes
+func seededTestAggregator() (AggregatedPlayerStats, error) {
+	return seededTestAttributes(), nil
+}
+
+// seededTestAttributes stands in for the real player data in seeded generation tests
+func seededTestAttributes() AggregatedPlayerStats {
+	profile := func(jersey, height, weight int) *PositionProfile {
+		return &PositionProfile{
+			Jerseys:           map[int]int{jersey: 5, jersey + 1: 3},
+			Heights:           map[int]int{height: 5, height + 2: 5},
+			Weights:           map[int]int{weight: 5, weight + 15: 5},
+			Ages:              map[int]int{23: 3, 27: 5, 31: 2},
+			YearsOfExperience: map[int]int{1: 3, 5: 5, 9: 2},
+		}
+	}
+	return AggregatedPlayerStats{
+		PositionProfile: map[string]*PositionProfile{
+			"QB": profile(12, 75, 220),
+			"RB": profile(28, 70, 210),
+			"WR": profile(88, 73, 195),
+			"TE": profile(87, 76, 250),
+			"PK": profile(4, 71, 190),
+		},
+		FirstNames: map[string]int{"John": 10, "Jane": 5, "Sam": 3},
+		LastNames:  map[string]int{"Doe": 10, "Smith": 5, "Jones": 2},
+	}
+}
+
+// generateSeededLeagues seeds the generators and generates a slice of every league: two football
+// teams with their careers and defenses, and a team each of basketball and baseball
+func generateSeededLeagues(t *testing.T, seed int64) []byte {
+	t.Helper()
+	rng := newRandom(seed)
+	// The player stats are aggregated once per process; aggregate the test data instead
+	generatorsOnce = sync.Once{}
+	getPlayerGenerators(seededTestAggregator, rng)
+
+	// Pin the season so the leagues don't change with the calendar
+	generator := NewLeagueDataGenerator(DefaultLeagueDefinition, rng, yearClock(2025))
+
+	league := generator.GenerateLeague()
+	teams := league.Teams[:2]
+	var players []Player
+	var careers []PlayerYearlyStatsFootball
+	for _, team := range teams {
+		roster := generator.GenerateRoster(team.ID)
+		players = append(players, flattenRoster(roster)...)
+		careers = append(careers, generator.GenerateCareers(roster)...)
+	}
+	careers = append(careers, generator.GenerateDefenseCareers(teams, players, careers)...)
+
+	basketball := generator.GenerateBasketballLeague()
+	basketballRoster := generator.GenerateBasketballRoster(basketball.Teams[0].ID)
+	baseball := generator.GenerateBaseballLeague()
+	baseballRoster := generator.GenerateBaseballRoster(baseball.Teams[0].ID)
+
+	data, err := json.Marshal(map[string]any{
+		"league":            league,
+		"players":           players,
+		"careers":           careers,
+		"basketball":        basketball,
+		"basketballPlayers": basketballRoster,
+		"basketballCareers": generator.GenerateBasketballCareers(basketballRoster),
+		"baseball":          baseball,
+		"baseballPlayers":   baseballRoster,
+		"baseballCareers":   generator.GenerateBaseballCareers(baseballRoster),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestSeededGenerationIsReproducible(t *testing.T) {
+	first := generateSeededLeagues(t, 42)
+	second := generateSeededLeagues(t, 42)
+	if !bytes.Equal(first, second) {
+		t.Error("Expected the same seed to generate identical leagues")
+	}
+
+	other := generateSeededLeagues(t, 43)
+	if bytes.Equal(first, other) {
+		t.Error("Expected a different seed to generate a different league")
+	}
+}
+
+func TestParseGeneratorFlagsPinsSeason(t *testing.T) {
+	options := parseGeneratorFlags("export", []string{"--year", "2031"})
+
+	if year := options.Clock.Now().Year(); year != 2031 {
+		t.Fatalf("Expected --year to pin the season to 2031, got %d", year)
+	}
+	player := NewLeagueDataGenerator(DefaultLeagueDefinition, options.Rand, options.Clock).GenerateRoster("team-1").QB[0]
+	if season := player.DraftYear + player.YearsOfExperience; season != 2031 {
+		t.Errorf("Expected players to be generated going into 2031, got %d", season)
+	}
+}
+
+func TestParseGeneratorFlagsSeedsTheSource(t *testing.T) {
+	first := parseGeneratorFlags("seed", []string{"--seed", "42"})
+	second := parseGeneratorFlags("seed", []string{"--seed", "42"})
+
+	if a, b := first.Rand.Int63(), second.Rand.Int63(); a != b {
+		t.Errorf("Expected --seed 42 to seed the same source twice, got draws %d and %d", a, b)
+	}
+}
+
+func TestUUIDsFrom(t *testing.T) {
+	newUUID := uuidsFrom(newRandom(7))
+	first := []string{newUUID(), newUUID()}
+	newUUID = uuidsFrom(newRandom(7))
+	second := []string{newUUID(), newUUID()}
+
+	if first[0] != second[0] || first[1] != second[1] {
+		t.Errorf("Expected seeded UUIDs to repeat, got %v and %v", first, second)
+	}
+	if first[0] == first[1] {
+		t.Errorf("Expected distinct UUIDs, got %s twice", first[0])
+	}
+	v4 := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	if !v4.MatchString(first[0]) {
+		t.Errorf("Expected a version 4 UUID, got %s", first[0])
+	}
+}
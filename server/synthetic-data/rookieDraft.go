@@ -0,0 +1,156 @@
+package main
+
+import (
+	"cmp"
+	"math"
+	"math/rand"
+	"slices"
+)
+
+// TeamRecord is a pro team's record over a simulated season
+type TeamRecord struct {
+	TeamID string
+	Wins   int
+	Losses int
+	Ties   int
+}
+
+// winPercentage counts ties as half a win. A team that hasn't played is at .500.
+func (r TeamRecord) winPercentage() float64 {
+	games := r.Wins + r.Losses + r.Ties
+	if games == 0 {
+		return 0.5
+	}
+	return (float64(r.Wins) + float64(r.Ties)/2) / float64(games)
+}
+
+// gamePoints is the score a team's players put up in a game. Passing touchdowns are left out
+// since the receiver's touchdown already counts them.
+func gamePoints(stats FootballStats) int {
+	return 6*(stats.RushingTDs+stats.ReceivingTDs) + 3*stats.FieldGoalsMade + stats.ExtraPointsMade
+}
+
+// ProGame is a game between two pro teams in a simulated season
+type ProGame struct {
+	Week       int
+	HomeTeamID string
+	AwayTeamID string
+}
+
+// scheduleSeason pairs up the pro teams for every week of a season. Each week the teams not on
+// bye are paired at random; with an odd number of teams one sits the week out.
+func scheduleSeason(teams []Team, weeks int, rng *rand.Rand) []ProGame {
+	var games []ProGame
+	for week := 1; week <= weeks; week++ {
+		var playing []string
+		for _, team := range teams {
+			if team.ByeWeek != week {
+				playing = append(playing, team.ID)
+			}
+		}
+		rng.Shuffle(len(playing), func(i, j int) { playing[i], playing[j] = playing[j], playing[i] })
+
+		for i := 0; i+1 < len(playing); i += 2 {
+			games = append(games, ProGame{Week: week, HomeTeamID: playing[i], AwayTeamID: playing[i+1]})
+		}
+	}
+	return games
+}
+
+// simulateRecords plays out a season's games between the pro teams: the team whose players
+// scored more wins. weeklyPoints maps team IDs to their score in each week.
+func simulateRecords(teams []Team, games []ProGame, weeklyPoints map[string]map[int]int) []TeamRecord {
+	records := make(map[string]*TeamRecord, len(teams))
+	for _, team := range teams {
+		records[team.ID] = &TeamRecord{TeamID: team.ID}
+	}
+
+	for _, game := range games {
+		home, away := records[game.HomeTeamID], records[game.AwayTeamID]
+		homePoints, awayPoints := weeklyPoints[home.TeamID][game.Week], weeklyPoints[away.TeamID][game.Week]
+		switch {
+		case homePoints > awayPoints:
+			home.Wins++
+			away.Losses++
+		case homePoints < awayPoints:
+			home.Losses++
+			away.Wins++
+		default:
+			home.Ties++
+			away.Ties++
+		}
+	}
+
+	result := make([]TeamRecord, 0, len(teams))
+	for _, team := range teams {
+		result = append(result, *records[team.ID])
+	}
+	return result
+}
+
+// draftOrder returns team IDs worst record first. Teams with the same record are ordered at random.
+func draftOrder(records []TeamRecord, rng *rand.Rand) []string {
+	ordered := slices.Clone(records)
+	rng.Shuffle(len(ordered), func(i, j int) { ordered[i], ordered[j] = ordered[j], ordered[i] })
+	slices.SortStableFunc(ordered, func(a, b TeamRecord) int {
+		return cmp.Compare(a.winPercentage(), b.winPercentage())
+	})
+
+	order := make([]string, len(ordered))
+	for i, record := range ordered {
+		order[i] = record.TeamID
+	}
+	return order
+}
+
+// scoutingError is the standard deviation of the gap between a prospect's scouted and true skill
+const scoutingError = 0.12
+
+// scoutSkill returns what scouts make of a prospect with the given true skill
+func scoutSkill(trueSkill float64, rng *rand.Rand) float64 {
+	return clampFloat(trueSkill+rng.NormFloat64()*scoutingError, 0.15, 0.95)
+}
+
+// prospectsPerNeed is how many prospects enter the draft at a position for each roster spot teams need to fill
+const prospectsPerNeed = 1.5
+
+// classSize returns how many prospects to generate at a position for the given number of open roster spots
+func classSize(needs int) int {
+	return int(math.Ceil(float64(needs) * prospectsPerNeed))
+}
+
+// runRookieDraft has the teams pick in order, round after round, each taking the prospect with the
+// best scouted skill at a position they still need. needs maps team IDs to the number of players
+// they need at each position and is used up by the draft. The draft ends when no team can make a
+// pick. The drafted players are returned with their team, round and pick within the round set.
+func runRookieDraft(order []string, needs map[string]map[string]int, class []Player) []Player {
+	available := slices.Clone(class)
+	slices.SortStableFunc(available, func(a, b Player) int {
+		return cmp.Compare(b.ScoutedSkill, a.ScoutedSkill)
+	})
+
+	var drafted []Player
+	for round := 1; ; round++ {
+		pick := 0
+		for _, teamID := range order {
+			index := slices.IndexFunc(available, func(p Player) bool {
+				return needs[teamID][p.Position] > 0
+			})
+			if index == -1 {
+				continue
+			}
+
+			pick++
+			rookie := available[index]
+			available = slices.Delete(available, index, index+1)
+			needs[teamID][rookie.Position]--
+			rookie.TeamID = teamID
+			rookie.DraftRound = round
+			rookie.DraftPick = pick
+			drafted = append(drafted, rookie)
+		}
+		if pick == 0 {
+			return drafted
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestTeamRecordWinPercentage(t *testing.T) {
+	tests := []struct {
+		record   TeamRecord
+		expected float64
+	}{
+		{TeamRecord{Wins: 12, Losses: 5}, 12.0 / 17.0},
+		{TeamRecord{Wins: 8, Losses: 8, Ties: 1}, 0.5},
+		{TeamRecord{}, 0.5},
+	}
+
+	for _, tt := range tests {
+		if result := tt.record.winPercentage(); result != tt.expected {
+			t.Errorf("Expected %+v to win %.3f, got %.3f", tt.record, tt.expected, result)
+		}
+	}
+}
+
+func TestGamePoints(t *testing.T) {
+	stats := FootballStats{PassingTDs: 2, RushingTDs: 1, ReceivingTDs: 2, FieldGoalsMade: 2, ExtraPointsMade: 3}
+
+	if result := gamePoints(stats); result != 27 {
+		t.Errorf("Expected 27 points, got %d", result)
+	}
+}
+
+func TestScheduleSeason(t *testing.T) {
+	teams := []Team{{ID: "a", ByeWeek: 2}, {ID: "b", ByeWeek: 3}, {ID: "c", ByeWeek: 2}, {ID: "d", ByeWeek: 3}}
+
+	games := scheduleSeason(teams, 3, rand.New(rand.NewSource(1)))
+
+	// Everyone plays week 1, and each bye week leaves two teams for a single game
+	if len(games) != 4 {
+		t.Fatalf("Expected 4 games, got %d", len(games))
+	}
+	for _, game := range games {
+		for _, team := range teams {
+			if team.ByeWeek == game.Week && (game.HomeTeamID == team.ID || game.AwayTeamID == team.ID) {
+				t.Errorf("Expected %s to sit out its bye in week %d", team.ID, game.Week)
+			}
+		}
+	}
+}
+
+func TestSimulateRecords(t *testing.T) {
+	teams := []Team{{ID: "strong", ByeWeek: 2}, {ID: "weak", ByeWeek: 3}, {ID: "middle", ByeWeek: 2}}
+	weeklyPoints := map[string]map[int]int{
+		"strong": {1: 30, 3: 30},
+		"weak":   {1: 10, 2: 10},
+		"middle": {1: 20, 3: 20},
+	}
+
+	records := simulateRecords(teams, scheduleSeason(teams, 3, rand.New(rand.NewSource(1))), weeklyPoints)
+
+	games := 0
+	for _, record := range records {
+		games += record.Wins + record.Losses + record.Ties
+		if record.TeamID == "strong" && record.Losses > 0 {
+			t.Errorf("Expected the strong team never to lose, got %+v", record)
+		}
+		if record.TeamID == "weak" && record.Wins > 0 {
+			t.Errorf("Expected the weak team never to win, got %+v", record)
+		}
+	}
+	// Week 1 has one game with a team sitting out, week 2 leaves the weak team without an opponent
+	// and week 3 pits the strong team against the middle one
+	if games != 4 {
+		t.Errorf("Expected 2 games played (4 team results), got %d results", games)
+	}
+}
+
+func TestDraftOrder(t *testing.T) {
+	records := []TeamRecord{
+		{TeamID: "champs", Wins: 15, Losses: 2},
+		{TeamID: "worst", Wins: 2, Losses: 15},
+		{TeamID: "middle", Wins: 9, Losses: 8},
+	}
+
+	order := draftOrder(records, rand.New(rand.NewSource(1)))
+
+	if !slices.Equal(order, []string{"worst", "middle", "champs"}) {
+		t.Errorf("Expected worst record to pick first, got %v", order)
+	}
+}
+
+func TestScoutSkill(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	misses := 0
+	for range 200 {
+		scouted := scoutSkill(0.5, rng)
+		if scouted < 0.15 || scouted > 0.95 {
+			t.Fatalf("Expected scouted skill within 0.15 and 0.95, got %.3f", scouted)
+		}
+		if scouted != 0.5 {
+			misses++
+		}
+	}
+	if misses == 0 {
+		t.Error("Expected scouts to misjudge prospects")
+	}
+}
+
+func TestClassSize(t *testing.T) {
+	if result := classSize(0); result != 0 {
+		t.Errorf("Expected no prospects without open spots, got %d", result)
+	}
+	if result := classSize(5); result != 8 {
+		t.Errorf("Expected 8 prospects for 5 open spots, got %d", result)
+	}
+}
+
+func TestRunRookieDraft(t *testing.T) {
+	class := []Player{
+		{ID: "qb-high", Position: "QB", ScoutedSkill: 0.9, Skill: 0.4},
+		{ID: "rb-high", Position: "RB", ScoutedSkill: 0.8},
+		{ID: "rb-mid", Position: "RB", ScoutedSkill: 0.6},
+		{ID: "rb-low", Position: "RB", ScoutedSkill: 0.3},
+		{ID: "wr-mid", Position: "WR", ScoutedSkill: 0.7},
+	}
+	needs := map[string]map[string]int{
+		"first":  {"RB": 2},
+		"second": {"QB": 1, "WR": 1},
+	}
+
+	drafted := runRookieDraft([]string{"first", "second"}, needs, class)
+
+	expected := []struct {
+		id    string
+		team  string
+		round int
+		pick  int
+	}{
+		{"rb-high", "first", 1, 1},
+		{"qb-high", "second", 1, 2},
+		{"rb-mid", "first", 2, 1},
+		{"wr-mid", "second", 2, 2},
+	}
+	if len(drafted) != len(expected) {
+		t.Fatalf("Expected %d picks, got %d", len(expected), len(drafted))
+	}
+	for i, e := range expected {
+		rookie := drafted[i]
+		if rookie.ID != e.id || rookie.TeamID != e.team || rookie.DraftRound != e.round || rookie.DraftPick != e.pick {
+			t.Errorf("Expected pick %d to be %s by %s (round %d, pick %d), got %s by %s (round %d, pick %d)",
+				i+1, e.id, e.team, e.round, e.pick, rookie.ID, rookie.TeamID, rookie.DraftRound, rookie.DraftPick)
+		}
+	}
+	if drafted[1].Skill != 0.4 {
+		t.Errorf("Expected the draft to keep the hidden true skill, got %.2f", drafted[1].Skill)
+	}
+	if needs["first"]["RB"] != 0 || needs["second"]["QB"] != 0 {
+		t.Errorf("Expected every need to be filled, got %v", needs)
+	}
+}
@@ -2,7 +2,11 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/jackc/pgx/v5"
@@ -45,7 +49,13 @@ func NewMockDataGenerator() *MockDataGenerator {
 			PK: []Player{},
 		},
 		CareerData: []PlayerYearlyStatsFootball{
-			{PlayerID: "player-1", Year: 2024, Stats: FootballYearlyStats{Total: FootballStats{PassingYards: 4000, PassingTDs: 30}}},
+			{PlayerID: "player-1", Year: 2024, Stats: FootballYearlyStats{
+				Total: FootballStats{PassingYards: 4000, PassingTDs: 30},
+				Weeks: []FootballWeekStats{
+					{Week: 1, Stats: FootballStats{PassingYards: 250, PassingTDs: 2}},
+					{Week: 2, Stats: FootballStats{PassingYards: 310, PassingTDs: 3}},
+				},
+			}},
 		},
 	}
 }
@@ -60,14 +70,103 @@ func (m *MockDataGenerator) GenerateRoster(teamID string) FootballTeamRoster {
 	return m.RosterData
 }
 
-func (m *MockDataGenerator) GenerateCareer(player Player) []PlayerYearlyStatsFootball {
-	m.CallCounts["GenerateCareer"]++
+func (m *MockDataGenerator) GenerateCareers(roster FootballTeamRoster) []PlayerYearlyStatsFootball {
+	m.CallCounts["GenerateCareers"]++
 	return m.CareerData
 }
 
+func (m *MockDataGenerator) GenerateDefenseCareers(teams []Team, players []Player, careers []PlayerYearlyStatsFootball) []PlayerYearlyStatsFootball {
+	m.CallCounts["GenerateDefenseCareers"]++
+	return nil
+}
+
+// MockBasketballGenerator provides a controlled basketball league
+type MockBasketballGenerator struct {
+	CallCounts map[string]int
+}
+
+func NewMockBasketballGenerator() *MockBasketballGenerator {
+	return &MockBasketballGenerator{CallCounts: make(map[string]int)}
+}
+
+func (m *MockBasketballGenerator) GenerateBasketballLeague() LeagueFlat {
+	m.CallCounts["GenerateBasketballLeague"]++
+	return LeagueFlat{
+		Conferences: []Conference{{ID: "bb-conf-1", Name: "Test Basketball Conference"}},
+		Divisions:   []Division{{ID: "bb-div-1", Name: "Test Basketball Division", ConferenceID: "bb-conf-1"}},
+		Teams:       []Team{{ID: "bb-team-1", City: "Test City", Name: "Hoopers", Abbr: "HOO", DivisionID: "bb-div-1", SportType: SportBasketball}},
+	}
+}
+
+func (m *MockBasketballGenerator) GenerateBasketballRoster(teamID string) []Player {
+	m.CallCounts["GenerateBasketballRoster"]++
+	return []Player{
+		{ID: "pg-1", Position: "PG", TeamID: teamID, Status: "ACTIVE"},
+		{ID: "pg-2", Position: "PG", TeamID: teamID, Status: "ACTIVE"},
+		{ID: "c-1", Position: "C", TeamID: teamID, Status: "ACTIVE"},
+	}
+}
+
+func (m *MockBasketballGenerator) GenerateBasketballCareers(roster []Player) []PlayerYearlyStatsBasketball {
+	m.CallCounts["GenerateBasketballCareers"]++
+	return []PlayerYearlyStatsBasketball{
+		{PlayerID: "pg-1", Year: 2024, Stats: BasketballYearlyStats{
+			Total:       BasketballStats{Points: 40, Assists: 12},
+			GamesPlayed: 2,
+			Weeks:       []BasketballWeekStats{{Week: 1, Games: 2, Stats: BasketballStats{Points: 40, Assists: 12}}},
+			Injuries:    []Injury{{Week: 1, GamesMissed: 3, Type: "Ankle sprain", Severity: "MINOR"}},
+		}},
+		{PlayerID: "c-1", Year: 2024, Stats: BasketballYearlyStats{OutAtSeasonEnd: true}},
+	}
+}
+
+// MockBaseballGenerator provides a controlled baseball league
+type MockBaseballGenerator struct {
+	CallCounts map[string]int
+}
+
+func NewMockBaseballGenerator() *MockBaseballGenerator {
+	return &MockBaseballGenerator{CallCounts: make(map[string]int)}
+}
+
+func (m *MockBaseballGenerator) GenerateBaseballLeague() LeagueFlat {
+	m.CallCounts["GenerateBaseballLeague"]++
+	return LeagueFlat{
+		Conferences: []Conference{{ID: "bsb-conf-1", Name: "Test Baseball League"}},
+		Divisions:   []Division{{ID: "bsb-div-1", Name: "Test Baseball Division", ConferenceID: "bsb-conf-1"}},
+		Teams:       []Team{{ID: "bsb-team-1", City: "Test City", Name: "Sluggers", Abbr: "SLG", DivisionID: "bsb-div-1", SportType: SportBaseball}},
+	}
+}
+
+func (m *MockBaseballGenerator) GenerateBaseballRoster(teamID string) []Player {
+	m.CallCounts["GenerateBaseballRoster"]++
+	return []Player{
+		{ID: "sp-1", Position: "SP", TeamID: teamID, Status: "ACTIVE"},
+		{ID: "ss-1", Position: "SS", TeamID: teamID, Status: "ACTIVE"},
+	}
+}
+
+func (m *MockBaseballGenerator) GenerateBaseballCareers(roster []Player) []PlayerYearlyStatsBaseball {
+	m.CallCounts["GenerateBaseballCareers"]++
+	return []PlayerYearlyStatsBaseball{
+		{PlayerID: "sp-1", Year: 2024, Stats: BaseballYearlyStats{
+			Total:       BaseballStats{OutsPitched: 18, Strikeouts: 7},
+			GamesPlayed: 1,
+			Weeks:       []BaseballWeekStats{{Week: 1, Games: 1, Stats: BaseballStats{OutsPitched: 18, Strikeouts: 7}}},
+		}},
+		{PlayerID: "ss-1", Year: 2024, Stats: BaseballYearlyStats{
+			Total:       BaseballStats{AtBats: 4, Hits: 2},
+			GamesPlayed: 1,
+			Weeks:       []BaseballWeekStats{{Week: 1, Games: 1, Stats: BaseballStats{AtBats: 4, Hits: 2}}},
+		}},
+	}
+}
+
 // MockTx implements pgx.Tx for testing
 type MockTx struct {
 	ExecCalls      []MockExecCall
+	Batches        int   // batches sent; each queued query is recorded in ExecCalls
+	QueryRowValues []any // scanned by every QueryRow, in column order (default: zero values)
 	ExecErr        error
 	ExecErrOnCall  int // Return error on this call number (0 = never)
 	currentCall    int
@@ -104,21 +203,64 @@ func (m *MockTx) Rollback(ctx context.Context) error {
 	return nil
 }
 
+// SendBatch runs each queued query through Exec, so batched inserts are recorded and fail like single ones
+func (m *MockTx) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	m.Batches++
+	results := &mockBatchResults{}
+	for _, query := range b.QueuedQueries {
+		_, err := m.Exec(ctx, query.SQL, query.Arguments...)
+		results.errs = append(results.errs, err)
+	}
+	return results
+}
+
 // Implement remaining pgx.Tx interface methods (not used in tests)
 func (m *MockTx) Begin(ctx context.Context) (pgx.Tx, error) { return nil, nil }
 func (m *MockTx) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
 	return 0, nil
 }
-func (m *MockTx) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults { return nil }
-func (m *MockTx) LargeObjects() pgx.LargeObjects                               { return pgx.LargeObjects{} }
+func (m *MockTx) LargeObjects() pgx.LargeObjects { return pgx.LargeObjects{} }
 func (m *MockTx) Prepare(ctx context.Context, name, sql string) (*pgconn.StatementDescription, error) {
 	return nil, nil
 }
 func (m *MockTx) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
 	return nil, nil
 }
-func (m *MockTx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row { return nil }
-func (m *MockTx) Conn() *pgx.Conn                                               { return nil }
+func (m *MockTx) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return mockRow{values: m.QueryRowValues}
+}
+func (m *MockTx) Conn() *pgx.Conn { return nil }
+
+// mockRow scans its values into the destinations, leaving any it has no value for untouched
+type mockRow struct {
+	values []any
+}
+
+func (r mockRow) Scan(dest ...any) error {
+	for i, value := range r.values {
+		if i < len(dest) {
+			reflect.ValueOf(dest[i]).Elem().Set(reflect.ValueOf(value))
+		}
+	}
+	return nil
+}
+
+// mockBatchResults hands back the results of a MockTx batch in the order the queries were queued
+type mockBatchResults struct {
+	errs []error
+	next int
+}
+
+func (r *mockBatchResults) Exec() (pgconn.CommandTag, error) {
+	if r.next >= len(r.errs) {
+		return pgconn.CommandTag{}, errors.New("no more results in batch")
+	}
+	r.next++
+	return pgconn.CommandTag{}, r.errs[r.next-1]
+}
+func (r *mockBatchResults) Query() (pgx.Rows, error) { return nil, nil }
+func (r *mockBatchResults) QueryRow() pgx.Row        { return nil }
+func (r *mockBatchResults) Close() error             { return nil }
 
 // =============================================================================
 // TESTS
@@ -134,6 +276,12 @@ func TestNewDatabaseSeeder(t *testing.T) {
 		if seeder.logger == nil {
 			t.Error("Expected logger to have a default")
 		}
+		if seeder.basketballGenerator == nil || seeder.baseballGenerator == nil {
+			t.Error("Expected the default generator to generate basketball and baseball too")
+		}
+		if seeder.universe != DefaultUniverse {
+			t.Errorf("Expected the %s universe to be seeded, got %s", DefaultUniverse, seeder.universe)
+		}
 	})
 
 	t.Run("with custom generator", func(t *testing.T) {
@@ -145,6 +293,9 @@ func TestNewDatabaseSeeder(t *testing.T) {
 		if seeder.generator != mockGen {
 			t.Error("Expected custom generator to be used")
 		}
+		if seeder.basketballGenerator != nil || seeder.baseballGenerator != nil {
+			t.Error("Expected no basketball or baseball league from a football-only generator")
+		}
 	})
 
 	t.Run("with quiet mode", func(t *testing.T) {
@@ -192,9 +343,15 @@ func TestDatabaseSeederSeed(t *testing.T) {
 		if result.PlayersInserted != 1 {
 			t.Errorf("Expected 1 player, got %d", result.PlayersInserted)
 		}
+		if result.DepthChartInserted != 1 {
+			t.Errorf("Expected 1 depth chart entry, got %d", result.DepthChartInserted)
+		}
 		if result.YearlyStatsInserted != 1 {
 			t.Errorf("Expected 1 yearly stat, got %d", result.YearlyStatsInserted)
 		}
+		if result.WeeklyStatsInserted != 2 {
+			t.Errorf("Expected 2 weekly stats, got %d", result.WeeklyStatsInserted)
+		}
 
 		// Verify generator was called
 		if mockGen.CallCounts["GenerateLeague"] != 1 {
@@ -203,8 +360,79 @@ func TestDatabaseSeederSeed(t *testing.T) {
 		if mockGen.CallCounts["GenerateRoster"] != 1 {
 			t.Error("Expected GenerateRoster to be called once per team")
 		}
-		if mockGen.CallCounts["GenerateCareer"] != 1 {
-			t.Error("Expected GenerateCareer to be called once per player")
+		if mockGen.CallCounts["GenerateCareers"] != 1 {
+			t.Error("Expected GenerateCareers to be called once per team")
+		}
+	})
+
+	t.Run("with basketball league", func(t *testing.T) {
+		mockGen := NewMockDataGenerator()
+		basketballGen := NewMockBasketballGenerator()
+		mockTx := &MockTx{}
+
+		seeder := NewDatabaseSeeder(SeederConfig{
+			DataGenerator:       mockGen,
+			BasketballGenerator: basketballGen,
+			Quiet:               true,
+		})
+
+		result, err := seeder.Seed(context.Background(), mockTx)
+
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result.TeamsInserted != 2 {
+			t.Errorf("Expected 2 teams, got %d", result.TeamsInserted)
+		}
+		if result.PlayersInserted != 4 {
+			t.Errorf("Expected 4 players, got %d", result.PlayersInserted)
+		}
+		if result.DepthChartInserted != 4 {
+			t.Errorf("Expected 4 depth chart entries, got %d", result.DepthChartInserted)
+		}
+		if result.YearlyStatsInserted != 3 {
+			t.Errorf("Expected 3 yearly stats, got %d", result.YearlyStatsInserted)
+		}
+		if result.WeeklyStatsInserted != 3 {
+			t.Errorf("Expected 3 weekly stats, got %d", result.WeeklyStatsInserted)
+		}
+		if result.InjuriesInserted != 1 {
+			t.Errorf("Expected 1 injury, got %d", result.InjuriesInserted)
+		}
+		if basketballGen.CallCounts["GenerateBasketballRoster"] != 1 || basketballGen.CallCounts["GenerateBasketballCareers"] != 1 {
+			t.Errorf("Expected one roster and one set of careers per basketball team, got %v", basketballGen.CallCounts)
+		}
+
+		injured := newUniverseScope(DefaultUniverse).id("c-1")
+		for _, call := range mockTx.ExecCalls {
+			if strings.HasPrefix(call.SQL, "INSERT INTO players") && call.Args[0] == injured && call.Args[11] != "INJURED" {
+				t.Errorf("Expected c-1 to be stored INJURED, got %v", call.Args[11])
+			}
+		}
+	})
+
+	t.Run("with baseball league", func(t *testing.T) {
+		baseballGen := NewMockBaseballGenerator()
+
+		seeder := NewDatabaseSeeder(SeederConfig{
+			DataGenerator:     NewMockDataGenerator(),
+			BaseballGenerator: baseballGen,
+			Quiet:             true,
+		})
+
+		result, err := seeder.Seed(context.Background(), &MockTx{})
+
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if result.TeamsInserted != 2 || result.PlayersInserted != 3 {
+			t.Errorf("Expected 2 teams and 3 players, got %d and %d", result.TeamsInserted, result.PlayersInserted)
+		}
+		if result.YearlyStatsInserted != 3 || result.WeeklyStatsInserted != 4 {
+			t.Errorf("Expected 3 yearly and 4 weekly stats, got %d and %d", result.YearlyStatsInserted, result.WeeklyStatsInserted)
+		}
+		if baseballGen.CallCounts["GenerateBaseballLeague"] != 1 {
+			t.Error("Expected GenerateBaseballLeague to be called once")
 		}
 	})
 
@@ -226,12 +454,201 @@ func TestDatabaseSeederSeed(t *testing.T) {
 		if err == nil {
 			t.Fatal("Expected error, got nil")
 		}
-		if !errors.Is(err, mockTx.ExecErr) && err.Error() != "failed to purge database: failed to purge table fantasy_rosters: purge failed" {
+		if !errors.Is(err, mockTx.ExecErr) && err.Error() != "failed to purge universe: failed to purge table rankings: purge failed" {
 			t.Errorf("Expected purge error, got: %v", err)
 		}
 	})
 }
 
+func TestInsertWeeklyStats(t *testing.T) {
+	mockTx := &MockTx{}
+	stats := []PlayerYearlyStatsFootball{
+		{PlayerID: "player-1", Year: 2023, Stats: FootballYearlyStats{Weeks: []FootballWeekStats{
+			{Week: 1, Stats: FootballStats{RushingYards: 80}},
+			{Week: 3, Stats: FootballStats{RushingYards: 45}},
+		}}},
+		{PlayerID: "player-2", Year: 2023, Stats: FootballYearlyStats{}},
+	}
+
+	inserted, err := insertWeeklyStats(context.Background(), mockTx, stats)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if inserted != 2 {
+		t.Errorf("Expected 2 rows inserted, got %d", inserted)
+	}
+	if len(mockTx.ExecCalls) != 2 {
+		t.Fatalf("Expected 2 exec calls, got %d", len(mockTx.ExecCalls))
+	}
+
+	// Week numbers should be passed through, not re-numbered
+	if week := mockTx.ExecCalls[1].Args[2]; week != 3 {
+		t.Errorf("Expected second row to be week 3, got %v", week)
+	}
+}
+
+func TestInsertPlayersBatchesRows(t *testing.T) {
+	t.Run("sends the rows a batch at a time", func(t *testing.T) {
+		mockTx := &MockTx{}
+		players := make([]Player, insertBatchSize*2+1)
+		for i := range players {
+			players[i] = Player{ID: fmt.Sprintf("player-%d", i), Position: "RB"}
+		}
+
+		if err := insertPlayers(context.Background(), mockTx, players); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if len(mockTx.ExecCalls) != len(players) {
+			t.Errorf("Expected every player to be inserted, got %d of %d", len(mockTx.ExecCalls), len(players))
+		}
+		if mockTx.Batches != 3 {
+			t.Errorf("Expected 3 batches, got %d", mockTx.Batches)
+		}
+	})
+
+	t.Run("reports the row that failed", func(t *testing.T) {
+		mockTx := &MockTx{ExecErr: errors.New("duplicate key"), ExecErrOnCall: 2}
+		players := []Player{
+			{ID: "player-1", FirstName: "Ada", LastName: "Lovelace"},
+			{ID: "player-2", FirstName: "Alan", LastName: "Turing"},
+			{ID: "player-3", FirstName: "Grace", LastName: "Hopper"},
+		}
+
+		err := insertPlayers(context.Background(), mockTx, players)
+		if err == nil || !strings.Contains(err.Error(), "player Alan Turing") {
+			t.Errorf("Expected the failed insert to name Alan Turing, got %v", err)
+		}
+	})
+
+	t.Run("sends nothing without rows", func(t *testing.T) {
+		mockTx := &MockTx{}
+
+		if err := insertPlayers(context.Background(), mockTx, nil); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if mockTx.Batches != 0 {
+			t.Errorf("Expected no batch to be sent, got %d", mockTx.Batches)
+		}
+	})
+}
+
+func TestInsertInjuries(t *testing.T) {
+	mockTx := &MockTx{}
+	stats := []PlayerYearlyStatsFootball{
+		{PlayerID: "player-1", Year: 2023, Stats: FootballYearlyStats{Injuries: []Injury{
+			{Week: 4, GamesMissed: 3, Type: "MCL sprain", Severity: "MODERATE"},
+		}}},
+		{PlayerID: "player-2", Year: 2023, Stats: FootballYearlyStats{}},
+	}
+
+	inserted, err := insertInjuries(context.Background(), mockTx, stats)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if inserted != 1 || len(mockTx.ExecCalls) != 1 {
+		t.Fatalf("Expected 1 injury inserted, got %d (%d exec calls)", inserted, len(mockTx.ExecCalls))
+	}
+	if severity := mockTx.ExecCalls[0].Args[5]; severity != "MODERATE" {
+		t.Errorf("Expected severity MODERATE, got %v", severity)
+	}
+}
+
+func TestInsertYearlyStats(t *testing.T) {
+	mockTx := &MockTx{}
+	stats := []PlayerYearlyStatsFootball{
+		{PlayerID: "player-1", Year: 2023, Age: 25, Skill: 0.8, Stats: FootballYearlyStats{
+			Total: FootballStats{RushingAttempts: 250, RushingYards: 1100, RushingTDs: 9},
+			Weeks: []FootballWeekStats{{Week: 1, Stats: FootballStats{RushingYards: 80}}},
+		}},
+	}
+
+	if err := insertYearlyStats(context.Background(), mockTx, stats); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(mockTx.ExecCalls) != 1 {
+		t.Fatalf("Expected 1 exec call, got %d", len(mockTx.ExecCalls))
+	}
+	// The row holds the season's totals directly, not wrapped in the yearly struct
+	var stored FootballStats
+	if err := json.Unmarshal(mockTx.ExecCalls[0].Args[2].([]byte), &stored); err != nil || stored != stats[0].Stats.Total {
+		t.Errorf("Expected the season totals to be stored, got %+v (%v)", stored, err)
+	}
+	if games := mockTx.ExecCalls[0].Args[3]; games != 1 {
+		t.Errorf("Expected a game played for the season's one week, got %v", games)
+	}
+}
+
+func TestInsertSportYearlyStats(t *testing.T) {
+	mockTx := &MockTx{}
+	stats := []PlayerYearlyStatsBasketball{
+		{PlayerID: "player-1", Year: 2023, Age: 27, Skill: 0.7, Stats: BasketballYearlyStats{
+			Total:       BasketballStats{Points: 1500, Rebounds: 400},
+			GamesPlayed: 70,
+		}},
+	}
+
+	if err := insertSportYearlyStats(context.Background(), mockTx, SportBasketball, basketballSeasons(stats)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(mockTx.ExecCalls) != 1 {
+		t.Fatalf("Expected 1 exec call, got %d", len(mockTx.ExecCalls))
+	}
+	if sport := mockTx.ExecCalls[0].Args[2]; sport != SportBasketball {
+		t.Errorf("Expected a BASKETBALL row, got %v", sport)
+	}
+	var stored BasketballStats
+	if err := json.Unmarshal(mockTx.ExecCalls[0].Args[3].([]byte), &stored); err != nil || stored != stats[0].Stats.Total {
+		t.Errorf("Expected the season totals to be stored, got %+v (%v)", stored, err)
+	}
+	if games := mockTx.ExecCalls[0].Args[4]; games != 70 {
+		t.Errorf("Expected 70 games played, got %v", games)
+	}
+}
+
+func TestInsertSportWeeklyStats(t *testing.T) {
+	mockTx := &MockTx{}
+	stats := []PlayerYearlyStatsBaseball{
+		{PlayerID: "player-1", Year: 2023, Stats: BaseballYearlyStats{Weeks: []BaseballWeekStats{
+			{Week: 1, Games: 6, Stats: BaseballStats{AtBats: 24, Hits: 7}},
+			{Week: 2, Games: 5, Stats: BaseballStats{AtBats: 20, Hits: 4}},
+		}}},
+	}
+
+	inserted, err := insertSportWeeklyStats(context.Background(), mockTx, SportBaseball, baseballSeasons(stats))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if inserted != 2 || len(mockTx.ExecCalls) != 2 {
+		t.Fatalf("Expected 2 rows inserted, got %d (%d exec calls)", inserted, len(mockTx.ExecCalls))
+	}
+	if sport := mockTx.ExecCalls[1].Args[3]; sport != SportBaseball {
+		t.Errorf("Expected a BASEBALL row, got %v", sport)
+	}
+}
+
+func TestMarkInjuredPlayers(t *testing.T) {
+	players := []Player{
+		{ID: "healed", Status: "ACTIVE"},
+		{ID: "still-out", Status: "ACTIVE"},
+		{ID: "rookie", Status: "ACTIVE"},
+	}
+	careers := []PlayerYearlyStatsFootball{
+		{PlayerID: "healed", Year: 2023, Stats: FootballYearlyStats{OutAtSeasonEnd: true}},
+		{PlayerID: "healed", Year: 2024},
+		{PlayerID: "still-out", Year: 2024, Stats: FootballYearlyStats{OutAtSeasonEnd: true}},
+		{PlayerID: "still-out", Year: 2023},
+	}
+
+	markInjuredPlayers(players, careers)
+
+	expected := []string{"ACTIVE", "INJURED", "ACTIVE"}
+	for i, player := range players {
+		if player.Status != expected[i] {
+			t.Errorf("Expected %s to be %s, got %s", player.ID, expected[i], player.Status)
+		}
+	}
+}
+
 func TestFlattenRoster(t *testing.T) {
 	roster := FootballTeamRoster{
 		QB: []Player{{ID: "qb-1"}, {ID: "qb-2"}},
@@ -256,6 +673,29 @@ func TestFlattenRoster(t *testing.T) {
 	}
 }
 
+func TestDepthChartEntries(t *testing.T) {
+	roster := FootballTeamRoster{
+		QB: []Player{{ID: "qb-1", TeamID: "team-1", Position: "QB"}, {ID: "qb-2", TeamID: "team-1", Position: "QB"}},
+		WR: []Player{{ID: "wr-1", TeamID: "team-1", Position: "WR"}},
+	}
+
+	entries := depthChartEntries(roster)
+
+	expected := []DepthChartEntry{
+		{TeamID: "team-1", PlayerID: "qb-1", Position: "QB", Rank: 1},
+		{TeamID: "team-1", PlayerID: "qb-2", Position: "QB", Rank: 2},
+		{TeamID: "team-1", PlayerID: "wr-1", Position: "WR", Rank: 1},
+	}
+	if len(entries) != len(expected) {
+		t.Fatalf("Expected %d entries, got %d", len(expected), len(entries))
+	}
+	for i, entry := range entries {
+		if entry != expected[i] {
+			t.Errorf("Expected entry %d to be %+v, got %+v", i, expected[i], entry)
+		}
+	}
+}
+
 func TestFlattenRosterEmpty(t *testing.T) {
 	roster := FootballTeamRoster{}
 	players := flattenRoster(roster)
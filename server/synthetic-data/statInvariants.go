@@ -0,0 +1,91 @@
+package main
+
+// footballInvariant is a rule every football stat line keeps, a single game or a season's total.
+// Each rule compares counts of the same plays, so a season of lines that keep it keeps it too.
+type footballInvariant struct {
+	name  string
+	holds func(stats FootballStats) bool
+}
+
+// footballInvariants are the rules enforceFootballInvariants restores and the validate command checks
+var footballInvariants = []footballInvariant{
+	{"completions <= attempts", func(s FootballStats) bool { return s.PassingCompletions <= s.PassingAttempts }},
+	{"interceptions <= incompletions", func(s FootballStats) bool {
+		return s.PassingInterceptions <= s.PassingAttempts-s.PassingCompletions
+	}},
+	{"passing TDs <= completions", func(s FootballStats) bool { return s.PassingTDs <= s.PassingCompletions }},
+	{"rushing TDs <= rushing attempts", func(s FootballStats) bool { return s.RushingTDs <= s.RushingAttempts }},
+	{"receptions <= targets", func(s FootballStats) bool { return s.ReceivingReceptions <= s.ReceivingTargets }},
+	{"receiving TDs <= receptions", func(s FootballStats) bool { return s.ReceivingTDs <= s.ReceivingReceptions }},
+	{"fumbles lost <= fumbles", func(s FootballStats) bool { return s.FumblesLost <= s.Fumbles }},
+	{"field goals made + missed = attempted", func(s FootballStats) bool {
+		return s.FieldGoalsMade+s.FieldGoalsMissed == s.FieldGoals
+	}},
+	{"field goals made <= attempted at every distance", func(s FootballStats) bool {
+		for _, r := range fieldGoalRanges {
+			if attempts, made := r.stats(&s); *made > *attempts {
+				return false
+			}
+		}
+		return true
+	}},
+	{"field goal distances add up to the totals", func(s FootballStats) bool {
+		attempts, made := fieldGoalDistanceTotals(s)
+		// Lines from before distances were recorded have none
+		return attempts+made == 0 || (attempts == s.FieldGoals && made == s.FieldGoalsMade)
+	}},
+	{"blocked field goals made <= blocked", func(s FootballStats) bool {
+		return s.FieldGoalsBlockedMade <= s.FieldGoalsBlocked
+	}},
+	{"extra points made + missed = attempted", func(s FootballStats) bool {
+		return s.ExtraPointsMade+s.ExtraPointsMissed == s.ExtraPoints
+	}},
+}
+
+// fieldGoalDistanceTotals sums the field goals a line records by distance
+func fieldGoalDistanceTotals(stats FootballStats) (attempts, made int) {
+	for _, r := range fieldGoalRanges {
+		rangeAttempts, rangeMade := r.stats(&stats)
+		attempts += *rangeAttempts
+		made += *rangeMade
+	}
+	return attempts, made
+}
+
+// footballViolations names the invariants a stat line breaks, in footballInvariants order
+func footballViolations(stats FootballStats) []string {
+	var broken []string
+	for _, invariant := range footballInvariants {
+		if !invariant.holds(stats) {
+			broken = append(broken, invariant.name)
+		}
+	}
+	return broken
+}
+
+// enforceFootballInvariants trims a stat line back to consistency. Skill multipliers and partial
+// workloads round each stat on its own, which can leave e.g. 10 receptions on 9 targets; the
+// dependent stat is cut to what its plays allow, and misses are recounted from attempts. Field goals
+// recorded by distance are the kicks themselves, so the field goal totals are recounted from them.
+func enforceFootballInvariants(stats FootballStats) FootballStats {
+	for _, r := range fieldGoalRanges {
+		attempts, made := r.stats(&stats)
+		*made = min(*made, *attempts)
+	}
+	if attempts, made := fieldGoalDistanceTotals(stats); attempts > 0 {
+		stats.FieldGoals, stats.FieldGoalsMade = attempts, made
+	}
+	stats.PassingCompletions = min(stats.PassingCompletions, stats.PassingAttempts)
+	stats.PassingInterceptions = min(stats.PassingInterceptions, stats.PassingAttempts-stats.PassingCompletions)
+	stats.PassingTDs = min(stats.PassingTDs, stats.PassingCompletions)
+	stats.RushingTDs = min(stats.RushingTDs, stats.RushingAttempts)
+	stats.ReceivingReceptions = min(stats.ReceivingReceptions, stats.ReceivingTargets)
+	stats.ReceivingTDs = min(stats.ReceivingTDs, stats.ReceivingReceptions)
+	stats.FumblesLost = min(stats.FumblesLost, stats.Fumbles)
+	stats.FieldGoalsMade = min(stats.FieldGoalsMade, stats.FieldGoals)
+	stats.FieldGoalsMissed = stats.FieldGoals - stats.FieldGoalsMade
+	stats.FieldGoalsBlockedMade = min(stats.FieldGoalsBlockedMade, stats.FieldGoalsBlocked)
+	stats.ExtraPointsMade = min(stats.ExtraPointsMade, stats.ExtraPoints)
+	stats.ExtraPointsMissed = stats.ExtraPoints - stats.ExtraPointsMade
+	return stats
+}
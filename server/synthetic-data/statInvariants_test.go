@@ -0,0 +1,153 @@
+package main
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestFootballViolations(t *testing.T) {
+	consistent := FootballStats{
+		PassingAttempts: 30, PassingCompletions: 20, PassingInterceptions: 1, PassingTDs: 2,
+		RushingAttempts: 5, RushingTDs: 1,
+		ReceivingTargets: 8, ReceivingReceptions: 6, ReceivingTDs: 1,
+		Fumbles: 1, FumblesLost: 1,
+		FieldGoals: 3, FieldGoalsMade: 2, FieldGoalsMissed: 1,
+		FieldGoalsBlocked: 1, FieldGoalsBlockedMade: 0,
+		ExtraPoints: 2, ExtraPointsMade: 2,
+	}
+	if broken := footballViolations(consistent); broken != nil {
+		t.Errorf("Expected a consistent line to break nothing, got %v", broken)
+	}
+	if broken := footballViolations(FootballStats{}); broken != nil {
+		t.Errorf("Expected an empty line to break nothing, got %v", broken)
+	}
+
+	tests := []struct {
+		breakIt func(*FootballStats)
+		broken  []string
+	}{
+		// More completions than attempts leaves fewer than no incompletions to be intercepted
+		{func(s *FootballStats) { s.PassingCompletions = 31; s.PassingTDs = 0 }, []string{"completions <= attempts", "interceptions <= incompletions"}},
+		{func(s *FootballStats) { s.PassingInterceptions = 11 }, []string{"interceptions <= incompletions"}},
+		{func(s *FootballStats) { s.PassingTDs = 21 }, []string{"passing TDs <= completions"}},
+		{func(s *FootballStats) { s.RushingTDs = 6 }, []string{"rushing TDs <= rushing attempts"}},
+		{func(s *FootballStats) { s.ReceivingReceptions = 9; s.ReceivingTDs = 0 }, []string{"receptions <= targets"}},
+		{func(s *FootballStats) { s.ReceivingTDs = 7 }, []string{"receiving TDs <= receptions"}},
+		{func(s *FootballStats) { s.FumblesLost = 2 }, []string{"fumbles lost <= fumbles"}},
+		{func(s *FootballStats) { s.FieldGoalsMissed = 0 }, []string{"field goals made + missed = attempted"}},
+		{func(s *FootballStats) { s.FieldGoalsUnder40, s.FieldGoalsMadeUnder40 = 3, 2 }, nil},
+		{func(s *FootballStats) { s.FieldGoalsUnder40, s.FieldGoals50Plus, s.FieldGoalsMade50Plus = 2, 1, 2 }, []string{"field goals made <= attempted at every distance"}},
+		{func(s *FootballStats) { s.FieldGoalsUnder40, s.FieldGoalsMadeUnder40 = 2, 2 }, []string{"field goal distances add up to the totals"}},
+		{func(s *FootballStats) { s.FieldGoalsBlockedMade = 2 }, []string{"blocked field goals made <= blocked"}},
+		{func(s *FootballStats) { s.ExtraPointsMissed = 1 }, []string{"extra points made + missed = attempted"}},
+	}
+	for _, tt := range tests {
+		stats := consistent
+		tt.breakIt(&stats)
+		if broken := footballViolations(stats); !reflect.DeepEqual(broken, tt.broken) {
+			t.Errorf("Expected %v to break, got %v", tt.broken, broken)
+		}
+	}
+}
+
+func TestEnforceFootballInvariants(t *testing.T) {
+	t.Run("repairs a broken line", func(t *testing.T) {
+		stats := FootballStats{
+			PassingAttempts: 10, PassingCompletions: 12, PassingInterceptions: 3, PassingTDs: 14,
+			RushingAttempts: 0, RushingTDs: 1,
+			ReceivingTargets: 4, ReceivingReceptions: 5, ReceivingTDs: 6,
+			Fumbles: 1, FumblesLost: 2,
+			FieldGoals: 3, FieldGoalsMade: 4, FieldGoalsMissed: 2,
+			FieldGoalsBlocked: 0, FieldGoalsBlockedMade: 1,
+			ExtraPoints: 2, ExtraPointsMade: 1, ExtraPointsMissed: 0,
+			PassingYards: 120,
+		}
+
+		enforced := enforceFootballInvariants(stats)
+
+		if broken := footballViolations(enforced); broken != nil {
+			t.Errorf("Expected the enforced line to break nothing, got %v", broken)
+		}
+		want := FootballStats{
+			PassingAttempts: 10, PassingCompletions: 10, PassingInterceptions: 0, PassingTDs: 10,
+			RushingAttempts: 0, RushingTDs: 0,
+			ReceivingTargets: 4, ReceivingReceptions: 4, ReceivingTDs: 4,
+			Fumbles: 1, FumblesLost: 1,
+			FieldGoals: 3, FieldGoalsMade: 3, FieldGoalsMissed: 0,
+			FieldGoalsBlocked: 0, FieldGoalsBlockedMade: 0,
+			ExtraPoints: 2, ExtraPointsMade: 1, ExtraPointsMissed: 1,
+			PassingYards: 120,
+		}
+		if enforced != want {
+			t.Errorf("Expected the dependent stats to be trimmed\ngot:  %+v\nwant: %+v", enforced, want)
+		}
+	})
+
+	t.Run("recounts field goals from their distances", func(t *testing.T) {
+		stats := FootballStats{
+			FieldGoals: 2, FieldGoalsMade: 2,
+			FieldGoalsUnder40: 2, FieldGoalsMadeUnder40: 2,
+			FieldGoals50Plus: 1, FieldGoalsMade50Plus: 2,
+		}
+
+		enforced := enforceFootballInvariants(stats)
+
+		if broken := footballViolations(enforced); broken != nil {
+			t.Errorf("Expected the enforced line to break nothing, got %v", broken)
+		}
+		if enforced.FieldGoals != 3 || enforced.FieldGoalsMade != 3 || enforced.FieldGoalsMissed != 0 || enforced.FieldGoalsMade50Plus != 1 {
+			t.Errorf("Expected 3 of 3 field goals, 1 from 50 plus, got %+v", enforced)
+		}
+	})
+
+	t.Run("leaves a consistent line alone", func(t *testing.T) {
+		stats := FootballStats{PassingAttempts: 30, PassingCompletions: 20, PassingTDs: 2, FieldGoals: 3, FieldGoalsMade: 2, FieldGoalsMissed: 1}
+		if enforced := enforceFootballInvariants(stats); enforced != stats {
+			t.Errorf("Expected %+v unchanged, got %+v", stats, enforced)
+		}
+	})
+}
+
+func TestSimulatedStatsKeepInvariants(t *testing.T) {
+	sim := NewCareerSimulator(YearSimulatorConfig{
+		Rand:         rand.New(rand.NewSource(7)),
+		InjuryRoller: func(int, string) (bool, int) { return false, 0 },
+	})
+	for _, position := range []string{"QB", "RB", "WR", "TE", "PK"} {
+		players := make([]Player, 3)
+		for i := range players {
+			players[i] = Player{ID: position, Position: position, Age: 26, YearsOfExperience: 4, DraftYear: 2020, Skill: 0.3 * float64(i+1)}
+		}
+		for _, season := range sim.SimulateDepthChartYear(players, 2024) {
+			if broken := footballViolations(season.Total); broken != nil {
+				t.Errorf("Expected a %s season to keep the invariants, got %v in %+v", position, broken, season.Total)
+			}
+			for _, week := range season.Weeks {
+				if broken := footballViolations(week.Stats); broken != nil {
+					t.Errorf("Expected a %s game to keep the invariants, got %v in %+v", position, broken, week.Stats)
+				}
+			}
+		}
+	}
+}
+
+func TestGeneratorsDrawConsistentLines(t *testing.T) {
+	rng := rand.New(rand.NewSource(11))
+	player := Player{Age: 26, YearsOfExperience: 4, Skill: 0.5}
+	generators := map[string]PlayerGameStatsGenerator{
+		"QB": QuarterBackGameStatsGenerator(),
+		"RB": RunningBackGameStatsGenerator(),
+		"WR": WideReceiverGameStatsGenerator(),
+		"TE": TightEndGameStatsGenerator(),
+		"PK": KickerGameStatsGenerator(),
+	}
+	for position, generator := range generators {
+		for range 1000 {
+			stats := generator.generate(rng, player, player.YearsOfExperience)
+			if broken := footballViolations(stats); broken != nil {
+				t.Fatalf("Expected %s lines to be drawn consistent, got %v in %+v", position, broken, stats)
+			}
+		}
+	}
+}
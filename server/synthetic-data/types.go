@@ -12,6 +12,12 @@ var NFLRosterComposition = RosterComposition{
 	"WR": 6,
 	"TE": 3,
 	"PK": 1,
+	// Individual defensive players, for leagues that draft them
+	"DL": 6,
+	"LB": 4,
+	"DB": 6,
+	// Every team has one defense/special teams unit
+	"DST": 1,
 }
 
 type FootballTeamRoster struct {
@@ -20,6 +26,11 @@ type FootballTeamRoster struct {
 	WR []Player
 	TE []Player
 	PK []Player
+	DL []Player
+	LB []Player
+	DB []Player
+	// DST holds the team's defense/special teams unit, which plays as a whole rather than through the career simulator
+	DST []Player
 }
 
 // --- Data Model Structs ---
@@ -46,8 +57,17 @@ type Team struct {
 	Name       string `json:"name"`
 	Abbr       string `json:"abbr"`
 	DivisionID string `json:"division_id"`
+	ByeWeek    int    `json:"bye_week"`   // week the team doesn't play, 0 = none
+	SportType  string `json:"sport_type"` // one of the Sport constants
 }
 
+// Sport types, matching sport_type_enum
+const (
+	SportFootball   = "FOOTBALL"
+	SportBasketball = "BASKETBALL"
+	SportBaseball   = "BASEBALL"
+)
+
 type Player struct {
 	ID                string  `json:"id"`
 	FirstName         string  `json:"first_name"`
@@ -62,6 +82,19 @@ type Player struct {
 	Skill             float64 `json:"skill"` // 0.0 - 1.0
 	Status            string  `json:"status"`
 	Jersey            int     `json:"jersey"`
+	// ScoutedSkill is the skill scouts saw in the player before their rookie season (0 = not scouted)
+	ScoutedSkill float64 `json:"scouted_skill,omitempty"`
+	// DraftRound and DraftPick are where the player was taken in the rookie draft (0 = not drafted in a simulated draft)
+	DraftRound int `json:"draft_round,omitempty"`
+	DraftPick  int `json:"draft_pick,omitempty"`
+}
+
+// DepthChartEntry places a player on their pro team's depth chart, rank 1 being the starter
+type DepthChartEntry struct {
+	TeamID   string `json:"team_id"`
+	PlayerID string `json:"player_id"`
+	Position string `json:"position"`
+	Rank     int    `json:"rank"`
 }
 
 type FootballStats struct {
@@ -84,13 +117,53 @@ type FootballStats struct {
 	FieldGoalsMissed      int
 	FieldGoalsBlocked     int
 	FieldGoalsBlockedMade int
+	// Field goals by distance: under 40 yards, 40 to 49 and 50 or more. They add up to FieldGoals
+	// and FieldGoalsMade, so scoring can award distance bonuses.
+	FieldGoalsUnder40     int
+	FieldGoalsMadeUnder40 int
+	FieldGoals40To49      int
+	FieldGoalsMade40To49  int
+	FieldGoals50Plus      int
+	FieldGoalsMade50Plus  int
 	ExtraPoints           int
 	ExtraPointsMade       int
 	ExtraPointsMissed     int
+	// Defense. Sacks and interceptions are recorded by defensive players and DST units alike.
+	Tackles         int
+	AssistedTackles int
+	Sacks           int
+	Interceptions   int
+	PassesDefended  int
+	ForcedFumbles   int
+	// Team defense, credited to DST units from what their opponents' offense gave up
+	FumbleRecoveries int
+	DefensiveTDs     int
+	PointsAllowed    int
+	YardsAllowed     int
+}
+
+// FootballWeekStats is a single game's stat line within a season
+type FootballWeekStats struct {
+	Week  int
+	Stats FootballStats
+}
+
+// Injury is a simulated injury, suffered during the game played in Week
+type Injury struct {
+	Week        int
+	GamesMissed int
+	Type        string
+	Severity    string
 }
 
 type FootballYearlyStats struct {
 	Total FootballStats
+	// Weeks holds the individual game lines that make up Total (stored separately in weekly_stats)
+	Weeks []FootballWeekStats `json:"-"`
+	// Injuries holds the injuries suffered during the season (stored separately in injuries)
+	Injuries []Injury `json:"-"`
+	// OutAtSeasonEnd is set when the player was still injured after the last game of the season
+	OutAtSeasonEnd bool `json:"-"`
 }
 
 type PlayerYearlyStats[T struct{}] struct {
@@ -103,5 +176,83 @@ type PlayerYearlyStats[T struct{}] struct {
 type PlayerYearlyStatsFootball struct {
 	PlayerID string              `json:"player_id"`
 	Year     int                 `json:"year"`
+	Age      int                 `json:"age"`   // player's age that season
+	Skill    float64             `json:"skill"` // player's skill that season, following their aging curve
 	Stats    FootballYearlyStats `json:"stats"`
 }
+
+type BasketballStats struct {
+	Points              int
+	Rebounds            int
+	Assists             int
+	Steals              int
+	Blocks              int
+	ThreePointersMade   int
+	Turnovers           int
+	FieldGoalsMade      int
+	FieldGoalsAttempted int
+	FreeThrowsMade      int
+	FreeThrowsAttempted int
+}
+
+// BasketballWeekStats is a fantasy week's stat line within a season, summing the games played that week
+type BasketballWeekStats = weekLine[BasketballStats]
+
+type BasketballYearlyStats struct {
+	Total BasketballStats
+	// GamesPlayed is how many games the player took the floor in
+	GamesPlayed int
+	// Weeks holds the weekly lines that make up Total (stored separately in weekly_stats)
+	Weeks []BasketballWeekStats `json:"-"`
+	// Injuries holds the injuries suffered during the season (stored separately in injuries)
+	Injuries []Injury `json:"-"`
+	// OutAtSeasonEnd is set when the player was still injured after the last game of the season
+	OutAtSeasonEnd bool `json:"-"`
+}
+
+type PlayerYearlyStatsBasketball struct {
+	PlayerID string                `json:"player_id"`
+	Year     int                   `json:"year"`
+	Age      int                   `json:"age"`   // player's age that season
+	Skill    float64               `json:"skill"` // player's skill that season, following their aging curve
+	Stats    BasketballYearlyStats `json:"stats"`
+}
+
+// BaseballStats covers hitting and pitching. Hitters leave the pitching stats at zero and pitchers
+// the hitting ones. Innings pitched are kept as outs so partial innings add up exactly.
+type BaseballStats struct {
+	AtBats       int
+	Hits         int
+	HomeRuns     int
+	RunsBattedIn int
+	StolenBases  int
+	OutsPitched  int
+	Strikeouts   int
+	EarnedRuns   int
+	HitsAllowed  int
+	WalksAllowed int
+	Saves        int
+}
+
+// BaseballWeekStats is a fantasy week's stat line within a season, summing the games played that week
+type BaseballWeekStats = weekLine[BaseballStats]
+
+type BaseballYearlyStats struct {
+	Total BaseballStats
+	// GamesPlayed is how many games the player appeared in
+	GamesPlayed int
+	// Weeks holds the weekly lines that make up Total (stored separately in weekly_stats)
+	Weeks []BaseballWeekStats `json:"-"`
+	// Injuries holds the injuries suffered during the season (stored separately in injuries)
+	Injuries []Injury `json:"-"`
+	// OutAtSeasonEnd is set when the player was still injured after the last game of the season
+	OutAtSeasonEnd bool `json:"-"`
+}
+
+type PlayerYearlyStatsBaseball struct {
+	PlayerID string              `json:"player_id"`
+	Year     int                 `json:"year"`
+	Age      int                 `json:"age"`   // player's age that season
+	Skill    float64             `json:"skill"` // player's skill that season, following their aging curve
+	Stats    BaseballYearlyStats `json:"stats"`
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// DefaultUniverse is the universe seed and advance-season work on unless --universe names another
+const DefaultUniverse = "default"
+
+// A universe is one synthetic league: its conferences, divisions, pro teams and players, and the
+// stats, injuries and depth charts that hang off them. Several can live side by side, and every
+// draft room drafts from one of them.
+const (
+	universeIDs       = "SELECT id FROM universes WHERE name = $1"
+	universeTeams     = "SELECT id FROM pro_teams WHERE universe_id IN (" + universeIDs + ")"
+	universePlayers   = "SELECT id FROM players WHERE team_id IN (" + universeTeams + ")"
+	universeRooms     = "SELECT id FROM draft_rooms WHERE universe_id IN (" + universeIDs + ")"
+	universeRoomTeams = "SELECT id FROM fantasy_teams WHERE draft_room_id IN (" + universeRooms + ")"
+	universeRoomTrade = "SELECT id FROM fantasy_trades WHERE draft_room_id IN (" + universeRooms + ")"
+)
+
+// scopedDelete deletes the rows of table matching where
+type scopedDelete struct {
+	table string
+	where string
+}
+
+// universeDeletes removes everything in a universe, children first to respect foreign keys. Each
+// statement takes the universe's name as $1.
+var universeDeletes = []scopedDelete{
+	{"rankings", "player_id IN (" + universePlayers + ")"},
+	{"team_depth_charts", "team_id IN (" + universeTeams + ")"},
+	{"injuries", "player_id IN (" + universePlayers + ")"},
+	{"weekly_stats", "player_id IN (" + universePlayers + ")"},
+	{"yearly_stats", "player_id IN (" + universePlayers + ")"},
+	{"players", "team_id IN (" + universeTeams + ")"},
+	{"pro_teams", "universe_id IN (" + universeIDs + ")"},
+	{"divisions", "conference_id IN (SELECT id FROM conferences WHERE universe_id IN (" + universeIDs + "))"},
+	{"conferences", "universe_id IN (" + universeIDs + ")"},
+	{"universes", "name = $1"},
+}
+
+// draftRoomDeletes removes the draft rooms drafting from a universe along with their teams,
+// seasons and roster moves, children first. Each statement takes the universe's name as $1.
+var draftRoomDeletes = []scopedDelete{
+	{"fantasy_transactions", "draft_room_id IN (" + universeRooms + ")"},
+	{"fantasy_trade_votes", "trade_id IN (" + universeRoomTrade + ")"},
+	{"fantasy_trade_players", "trade_id IN (" + universeRoomTrade + ")"},
+	{"fantasy_trades", "draft_room_id IN (" + universeRooms + ")"},
+	{"fantasy_waiver_claims", "draft_room_id IN (" + universeRooms + ")"},
+	{"fantasy_rosters", "fantasy_team_id IN (" + universeRoomTeams + ")"},
+	{"fantasy_matchups", "draft_room_id IN (" + universeRooms + ")"},
+	{"fantasy_playoff_games", "draft_room_id IN (" + universeRooms + ")"},
+	{"fantasy_seasons", "draft_room_id IN (" + universeRooms + ")"},
+	{"fantasy_teams", "draft_room_id IN (" + universeRooms + ")"},
+	{"draft_rooms", "universe_id IN (" + universeIDs + ")"},
+}
+
+// purgeUniverse deletes the named universe so it can be seeded again. Users, ranking lists (less
+// the universe's players) and other universes are left alone. Draft rooms can't outlive the players they drafted, so a
+// universe with draft rooms is only purged when dropDraftRooms says they can go with it.
+func purgeUniverse(ctx context.Context, tx pgx.Tx, name string, dropDraftRooms bool) error {
+	var rooms int
+	if err := tx.QueryRow(ctx, "SELECT COUNT(*) FROM ("+universeRooms+") rooms", name).Scan(&rooms); err != nil {
+		return fmt.Errorf("failed to count the draft rooms of universe %s: %w", name, err)
+	}
+	if rooms > 0 {
+		if !dropDraftRooms {
+			return fmt.Errorf("universe %s has %d draft rooms; seed another universe or pass --drop-draft-rooms to delete them", name, rooms)
+		}
+		if err := deleteFromUniverse(ctx, tx, name, draftRoomDeletes); err != nil {
+			return err
+		}
+	}
+	return deleteFromUniverse(ctx, tx, name, universeDeletes)
+}
+
+func deleteFromUniverse(ctx context.Context, db DBExecutor, name string, deletes []scopedDelete) error {
+	for _, d := range deletes {
+		_, err := db.Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE %s", d.table, d.where), name)
+		if err != nil {
+			return fmt.Errorf("failed to purge table %s: %w", d.table, err)
+		}
+	}
+	return nil
+}
+
+// universeNamespace is the namespace universe IDs are derived in
+var universeNamespace = uuid.MustParse("6f1c2a4e-8d3b-4e5f-9a7c-0b2d4e6f8a1c")
+
+// universeScope maps the IDs a universe was generated or exported with to the IDs its rows are
+// stored under. Generated IDs come from the seeded random source, so the same seed makes the same
+// IDs in every universe; deriving the stored ID from the universe's name as well keeps universes
+// seeded or imported from the same data apart, and the same universe gets the same IDs every time.
+type universeScope uuid.UUID
+
+// newUniverseScope scopes IDs to the named universe
+func newUniverseScope(name string) universeScope {
+	return universeScope(uuid.NewSHA1(universeNamespace, []byte(name)))
+}
+
+// universeID is the ID the universe itself is stored under
+func (s universeScope) universeID() string {
+	return uuid.UUID(s).String()
+}
+
+// id is the ID a generated row is stored under. A missing ID, such as a free agent's team, stays
+// missing.
+func (s universeScope) id(generated string) string {
+	if generated == "" {
+		return ""
+	}
+	return uuid.NewSHA1(uuid.UUID(s), []byte(generated)).String()
+}
+
+// league copies a league with every ID, and every reference to one, moved into the scope
+func (s universeScope) league(flat LeagueFlat) LeagueFlat {
+	scoped := LeagueFlat{
+		Conferences: make([]Conference, len(flat.Conferences)),
+		Divisions:   make([]Division, len(flat.Divisions)),
+		Teams:       make([]Team, len(flat.Teams)),
+	}
+	for i, conference := range flat.Conferences {
+		conference.ID = s.id(conference.ID)
+		scoped.Conferences[i] = conference
+	}
+	for i, division := range flat.Divisions {
+		division.ID, division.ConferenceID = s.id(division.ID), s.id(division.ConferenceID)
+		scoped.Divisions[i] = division
+	}
+	for i, team := range flat.Teams {
+		team.ID, team.DivisionID = s.id(team.ID), s.id(team.DivisionID)
+		scoped.Teams[i] = team
+	}
+	return scoped
+}
+
+// players copies players into the scope
+func (s universeScope) players(players []Player) []Player {
+	scoped := make([]Player, len(players))
+	for i, player := range players {
+		player.ID, player.TeamID = s.id(player.ID), s.id(player.TeamID)
+		scoped[i] = player
+	}
+	return scoped
+}
+
+// depthCharts copies depth chart entries into the scope
+func (s universeScope) depthCharts(entries []DepthChartEntry) []DepthChartEntry {
+	scoped := make([]DepthChartEntry, len(entries))
+	for i, entry := range entries {
+		entry.TeamID, entry.PlayerID = s.id(entry.TeamID), s.id(entry.PlayerID)
+		scoped[i] = entry
+	}
+	return scoped
+}
+
+// universe copies a generated universe with all its IDs moved into the scope
+func (s universeScope) universe(universe GeneratedUniverse) GeneratedUniverse {
+	football := universe.Football
+	scoped := GeneratedUniverse{League: universe.League, Football: footballLeague{
+		Flat:        s.league(football.Flat),
+		Players:     s.players(football.Players),
+		DepthCharts: s.depthCharts(football.DepthCharts),
+		Careers:     make([]PlayerYearlyStatsFootball, len(football.Careers)),
+	}}
+	for i, career := range football.Careers {
+		career.PlayerID = s.id(career.PlayerID)
+		scoped.Football.Careers[i] = career
+	}
+	for _, league := range universe.Leagues {
+		seasons := make([]sportSeason, len(league.Seasons))
+		for i, season := range league.Seasons {
+			season.PlayerID = s.id(season.PlayerID)
+			seasons[i] = season
+		}
+		league.Flat, league.Players, league.DepthCharts, league.Seasons =
+			s.league(league.Flat), s.players(league.Players), s.depthCharts(league.DepthCharts), seasons
+		scoped.Leagues = append(scoped.Leagues, league)
+	}
+	return scoped
+}
+
+// insertUniverse stores a universe along with the definition its football league was generated
+// from. A league without a roster composition isn't known, as with an import exported before
+// leagues were, and is stored as NULL.
+func insertUniverse(ctx context.Context, db DBExecutor, id, name string, league LeagueDefinition) error {
+	var definition []byte
+	if league.RosterComposition != nil {
+		var err error
+		if definition, err = json.Marshal(league); err != nil {
+			return fmt.Errorf("failed to marshal league definition: %w", err)
+		}
+	}
+	_, err := db.Exec(ctx, "INSERT INTO universes (id, name, league) VALUES ($1, $2, $3)", id, name, definition)
+	return err
+}
+
+// loadUniverseLeague reads the definition a universe's football league was generated from, or nil
+// for a universe stored before its league was
+func loadUniverseLeague(ctx context.Context, tx pgx.Tx, universe string) (*LeagueDefinition, error) {
+	var definition []byte
+	if err := tx.QueryRow(ctx, "SELECT league FROM universes WHERE name = $1", universe).Scan(&definition); err != nil {
+		return nil, err
+	}
+	if definition == nil {
+		return nil, nil
+	}
+	var league LeagueDefinition
+	if err := json.Unmarshal(definition, &league); err != nil {
+		return nil, fmt.Errorf("invalid league definition stored for universe %s: %w", universe, err)
+	}
+	return &league, nil
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestPurgeUniverse(t *testing.T) {
+	t.Run("deletes only the universe", func(t *testing.T) {
+		mockTx := &MockTx{}
+
+		if err := purgeUniverse(context.Background(), mockTx, "sandbox", false); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+
+		if len(mockTx.ExecCalls) != len(universeDeletes) {
+			t.Fatalf("Expected %d deletes, got %d", len(universeDeletes), len(mockTx.ExecCalls))
+		}
+		for _, call := range mockTx.ExecCalls {
+			if len(call.Args) != 1 || call.Args[0] != "sandbox" {
+				t.Errorf("Expected every delete to be scoped to the sandbox universe, got %v for %s", call.Args, call.SQL)
+			}
+			for _, kept := range []string{"users", "ranking_lists", "draft_rooms", "fantasy_"} {
+				if strings.HasPrefix(call.SQL, "DELETE FROM "+kept) {
+					t.Errorf("Expected %s to be left alone, got %s", kept, call.SQL)
+				}
+			}
+		}
+		if last := mockTx.ExecCalls[len(mockTx.ExecCalls)-1].SQL; !strings.HasPrefix(last, "DELETE FROM universes") {
+			t.Errorf("Expected the universe itself to be deleted last, got %s", last)
+		}
+	})
+
+	t.Run("keeps a universe with draft rooms", func(t *testing.T) {
+		mockTx := &MockTx{QueryRowValues: []any{2}}
+
+		err := purgeUniverse(context.Background(), mockTx, "sandbox", false)
+
+		if err == nil || !strings.Contains(err.Error(), "2 draft rooms") {
+			t.Errorf("Expected the universe's draft rooms to stop the purge, got %v", err)
+		}
+		if len(mockTx.ExecCalls) != 0 {
+			t.Errorf("Expected nothing to be deleted, got %d deletes", len(mockTx.ExecCalls))
+		}
+	})
+
+	t.Run("drops the draft rooms when asked", func(t *testing.T) {
+		mockTx := &MockTx{QueryRowValues: []any{2}}
+
+		if err := purgeUniverse(context.Background(), mockTx, "sandbox", true); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+
+		if len(mockTx.ExecCalls) != len(draftRoomDeletes)+len(universeDeletes) {
+			t.Fatalf("Expected %d deletes, got %d", len(draftRoomDeletes)+len(universeDeletes), len(mockTx.ExecCalls))
+		}
+		if first := mockTx.ExecCalls[0].SQL; !strings.HasPrefix(first, "DELETE FROM fantasy_") {
+			t.Errorf("Expected the draft rooms' data to go before the players it references, got %s", first)
+		}
+	})
+}
+
+func TestSeedCreatesUniverse(t *testing.T) {
+	mockTx := &MockTx{}
+	seeder := NewDatabaseSeeder(SeederConfig{
+		DataGenerator: NewMockDataGenerator(),
+		Universe:      "sandbox",
+		Quiet:         true,
+	})
+
+	result, err := seeder.Seed(context.Background(), mockTx)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var universeInserted bool
+	for _, call := range mockTx.ExecCalls {
+		switch {
+		case strings.HasPrefix(call.SQL, "INSERT INTO universes"):
+			universeInserted = true
+			if call.Args[0] != result.UniverseID || call.Args[1] != "sandbox" {
+				t.Errorf("Expected the sandbox universe to be inserted as %s, got %v", result.UniverseID, call.Args)
+			}
+		case strings.HasPrefix(call.SQL, "INSERT INTO conferences"):
+			if call.Args[2] != result.UniverseID {
+				t.Errorf("Expected conferences to belong to the universe, got %v", call.Args[2])
+			}
+		case strings.HasPrefix(call.SQL, "INSERT INTO pro_teams"):
+			if call.Args[8] != result.UniverseID {
+				t.Errorf("Expected teams to belong to the universe, got %v", call.Args[8])
+			}
+		}
+	}
+	if !universeInserted {
+		t.Error("Expected the universe to be inserted")
+	}
+}
+
+func TestSeedingUniversesFromOneSeed(t *testing.T) {
+	// Two teams in a short season keep the seeded league small
+	league := DefaultLeagueDefinition
+	league.Conferences, league.Divisions, league.TeamsPerDivision, league.GamesPerSeason = []string{"Union Conference"}, []string{"North"}, 2, 4
+
+	seed := func(universe string) map[string]bool {
+		rng := rand.New(rand.NewSource(42))
+		generatorsOnce = sync.Once{}
+		getPlayerGenerators(seededTestAggregator, rng)
+		seeder := NewDatabaseSeeder(SeederConfig{DataGenerator: NewLeagueDataGenerator(league, rng, yearClock(2025)), Universe: universe, Quiet: true})
+
+		mockTx := &MockTx{}
+		if _, err := seeder.Seed(context.Background(), mockTx); err != nil {
+			t.Fatalf("Expected universe %s to be seeded, got %v", universe, err)
+		}
+		ids := make(map[string]bool)
+		for _, call := range mockTx.ExecCalls {
+			for _, table := range []string{"universes", "conferences", "divisions", "pro_teams", "players"} {
+				if strings.HasPrefix(call.SQL, "INSERT INTO "+table+" ") {
+					ids[call.Args[0].(string)] = true
+				}
+			}
+		}
+		return ids
+	}
+
+	sandbox, staging := seed("sandbox"), seed("staging")
+	if len(sandbox) == 0 || len(sandbox) != len(staging) {
+		t.Fatalf("Expected the same seed to insert as many rows in each universe, got %d and %d", len(sandbox), len(staging))
+	}
+	for id := range sandbox {
+		if staging[id] {
+			t.Errorf("Expected each universe to store its rows under its own IDs, both have %s", id)
+		}
+	}
+	if again := seed("sandbox"); !reflect.DeepEqual(again, sandbox) {
+		t.Error("Expected reseeding a universe with the same seed to insert the same IDs")
+	}
+}
+
+func TestUniverseLeague(t *testing.T) {
+	league := DefaultLeagueDefinition
+	league.TeamsPerDivision, league.GamesPerSeason = 2, 14
+
+	t.Run("stores the league the universe was generated from", func(t *testing.T) {
+		mockTx := &MockTx{}
+		if err := insertUniverse(context.Background(), mockTx, "universe-1", "sandbox", league); err != nil {
+			t.Fatal(err)
+		}
+
+		mockTx.QueryRowValues = []any{mockTx.ExecCalls[0].Args[2]}
+		stored, err := loadUniverseLeague(context.Background(), mockTx, "sandbox")
+		if err != nil {
+			t.Fatalf("Expected the stored league to be read, got %v", err)
+		}
+		if stored == nil || !reflect.DeepEqual(*stored, league) {
+			t.Errorf("Expected the league to be read back as stored, got %+v", stored)
+		}
+	})
+
+	t.Run("stores an unknown league as NULL", func(t *testing.T) {
+		mockTx := &MockTx{}
+		if err := insertUniverse(context.Background(), mockTx, "universe-1", "sandbox", LeagueDefinition{}); err != nil {
+			t.Fatal(err)
+		}
+		if definition := mockTx.ExecCalls[0].Args[2].([]byte); definition != nil {
+			t.Errorf("Expected no league to be stored, got %s", definition)
+		}
+
+		mockTx.QueryRowValues = []any{[]byte(nil)}
+		if stored, err := loadUniverseLeague(context.Background(), mockTx, "sandbox"); err != nil || stored != nil {
+			t.Errorf("Expected no stored league, got %+v and %v", stored, err)
+		}
+	})
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidationReportCheck(t *testing.T) {
+	season := storedStatLine{PlayerID: "p-1", FirstName: "Jane", LastName: "Smith", Year: 2024,
+		Stats: FootballStats{ReceivingTargets: 100, ReceivingReceptions: 70, ReceivingTDs: 8}}
+	badGame := storedStatLine{PlayerID: "p-1", FirstName: "Jane", LastName: "Smith", Year: 2024, Week: 3,
+		Stats: FootballStats{ReceivingTargets: 6, ReceivingReceptions: 7}}
+	badKick := storedStatLine{PlayerID: "k-1", FirstName: "Sam", LastName: "Jones", Year: 2024,
+		Stats: FootballStats{FieldGoals: 30, FieldGoalsMade: 25, FieldGoalsMissed: 4}}
+
+	t.Run("lists the lines that break an invariant", func(t *testing.T) {
+		report := &validationReport{}
+		for _, line := range []storedStatLine{season, badGame, badKick} {
+			report.check(line)
+		}
+
+		want := []statViolation{
+			{Line: badGame, Broken: []string{"receptions <= targets"}},
+			{Line: badKick, Broken: []string{"field goals made + missed = attempted"}},
+		}
+		if report.Checked != 3 || report.Violating != 2 || !reflect.DeepEqual(report.Listed, want) {
+			t.Fatalf("Expected the game and the kicker's season of 3 lines, got %+v", report)
+		}
+		if got := report.Listed[0].String(); got != "Jane Smith (p-1), 2024 week 3: [receptions <= targets]" {
+			t.Errorf("Expected the game to be named by its week, got %q", got)
+		}
+		if got := report.Listed[1].String(); got != "Sam Jones (k-1), 2024 season: [field goals made + missed = attempted]" {
+			t.Errorf("Expected a season total to be named as one, got %q", got)
+		}
+	})
+
+	t.Run("keeps only the first violations but counts them all", func(t *testing.T) {
+		report := &validationReport{}
+		for week := range maxReportedViolations + 10 {
+			line := badGame
+			line.Week = week + 1
+			report.check(line)
+		}
+		report.check(badKick)
+
+		if len(report.Listed) != maxReportedViolations || report.Listed[0].Line.Week != 1 {
+			t.Errorf("Expected the first %d violations to be listed, got %d", maxReportedViolations, len(report.Listed))
+		}
+		if report.Violating != maxReportedViolations+11 {
+			t.Errorf("Expected %d violating lines, got %d", maxReportedViolations+11, report.Violating)
+		}
+		want := map[string]int{"receptions <= targets": maxReportedViolations + 10, "field goals made + missed = attempted": 1}
+		if !reflect.DeepEqual(report.Broken, want) {
+			t.Errorf("Expected every broken invariant to be counted, got %v", report.Broken)
+		}
+	})
+}